@@ -0,0 +1,38 @@
+package interceptors
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/protobuf/proto"
+
+	protopackage "market.goravel.dev/proto/package"
+	protouser "market.goravel.dev/proto/user"
+	utilsetag "market.goravel.dev/utils/etag"
+)
+
+type PackageResponse interface {
+	GetPackage() *protopackage.Package
+}
+
+type UserResponse interface {
+	GetUser() *protouser.User
+}
+
+// ETag exposes the revision of the package or the user in the response as the ETag header, the clients send it back in
+// the If-Match header to update the revision they read.
+func ETag(ctx context.Context, w http.ResponseWriter, resp proto.Message) error {
+	var revision uint64
+	switch resp := resp.(type) {
+	case PackageResponse:
+		revision = resp.GetPackage().GetRevision()
+	case UserResponse:
+		revision = resp.GetUser().GetRevision()
+	}
+
+	if revision > 0 {
+		w.Header().Set("ETag", utilsetag.Format(revision))
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package interceptors
+
+import (
+	"net/textproto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// forwardedHeaders are the HTTP headers that are forwarded to the gRPC services as metadata besides the default ones.
+var forwardedHeaders = map[string]string{
+	"Idempotency-Key": "idempotency-key",
+	"If-Match":        "if-match",
+}
+
+// Header maps the HTTP headers to the gRPC metadata, the headers that aren't in forwardedHeaders are handled by
+// runtime.DefaultHeaderMatcher.
+func Header(key string) (string, bool) {
+	if metadataKey, ok := forwardedHeaders[textproto.CanonicalMIMEHeaderKey(key)]; ok {
+		return metadataKey, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
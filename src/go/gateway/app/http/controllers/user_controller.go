@@ -1,21 +1,86 @@
 package controllers
 
 import (
+	"encoding/json"
+	"errors"
+
 	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/facades"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"market.goravel.dev/gateway/app/services"
+	protobase "market.goravel.dev/proto/base"
+	protopackage "market.goravel.dev/proto/package"
+	utilserrors "market.goravel.dev/utils/errors"
 )
 
 type UserController struct {
-	//Dependent services
+	packageService services.Package
+	userService    services.User
 }
 
 func NewUserController() *UserController {
 	return &UserController{
-		//Inject services
+		packageService: services.NewPackageImpl(),
+		userService:    services.NewUserImpl(),
 	}
 }
 
-func (r *UserController) Show(ctx http.Context) http.Response {
+// Packages lists the public packages of the user together with the public profile, the user can be given by the ID or
+// the username. The caller isn't passed to the package service, so the private packages of the user are never listed.
+func (r *UserController) Packages(ctx http.Context) http.Response {
+	profile, err := r.userService.GetPublicProfile(ctx, ctx.Request().Route("id"))
+	if err != nil {
+		return r.error(ctx, err)
+	}
+
+	response, err := r.packageService.GetPackages(ctx, &protopackage.GetPackagesRequest{
+		Pagination: &protobase.Pagination{
+			Page:   int32(ctx.Request().QueryInt("pagination.page", 0)),
+			Limit:  int32(ctx.Request().QueryInt("pagination.limit", 0)),
+			Cursor: ctx.Request().Query("pagination.cursor", ""),
+		},
+		Query: &protopackage.PackagesQuery{
+			UserId:    profile.GetId(),
+			Sort:      ctx.Request().Query("query.sort", ""),
+			Direction: ctx.Request().Query("query.direction", ""),
+		},
+	})
+	if err != nil {
+		return r.error(ctx, err)
+	}
+
+	// The messages are marshaled the same way as the responses proxied by the gateway.
+	marshaler := protojson.MarshalOptions{EmitUnpopulated: true}
+	packages, err := marshaler.Marshal(response)
+	if err != nil {
+		return r.error(ctx, err)
+	}
+	var data map[string]json.RawMessage
+	if err := json.Unmarshal(packages, &data); err != nil {
+		return r.error(ctx, err)
+	}
+	if data["profile"], err = marshaler.Marshal(profile); err != nil {
+		return r.error(ctx, err)
+	}
+
+	return ctx.Response().Success().Json(data)
+}
+
+// error responds the error in the status of the body like the failed responses of the services.
+func (r *UserController) error(ctx http.Context, err error) http.Response {
+	code := int32(http.StatusInternalServerError)
+	var errWithCode utilserrors.ErrorWithCode
+	if errors.As(err, &errWithCode) {
+		code = errWithCode.Code()
+	} else {
+		facades.Log().Request(ctx.Request()).Errorf("user controller err: %+v", err)
+	}
+
 	return ctx.Response().Success().Json(http.Json{
-		"Hello": "Goravel",
+		"status": http.Json{
+			"code":  code,
+			"error": err.Error(),
+		},
 	})
 }
@@ -0,0 +1,165 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	mockshttp "github.com/goravel/framework/mocks/http"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	mocksservices "market.goravel.dev/gateway/app/mocks/services"
+	protobase "market.goravel.dev/proto/base"
+	protopackage "market.goravel.dev/proto/package"
+	protouser "market.goravel.dev/proto/user"
+	utilserrors "market.goravel.dev/utils/errors"
+)
+
+type UserControllerSuite struct {
+	suite.Suite
+	mockContext        *mockshttp.Context
+	mockRequest        *mockshttp.ContextRequest
+	mockResponse       *mockshttp.ContextResponse
+	mockResponseStatus *mockshttp.ResponseStatus
+	mockPackageService *mocksservices.Package
+	mockUserService    *mocksservices.User
+	userController     *UserController
+}
+
+func TestUserControllerSuite(t *testing.T) {
+	suite.Run(t, new(UserControllerSuite))
+}
+
+func (s *UserControllerSuite) SetupTest() {
+	mockFactory := testingmock.Factory()
+	mockFactory.Log()
+	s.mockContext = mockFactory.Context()
+	s.mockRequest = mockFactory.ContextRequest()
+	s.mockResponse = mockFactory.ContextResponse()
+	s.mockResponseStatus = mockFactory.ResponseStatus()
+	s.mockPackageService = &mocksservices.Package{}
+	s.mockUserService = &mocksservices.User{}
+	s.userController = &UserController{
+		packageService: s.mockPackageService,
+		userService:    s.mockUserService,
+	}
+}
+
+func (s *UserControllerSuite) TestPackages() {
+	var (
+		profile = &protouser.PublicProfile{
+			Id:       "1",
+			Username: "goravel",
+			Name:     "Goravel",
+		}
+		packagesResponse = &protopackage.GetPackagesResponse{
+			Status: &protobase.Status{Code: 200},
+			Packages: []*protopackage.Package{
+				{Id: "2", UserId: "1", Name: "goravel/gin"},
+			},
+			Total: 1,
+		}
+	)
+
+	tests := []struct {
+		name           string
+		setup          func()
+		expectProfile  *protouser.PublicProfile
+		expectPackages *protopackage.GetPackagesResponse
+		expectStatus   map[string]any
+	}{
+		{
+			name: "Happy path - profile is merged into the packages",
+			setup: func() {
+				s.mockUserService.On("GetPublicProfile", s.mockContext, "goravel").Return(profile, nil).Once()
+				s.mockRequest.On("QueryInt", "pagination.page", 0).Return(2).Once()
+				s.mockRequest.On("QueryInt", "pagination.limit", 0).Return(10).Once()
+				s.mockRequest.On("Query", "pagination.cursor", "").Return("").Once()
+				s.mockRequest.On("Query", "query.sort", "").Return("views").Once()
+				s.mockRequest.On("Query", "query.direction", "").Return("desc").Once()
+				// The packages are listed by the profile, the caller is never passed, so the private packages stay hidden.
+				s.mockPackageService.On("GetPackages", s.mockContext, mock.MatchedBy(func(req *protopackage.GetPackagesRequest) bool {
+					return req.GetUserId() == "" && req.GetQuery().GetUserId() == "1" && req.GetQuery().GetSort() == "views" &&
+						req.GetQuery().GetDirection() == "desc" && req.GetPagination().GetPage() == 2 && req.GetPagination().GetLimit() == 10
+				})).Return(packagesResponse, nil).Once()
+			},
+			expectProfile:  profile,
+			expectPackages: packagesResponse,
+		},
+		{
+			name: "Sad path - user is not found",
+			setup: func() {
+				s.mockUserService.On("GetPublicProfile", s.mockContext, "goravel").Return(nil, utilserrors.NewNotFound("user not found")).Once()
+			},
+			expectStatus: map[string]any{"code": float64(404), "error": "user not found"},
+		},
+		{
+			name: "Sad path - GetPackages returns error",
+			setup: func() {
+				s.mockUserService.On("GetPublicProfile", s.mockContext, "goravel").Return(profile, nil).Once()
+				s.mockRequest.On("QueryInt", "pagination.page", 0).Return(0).Once()
+				s.mockRequest.On("QueryInt", "pagination.limit", 0).Return(0).Once()
+				s.mockRequest.On("Query", "pagination.cursor", "").Return("").Once()
+				s.mockRequest.On("Query", "query.sort", "").Return("").Once()
+				s.mockRequest.On("Query", "query.direction", "").Return("").Once()
+				s.mockPackageService.On("GetPackages", s.mockContext, mock.AnythingOfType("*_package.GetPackagesRequest")).
+					Return(nil, utilserrors.NewBadRequest("invalid sort")).Once()
+			},
+			expectStatus: map[string]any{"code": float64(400), "error": "invalid sort"},
+		},
+		{
+			name: "Sad path - GetPublicProfile returns unknown error",
+			setup: func() {
+				s.mockUserService.On("GetPublicProfile", s.mockContext, "goravel").Return(nil, errors.New("error")).Once()
+			},
+			expectStatus: map[string]any{"code": float64(500), "error": "error"},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			s.mockContext.On("Request").Return(s.mockRequest)
+			s.mockContext.On("Response").Return(s.mockResponse).Once()
+			s.mockRequest.On("Route", "id").Return("goravel").Once()
+			s.mockResponse.On("Success").Return(s.mockResponseStatus).Once()
+			var body any
+			s.mockResponseStatus.On("Json", mock.Anything).Run(func(args mock.Arguments) {
+				body = args.Get(0)
+			}).Return(nil).Once()
+			test.setup()
+
+			s.userController.Packages(s.mockContext)
+
+			if test.expectStatus != nil {
+				data, err := json.Marshal(body)
+				s.Nil(err)
+				var response map[string]map[string]any
+				s.Nil(json.Unmarshal(data, &response))
+				s.Equal(test.expectStatus, response["status"])
+			} else {
+				data, ok := body.(map[string]json.RawMessage)
+				s.True(ok)
+
+				var profile protouser.PublicProfile
+				s.Nil(protojson.Unmarshal(data["profile"], &profile))
+				s.True(proto.Equal(test.expectProfile, &profile))
+
+				delete(data, "profile")
+				packages, err := json.Marshal(data)
+				s.Nil(err)
+				var response protopackage.GetPackagesResponse
+				s.Nil(protojson.Unmarshal(packages, &response))
+				s.True(proto.Equal(test.expectPackages, &response))
+			}
+
+			s.mockUserService.AssertExpectations(s.T())
+			s.mockPackageService.AssertExpectations(s.T())
+			s.mockRequest.AssertExpectations(s.T())
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/gateway"
+)
+
+// Fingerprint injects a fingerprint of the client, it's used to identify anonymous clients, e.g. to deduplicate
+// package views.
+func Fingerprint() http.Middleware {
+	return func(ctx http.Context) {
+		// The fingerprint can only be injected by the gateway, never by the caller.
+		query := ctx.Request().Origin().URL.Query()
+		query.Del("fingerprint")
+		ctx.Request().Origin().URL.RawQuery = query.Encode()
+
+		hash := sha256.Sum256([]byte(ctx.Request().Ip() + "|" + ctx.Request().Header("User-Agent", "")))
+		gateway.Inject(ctx, "fingerprint", hex.EncodeToString(hash[:16]))
+
+		ctx.Request().Next()
+	}
+}
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/gateway"
+
+	"market.goravel.dev/gateway/app/services"
+)
+
+// OptionalJwt injects the current user if the request carries a token, and lets anonymous requests go through.
+func OptionalJwt(userService services.User) http.Middleware {
+	return func(ctx http.Context) {
+		// The user_id can only be injected by the gateway, never by the caller.
+		query := ctx.Request().Origin().URL.Query()
+		query.Del("user_id")
+		ctx.Request().Origin().URL.RawQuery = query.Encode()
+
+		token := ctx.Request().Header("Authorization", "")
+		if token == "" {
+			ctx.Request().Next()
+			return
+		}
+
+		user, err := userService.GetUserByToken(ctx, token)
+		if err != nil {
+			facades.Log().Request(ctx.Request()).Errorf("get user err: %+v", err)
+			ctx.Request().AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+
+		gateway.Inject(ctx, "user_id", user.GetId())
+		gateway.Inject(ctx, "user_name", user.GetName())
+
+		ctx.Request().Next()
+	}
+}
@@ -25,6 +25,7 @@ func (receiver *RouteServiceProvider) Boot(app foundation.Application) {
 
 	routes.Users()
 	routes.Packages()
+	routes.Organizations()
 }
 
 func (receiver *RouteServiceProvider) configureRateLimiting() {
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/goravel/framework/facades"
+
+	protopackage "market.goravel.dev/proto/package"
+	utilserrors "market.goravel.dev/utils/errors"
+)
+
+var packageInstance *PackageImpl
+var packageOnce sync.Once
+var _ Package = (*PackageImpl)(nil)
+
+type Package interface {
+	GetPackages(ctx context.Context, req *protopackage.GetPackagesRequest) (*protopackage.GetPackagesResponse, error)
+}
+
+type PackageImpl struct {
+	client  protopackage.PackageServiceClient
+	timeout int
+}
+
+func NewPackageImpl() *PackageImpl {
+	packageOnce.Do(func() {
+		client, err := facades.Grpc().Client(context.Background(), "package")
+		if err != nil {
+			panic(fmt.Sprintf("init PackageService err: %+v", err))
+		}
+
+		packageInstance = &PackageImpl{
+			client:  protopackage.NewPackageServiceClient(client),
+			timeout: 10,
+		}
+	})
+
+	return packageInstance
+}
+
+// GetPackages gets the packages from the package service, the status of the failed response is returned as an
+// error with its code.
+func (r *PackageImpl) GetPackages(ctx context.Context, req *protopackage.GetPackagesRequest) (*protopackage.GetPackagesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(r.timeout)*time.Second)
+	defer cancel()
+
+	response, err := r.client.GetPackages(ctx, req)
+	if err := utilserrors.NewResponse(response, err); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
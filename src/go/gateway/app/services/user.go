@@ -11,6 +11,7 @@ import (
 	"github.com/goravel/framework/facades"
 
 	protouser "market.goravel.dev/proto/user"
+	utilserrors "market.goravel.dev/utils/errors"
 )
 
 var userInstance *UserImpl
@@ -18,6 +19,7 @@ var userOnce sync.Once
 var _ User = (*UserImpl)(nil)
 
 type User interface {
+	GetPublicProfile(ctx context.Context, id string) (*protouser.PublicProfile, error)
 	GetUserByToken(ctx context.Context, token string) (*protouser.User, error)
 }
 
@@ -42,6 +44,22 @@ func NewUserImpl() *UserImpl {
 	return userInstance
 }
 
+// GetPublicProfile gets the public profile of the user by the ID or the username, the status of the failed response is
+// returned as an error with its code.
+func (r *UserImpl) GetPublicProfile(ctx context.Context, id string) (*protouser.PublicProfile, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(r.timeout)*time.Second)
+	defer cancel()
+
+	response, err := r.client.GetPublicProfile(ctx, &protouser.GetPublicProfileRequest{
+		Id: id,
+	})
+	if err := utilserrors.NewResponse(response, err); err != nil {
+		return nil, err
+	}
+
+	return response.Profile, nil
+}
+
 func (r *UserImpl) GetUserByToken(ctx context.Context, token string) (*protouser.User, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Duration(r.timeout)*time.Second)
 	defer cancel()
@@ -21,7 +21,11 @@ func main() {
 	}()
 
 	go func() {
-		mux := runtime.NewServeMux(runtime.WithForwardResponseOption(interceptors.Token))
+		mux := runtime.NewServeMux(
+			runtime.WithForwardResponseOption(interceptors.Token),
+			runtime.WithForwardResponseOption(interceptors.ETag),
+			runtime.WithIncomingHeaderMatcher(interceptors.Header),
+		)
 		if err := gatewayfacades.Gateway().Run(mux); err != nil {
 			facades.Log().Errorf("Gateway run error: %v", err)
 		}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/gateway"
+
+	"market.goravel.dev/gateway/app/http/middleware"
+	"market.goravel.dev/gateway/app/services"
+)
+
+func Organizations() {
+	userService := services.NewUserImpl()
+
+	facades.Route().Middleware(middleware.Jwt(userService)).Post("/organizations", gateway.Post)
+	facades.Route().Middleware(middleware.Jwt(userService)).Post("/organizations/invitations/accept", gateway.Post)
+	facades.Route().Middleware(middleware.OptionalJwt(userService)).Get("/organizations/{id}", gateway.Get)
+	facades.Route().Middleware(middleware.OptionalJwt(userService)).Get("/organizations/{id}/members", gateway.Get)
+	facades.Route().Middleware(middleware.Jwt(userService)).Post("/organizations/{id}/invitations", gateway.Post)
+}
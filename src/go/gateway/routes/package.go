@@ -11,9 +11,43 @@ import (
 func Packages() {
 	userService := services.NewUserImpl()
 
-	facades.Route().Get("/packages", gateway.Get)
-	facades.Route().Get("/packages/tags", gateway.Get)
-	facades.Route().Middleware(middleware.Jwt(userService)).Get("/packages/{id}", gateway.Get)
+	facades.Route().Middleware(middleware.OptionalJwt(userService)).Get("/packages", gateway.Get)
+	facades.Route().Middleware(middleware.OptionalJwt(userService)).Get("/packages/tags", gateway.Get)
+	facades.Route().Middleware(middleware.Jwt(userService)).Get("/packages/pending", gateway.Get)
+	facades.Route().Middleware(middleware.Jwt(userService)).Post("/packages/tags", gateway.Post)
+	facades.Route().Middleware(middleware.Jwt(userService)).Put("/packages/tags/{id}", gateway.Put)
+	facades.Route().Middleware(middleware.Jwt(userService)).Post("/packages/tags/{id}/merge", gateway.Post)
+	facades.Route().Middleware(middleware.Jwt(userService)).Post("/packages/tags/{id}/aliases", gateway.Post)
+	facades.Route().Middleware(middleware.Jwt(userService)).Delete("/packages/tags/{id}/aliases/{name}", gateway.Delete)
+	facades.Route().Middleware(middleware.Jwt(userService)).Get("/packages/favorites", gateway.Get)
+	facades.Route().Middleware(middleware.OptionalJwt(userService), middleware.Fingerprint()).Get("/packages/slugs/{slug}", gateway.Get)
+	facades.Route().Middleware(middleware.Jwt(userService), middleware.Fingerprint()).Get("/packages/{id}", gateway.Get)
 	facades.Route().Middleware(middleware.Jwt(userService)).Put("/packages/{id}", gateway.Put)
+	facades.Route().Middleware(middleware.Jwt(userService)).Patch("/packages/{id}", gateway.Patch)
+	facades.Route().Middleware(middleware.Jwt(userService)).Delete("/packages/{id}", gateway.Delete)
 	facades.Route().Middleware(middleware.Jwt(userService)).Post("/packages", gateway.Post)
+	facades.Route().Middleware(middleware.Jwt(userService)).Post("/packages/{id}/approve", gateway.Post)
+	facades.Route().Middleware(middleware.Jwt(userService)).Post("/packages/{id}/reject", gateway.Post)
+	facades.Route().Middleware(middleware.Jwt(userService)).Post("/packages/{id}/restore", gateway.Post)
+	facades.Route().Middleware(middleware.Jwt(userService)).Post("/packages/{id}/sync", gateway.Post)
+	facades.Route().Middleware(middleware.Jwt(userService)).Post("/packages/{id}/cover", gateway.Post)
+	facades.Route().Middleware(middleware.Jwt(userService)).Post("/packages/{id}/favorite", gateway.Post)
+	facades.Route().Middleware(middleware.Jwt(userService)).Delete("/packages/{id}/favorite", gateway.Delete)
+	facades.Route().Middleware(middleware.OptionalJwt(userService)).Get("/packages/{id}/maintainers", gateway.Get)
+	facades.Route().Middleware(middleware.Jwt(userService)).Post("/packages/{id}/maintainers", gateway.Post)
+	facades.Route().Middleware(middleware.Jwt(userService)).Delete("/packages/{id}/maintainers/{maintainer_id}", gateway.Delete)
+	facades.Route().Middleware(middleware.Jwt(userService)).Post("/packages/{id}/transfer", gateway.Post)
+	facades.Route().Middleware(middleware.Jwt(userService)).Post("/packages/{id}/transfer/accept", gateway.Post)
+	facades.Route().Middleware(middleware.Jwt(userService)).Post("/packages/{id}/transfer/decline", gateway.Post)
+	facades.Route().Middleware(middleware.OptionalJwt(userService)).Get("/packages/{id}/releases", gateway.Get)
+	facades.Route().Middleware(middleware.OptionalJwt(userService)).Get("/packages/{id}/releases/{version}", gateway.Get)
+	facades.Route().Middleware(middleware.Jwt(userService)).Post("/packages/{id}/releases", gateway.Post)
+	facades.Route().Middleware(middleware.OptionalJwt(userService)).Get("/packages/{id}/reviews", gateway.Get)
+	facades.Route().Middleware(middleware.Jwt(userService)).Post("/packages/{id}/reviews", gateway.Post)
+	facades.Route().Middleware(middleware.Jwt(userService)).Put("/packages/{id}/reviews/{review_id}", gateway.Put)
+	facades.Route().Middleware(middleware.Jwt(userService)).Delete("/packages/{id}/reviews/{review_id}", gateway.Delete)
+	facades.Route().Middleware(middleware.OptionalJwt(userService)).Get("/packages/{id}/comments", gateway.Get)
+	facades.Route().Middleware(middleware.Jwt(userService)).Post("/packages/{id}/comments", gateway.Post)
+	facades.Route().Middleware(middleware.Jwt(userService)).Put("/packages/{id}/comments/{comment_id}", gateway.Put)
+	facades.Route().Middleware(middleware.Jwt(userService)).Delete("/packages/{id}/comments/{comment_id}", gateway.Delete)
 }
@@ -5,6 +5,7 @@ import (
 	httpmiddleware "github.com/goravel/framework/http/middleware"
 	"github.com/goravel/gateway"
 
+	"market.goravel.dev/gateway/app/http/controllers"
 	"market.goravel.dev/gateway/app/http/middleware"
 	"market.goravel.dev/gateway/app/services"
 )
@@ -16,5 +17,9 @@ func Users() {
 	facades.Route().Post("/users/email/register", gateway.Post)
 	facades.Route().Middleware(httpmiddleware.Throttle("VerifyCode")).Get("/users/email/register/code", gateway.Get)
 	facades.Route().Middleware(middleware.Jwt(userService)).Get("/users/self", gateway.Get)
+	facades.Route().Get("/users/{id}/profile", gateway.Get)
+	facades.Route().Get("/users/{id}/packages", controllers.NewUserController().Packages)
 	facades.Route().Middleware(middleware.Jwt(userService)).Put("/users/{id}", gateway.Put)
+	facades.Route().Middleware(middleware.Jwt(userService)).Patch("/users/{id}", gateway.Patch)
+	facades.Route().Middleware(middleware.Jwt(userService)).Post("/users/{id}/avatar", gateway.Post)
 }
@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+
+	"market.goravel.dev/package/app/models"
+)
+
+type BackfillPackageSlugs struct {
+	packageModel models.PackageInterface
+}
+
+func NewBackfillPackageSlugs() *BackfillPackageSlugs {
+	return &BackfillPackageSlugs{
+		packageModel: models.NewPackage(),
+	}
+}
+
+// Signature The name and signature of the console command.
+func (r *BackfillPackageSlugs) Signature() string {
+	return "package:backfill-slugs"
+}
+
+// Description The console command description.
+func (r *BackfillPackageSlugs) Description() string {
+	return "Generate the slugs of the packages created before the packages had slugs"
+}
+
+// Extend The console command extend.
+func (r *BackfillPackageSlugs) Extend() command.Extend {
+	return command.Extend{
+		Category: "package",
+		Flags: []command.Flag{
+			&command.IntFlag{
+				Name:  "batch-size",
+				Value: 100,
+				Usage: "The number of packages loaded at a time",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (r *BackfillPackageSlugs) Handle(ctx console.Context) error {
+	batchSize := ctx.OptionInt("batch-size")
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+
+	count, err := r.packageModel.BackfillSlugs(batchSize)
+	if err != nil {
+		ctx.Error(fmt.Sprintf("Backfill package slugs error: %v", err))
+
+		return err
+	}
+
+	ctx.Info(fmt.Sprintf("Backfilled the slugs of %d packages", count))
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"errors"
+	"testing"
+
+	mocksconsole "github.com/goravel/framework/mocks/console"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	mocksmodels "market.goravel.dev/package/app/mocks/models"
+)
+
+type BackfillPackageSlugsTestSuite struct {
+	suite.Suite
+	mockContext      *mocksconsole.Context
+	mockPackageModel *mocksmodels.PackageInterface
+	command          *BackfillPackageSlugs
+}
+
+func TestBackfillPackageSlugsTestSuite(t *testing.T) {
+	suite.Run(t, new(BackfillPackageSlugsTestSuite))
+}
+
+func (s *BackfillPackageSlugsTestSuite) SetupTest() {
+	s.mockContext = &mocksconsole.Context{}
+	s.mockPackageModel = &mocksmodels.PackageInterface{}
+	s.command = &BackfillPackageSlugs{
+		packageModel: s.mockPackageModel,
+	}
+}
+
+func (s *BackfillPackageSlugsTestSuite) TestHandle() {
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockContext.On("OptionInt", "batch-size").Return(50).Once()
+				s.mockPackageModel.On("BackfillSlugs", 50).Return(3, nil).Once()
+				s.mockContext.On("Info", "Backfilled the slugs of 3 packages").Once()
+			},
+		},
+		{
+			name: "Happy path - invalid batch size falls back to the default",
+			setup: func() {
+				s.mockContext.On("OptionInt", "batch-size").Return(0).Once()
+				s.mockPackageModel.On("BackfillSlugs", 100).Return(0, nil).Once()
+				s.mockContext.On("Info", "Backfilled the slugs of 0 packages").Once()
+			},
+		},
+		{
+			name: "Sad path - BackfillSlugs returns error",
+			setup: func() {
+				s.mockContext.On("OptionInt", "batch-size").Return(100).Once()
+				s.mockPackageModel.On("BackfillSlugs", 100).Return(1, errors.New("error")).Once()
+				s.mockContext.On("Error", mock.Anything).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			s.Equal(test.expectedErr, s.command.Handle(s.mockContext))
+
+			s.mockContext.AssertExpectations(s.T())
+			s.mockPackageModel.AssertExpectations(s.T())
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+
+	"market.goravel.dev/package/app/services"
+)
+
+type FlushPackageViews struct {
+	packageViewService services.PackageView
+}
+
+func NewFlushPackageViews() *FlushPackageViews {
+	return &FlushPackageViews{
+		packageViewService: services.NewPackageViewImpl(),
+	}
+}
+
+// Signature The name and signature of the console command.
+func (r *FlushPackageViews) Signature() string {
+	return "package:flush-views"
+}
+
+// Description The console command description.
+func (r *FlushPackageViews) Description() string {
+	return "Write the package views buffered in the cache to the database"
+}
+
+// Extend The console command extend.
+func (r *FlushPackageViews) Extend() command.Extend {
+	return command.Extend{
+		Category: "package",
+	}
+}
+
+// Handle Execute the console command.
+func (r *FlushPackageViews) Handle(ctx console.Context) error {
+	count, err := r.packageViewService.FlushViews()
+	if err != nil {
+		ctx.Error(fmt.Sprintf("Flush package views error: %v", err))
+
+		return err
+	}
+
+	ctx.Info(fmt.Sprintf("Flushed the views of %d packages", count))
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"errors"
+	"testing"
+
+	mocksconsole "github.com/goravel/framework/mocks/console"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	mocksservices "market.goravel.dev/package/app/mocks/services"
+)
+
+type FlushPackageViewsTestSuite struct {
+	suite.Suite
+	mockContext            *mocksconsole.Context
+	mockPackageViewService *mocksservices.PackageView
+	command                *FlushPackageViews
+}
+
+func TestFlushPackageViewsTestSuite(t *testing.T) {
+	suite.Run(t, new(FlushPackageViewsTestSuite))
+}
+
+func (s *FlushPackageViewsTestSuite) SetupTest() {
+	s.mockContext = &mocksconsole.Context{}
+	s.mockPackageViewService = &mocksservices.PackageView{}
+	s.command = &FlushPackageViews{
+		packageViewService: s.mockPackageViewService,
+	}
+}
+
+func (s *FlushPackageViewsTestSuite) TestHandle() {
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockPackageViewService.On("FlushViews").Return(2, nil).Once()
+				s.mockContext.On("Info", "Flushed the views of 2 packages").Once()
+			},
+		},
+		{
+			name: "Sad path - FlushViews returns error",
+			setup: func() {
+				s.mockPackageViewService.On("FlushViews").Return(0, errors.New("error")).Once()
+				s.mockContext.On("Error", mock.Anything).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			s.Equal(test.expectedErr, s.command.Handle(s.mockContext))
+
+			s.mockContext.AssertExpectations(s.T())
+			s.mockPackageViewService.AssertExpectations(s.T())
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/support/carbon"
+
+	"market.goravel.dev/package/app/models"
+	"market.goravel.dev/package/app/services"
+)
+
+type PurgeDeletedPackages struct {
+	packageCoverService services.PackageCover
+	packageModel        models.PackageInterface
+}
+
+func NewPurgeDeletedPackages() *PurgeDeletedPackages {
+	return &PurgeDeletedPackages{
+		packageCoverService: services.NewPackageCoverImpl(),
+		packageModel:        models.NewPackage(),
+	}
+}
+
+// Signature The name and signature of the console command.
+func (r *PurgeDeletedPackages) Signature() string {
+	return "package:purge-deleted"
+}
+
+// Description The console command description.
+func (r *PurgeDeletedPackages) Description() string {
+	return "Permanently delete the packages that have been soft deleted for longer than the retention period"
+}
+
+// Extend The console command extend.
+func (r *PurgeDeletedPackages) Extend() command.Extend {
+	return command.Extend{
+		Category: "package",
+	}
+}
+
+// Handle Execute the console command.
+func (r *PurgeDeletedPackages) Handle(ctx console.Context) error {
+	days := facades.Config().GetInt("package.deleted_retention_days", 30)
+	deletedBefore := carbon.Now().SubDays(days).StdTime()
+
+	packages, err := r.packageModel.PurgeDeletedPackages(deletedBefore)
+	if err != nil {
+		ctx.Error(fmt.Sprintf("Purge deleted packages error: %v", err))
+
+		return err
+	}
+
+	// The files are deleted after the packages are purged, a failed purge keeps the covers of the packages.
+	r.packageCoverService.DeleteCovers(packages)
+
+	ctx.Info(fmt.Sprintf("Purged %d packages deleted before %s", len(packages), deletedBefore.Format("2006-01-02 15:04:05")))
+
+	return nil
+}
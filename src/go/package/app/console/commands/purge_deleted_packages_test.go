@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mocksconsole "github.com/goravel/framework/mocks/console"
+	"github.com/goravel/framework/support/carbon"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	mocksmodels "market.goravel.dev/package/app/mocks/models"
+	mocksservices "market.goravel.dev/package/app/mocks/services"
+	"market.goravel.dev/package/app/models"
+)
+
+type PurgeDeletedPackagesTestSuite struct {
+	suite.Suite
+	mockConfig              *mocksconfig.Config
+	mockContext             *mocksconsole.Context
+	mockPackageCoverService *mocksservices.PackageCover
+	mockPackageModel        *mocksmodels.PackageInterface
+	command                 *PurgeDeletedPackages
+}
+
+func TestPurgeDeletedPackagesTestSuite(t *testing.T) {
+	suite.Run(t, new(PurgeDeletedPackagesTestSuite))
+}
+
+func (s *PurgeDeletedPackagesTestSuite) SetupTest() {
+	mockFactory := testingmock.Factory()
+	s.mockConfig = mockFactory.Config()
+	s.mockContext = &mocksconsole.Context{}
+	s.mockPackageCoverService = &mocksservices.PackageCover{}
+	s.mockPackageModel = &mocksmodels.PackageInterface{}
+	s.command = &PurgeDeletedPackages{
+		packageCoverService: s.mockPackageCoverService,
+		packageModel:        s.mockPackageModel,
+	}
+}
+
+func (s *PurgeDeletedPackagesTestSuite) TearDownTest() {
+	carbon.UnsetTestNow()
+}
+
+func (s *PurgeDeletedPackagesTestSuite) TestHandle() {
+	now := carbon.Parse("2024-02-01 00:00:00")
+	carbon.SetTestNow(now)
+	deletedBefore := mock.MatchedBy(func(t time.Time) bool {
+		return t.Equal(carbon.Parse("2024-01-02 00:00:00").StdTime())
+	})
+	packages := []*models.Package{
+		{UUIDModel: models.UUIDModel{ID: 1}, Cover: "https://goravel.dev/storage/covers/1/cover.png"},
+		{UUIDModel: models.UUIDModel{ID: 2}},
+	}
+
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockConfig.On("GetInt", "package.deleted_retention_days", 30).Return(30).Once()
+				s.mockPackageModel.On("PurgeDeletedPackages", deletedBefore).Return(packages, nil).Once()
+				s.mockPackageCoverService.On("DeleteCovers", packages).Once()
+				s.mockContext.On("Info", "Purged 2 packages deleted before 2024-01-02 00:00:00").Once()
+			},
+		},
+		{
+			name: "Sad path - PurgeDeletedPackages returns error",
+			setup: func() {
+				s.mockConfig.On("GetInt", "package.deleted_retention_days", 30).Return(30).Once()
+				s.mockPackageModel.On("PurgeDeletedPackages", deletedBefore).Return(nil, errors.New("error")).Once()
+				s.mockContext.On("Error", mock.Anything).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			s.Equal(test.expectedErr, s.command.Handle(s.mockContext))
+
+			s.mockConfig.AssertExpectations(s.T())
+			s.mockContext.AssertExpectations(s.T())
+			s.mockPackageCoverService.AssertExpectations(s.T())
+			s.mockPackageModel.AssertExpectations(s.T())
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+
+	"market.goravel.dev/package/app/services"
+)
+
+type SyncPackages struct {
+	packageSyncService services.PackageSync
+}
+
+func NewSyncPackages() *SyncPackages {
+	return &SyncPackages{
+		packageSyncService: services.NewPackageSyncImpl(),
+	}
+}
+
+// Signature The name and signature of the console command.
+func (r *SyncPackages) Signature() string {
+	return "package:sync"
+}
+
+// Description The console command description.
+func (r *SyncPackages) Description() string {
+	return "Sync the metadata of the packages from their GitHub repositories"
+}
+
+// Extend The console command extend.
+func (r *SyncPackages) Extend() command.Extend {
+	return command.Extend{
+		Category: "package",
+	}
+}
+
+// Handle Execute the console command.
+func (r *SyncPackages) Handle(ctx console.Context) error {
+	count, err := r.packageSyncService.SyncPackages(context.Background())
+	if err != nil {
+		ctx.Error(fmt.Sprintf("Sync packages error: %v", err))
+
+		return err
+	}
+
+	ctx.Info(fmt.Sprintf("Synced %d packages", count))
+
+	return nil
+}
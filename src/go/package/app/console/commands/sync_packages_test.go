@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"errors"
+	"testing"
+
+	mocksconsole "github.com/goravel/framework/mocks/console"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	mocksservices "market.goravel.dev/package/app/mocks/services"
+)
+
+type SyncPackagesTestSuite struct {
+	suite.Suite
+	mockContext            *mocksconsole.Context
+	mockPackageSyncService *mocksservices.PackageSync
+	command                *SyncPackages
+}
+
+func TestSyncPackagesTestSuite(t *testing.T) {
+	suite.Run(t, new(SyncPackagesTestSuite))
+}
+
+func (s *SyncPackagesTestSuite) SetupTest() {
+	s.mockContext = &mocksconsole.Context{}
+	s.mockPackageSyncService = &mocksservices.PackageSync{}
+	s.command = &SyncPackages{
+		packageSyncService: s.mockPackageSyncService,
+	}
+}
+
+func (s *SyncPackagesTestSuite) TestHandle() {
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockPackageSyncService.On("SyncPackages", mock.Anything).Return(2, nil).Once()
+				s.mockContext.On("Info", "Synced 2 packages").Once()
+			},
+		},
+		{
+			name: "Sad path - SyncPackages returns error",
+			setup: func() {
+				s.mockPackageSyncService.On("SyncPackages", mock.Anything).Return(0, errors.New("error")).Once()
+				s.mockContext.On("Error", mock.Anything).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			s.Equal(test.expectedErr, s.command.Handle(s.mockContext))
+
+			s.mockContext.AssertExpectations(s.T())
+			s.mockPackageSyncService.AssertExpectations(s.T())
+		})
+	}
+}
@@ -3,15 +3,27 @@ package console
 import (
 	"github.com/goravel/framework/contracts/console"
 	"github.com/goravel/framework/contracts/schedule"
+	"github.com/goravel/framework/facades"
+
+	"market.goravel.dev/package/app/console/commands"
 )
 
 type Kernel struct {
 }
 
 func (kernel *Kernel) Schedule() []schedule.Event {
-	return []schedule.Event{}
+	return []schedule.Event{
+		facades.Schedule().Command("package:flush-views").EveryMinute(),
+		facades.Schedule().Command("package:purge-deleted").Daily(),
+		facades.Schedule().Command("package:sync").Hourly(),
+	}
 }
 
 func (kernel *Kernel) Commands() []console.Command {
-	return []console.Command{}
+	return []console.Command{
+		commands.NewBackfillPackageSlugs(),
+		commands.NewFlushPackageViews(),
+		commands.NewPurgeDeletedPackages(),
+		commands.NewSyncPackages(),
+	}
 }
@@ -0,0 +1,168 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/goravel/framework/facades"
+	"golang.org/x/mod/semver"
+
+	"market.goravel.dev/utils/errors"
+)
+
+// maxReadmeSize is the max size of the README that is stored, the rest is truncated.
+const maxReadmeSize = 1 << 20
+
+// RepositoryPattern matches the links of GitHub repositories, it's also a valid PostgreSQL regular expression, so the
+// links can be filtered in the database.
+const RepositoryPattern = `(?i)^(?:https?://)?(?:www\.)?github\.com/([a-z0-9-]+)/([a-z0-9._-]+?)(?:\.git)?/?(?:[/?#].*)?$`
+
+var repositoryRegexp = regexp.MustCompile(RepositoryPattern)
+
+// Repository is the metadata of a GitHub repository.
+type Repository struct {
+	Stars        uint32
+	License      string
+	LatestTag    string
+	LastCommitAt time.Time
+	Readme       string
+}
+
+// Client fetches the metadata of the GitHub repositories through the GitHub REST API.
+type Client interface {
+	GetRepository(ctx context.Context, owner, name string) (*Repository, error)
+}
+
+type ClientImpl struct {
+	baseURL string
+	client  *http.Client
+	token   string
+}
+
+func NewClientImpl() *ClientImpl {
+	return &ClientImpl{
+		baseURL: facades.Config().GetString("package.github.base_url", "https://api.github.com"),
+		client:  &http.Client{Timeout: 10 * time.Second},
+		token:   facades.Config().GetString("package.github.token"),
+	}
+}
+
+func (r *ClientImpl) GetRepository(ctx context.Context, owner, name string) (*Repository, error) {
+	path := fmt.Sprintf("/repos/%s/%s", owner, name)
+
+	var response struct {
+		StargazersCount uint32 `json:"stargazers_count"`
+		License         *struct {
+			SpdxID string `json:"spdx_id"`
+		} `json:"license"`
+	}
+	exist, err := r.get(ctx, path, "application/vnd.github+json", func(body io.Reader) error {
+		return json.NewDecoder(body).Decode(&response)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errors.NewNotFound(facades.Lang(ctx).Get("not_exist.github_repository"))
+	}
+
+	repository := &Repository{
+		Stars: response.StargazersCount,
+	}
+	if response.License != nil {
+		repository.License = response.License.SpdxID
+	}
+
+	// The tags aren't sorted by version, the latest one is the max semantic version.
+	var tags []struct {
+		Name string `json:"name"`
+	}
+	if _, err := r.get(ctx, path+"/tags?per_page=100", "application/vnd.github+json", func(body io.Reader) error {
+		return json.NewDecoder(body).Decode(&tags)
+	}); err != nil {
+		return nil, err
+	}
+	for _, tag := range tags {
+		if semver.IsValid(tag.Name) && semver.Compare(tag.Name, repository.LatestTag) > 0 {
+			repository.LatestTag = tag.Name
+		}
+	}
+
+	var commits []struct {
+		Commit struct {
+			Committer struct {
+				Date time.Time `json:"date"`
+			} `json:"committer"`
+		} `json:"commit"`
+	}
+	if _, err := r.get(ctx, path+"/commits?per_page=1", "application/vnd.github+json", func(body io.Reader) error {
+		return json.NewDecoder(body).Decode(&commits)
+	}); err != nil {
+		return nil, err
+	}
+	if len(commits) > 0 {
+		repository.LastCommitAt = commits[0].Commit.Committer.Date
+	}
+
+	if _, err := r.get(ctx, path+"/readme", "application/vnd.github.raw", func(body io.Reader) error {
+		readme, err := io.ReadAll(io.LimitReader(body, maxReadmeSize))
+		repository.Readme = string(readme)
+
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return repository, nil
+}
+
+// get sends a GET request to the GitHub API and passes the response body to handle, it returns false if the resource
+// doesn't exist. An empty repository responds 409 to the commit requests, it's treated as not existing as well.
+func (r *ClientImpl) get(ctx context.Context, path, accept string, handle func(body io.Reader) error) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.baseURL+path, nil)
+	if err != nil {
+		return false, errors.NewInternalServerError(err)
+	}
+
+	req.Header.Set("Accept", accept)
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if r.token != "" {
+		req.Header.Set("Authorization", "Bearer "+r.token)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return false, errors.NewInternalServerError(err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound, http.StatusConflict:
+		return false, nil
+	default:
+		return false, errors.NewInternalServerError(fmt.Errorf("request GitHub %s failed with status %d", path, resp.StatusCode))
+	}
+
+	if err := handle(resp.Body); err != nil {
+		return false, errors.NewInternalServerError(err)
+	}
+
+	return true, nil
+}
+
+// ParseRepository gets the owner and name of the repository from a GitHub link, e.g.
+// https://github.com/goravel/framework, it returns false if the link isn't a GitHub repository.
+func ParseRepository(link string) (string, string, bool) {
+	matches := repositoryRegexp.FindStringSubmatch(link)
+	if matches == nil {
+		return "", "", false
+	}
+
+	return matches[1], matches[2], true
+}
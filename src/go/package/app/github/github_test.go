@@ -0,0 +1,164 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockstranslation "github.com/goravel/framework/mocks/translation"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	utilserrors "market.goravel.dev/utils/errors"
+)
+
+type ClientTestSuite struct {
+	suite.Suite
+	ctx      context.Context
+	mockLang *mockstranslation.Translator
+	handlers map[string]http.HandlerFunc
+	server   *httptest.Server
+	client   *ClientImpl
+}
+
+func TestClientTestSuite(t *testing.T) {
+	suite.Run(t, new(ClientTestSuite))
+}
+
+func (s *ClientTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	mockFactory := testingmock.Factory()
+	s.mockLang = mockFactory.Lang(s.ctx)
+	mockFactory.Log()
+
+	// The stub server serves the GitHub API, the paths that don't have a handler respond 404.
+	s.handlers = make(map[string]http.HandlerFunc)
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if handler, ok := s.handlers[req.URL.Path]; ok {
+			handler(w, req)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	s.client = &ClientImpl{
+		baseURL: s.server.URL,
+		client:  s.server.Client(),
+		token:   "token",
+	}
+}
+
+func (s *ClientTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *ClientTestSuite) json(body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		s.Equal("Bearer token", req.Header.Get("Authorization"))
+		s.Equal("application/vnd.github+json", req.Header.Get("Accept"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, body)
+	}
+}
+
+func (s *ClientTestSuite) TestGetRepository() {
+	tests := []struct {
+		name             string
+		setup            func()
+		expectRepository *Repository
+		expectedErr      error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.handlers["/repos/goravel/framework"] = s.json(`{"stargazers_count": 2000, "license": {"spdx_id": "MIT"}}`)
+				s.handlers["/repos/goravel/framework/tags"] = s.json(`[{"name": "v1.9.0"}, {"name": "v1.10.0"}, {"name": "nightly"}]`)
+				s.handlers["/repos/goravel/framework/commits"] = s.json(`[{"commit": {"committer": {"date": "2024-01-02T03:04:05Z"}}}]`)
+				s.handlers["/repos/goravel/framework/readme"] = func(w http.ResponseWriter, req *http.Request) {
+					s.Equal("application/vnd.github.raw", req.Header.Get("Accept"))
+					_, _ = fmt.Fprint(w, "# Goravel")
+				}
+			},
+			expectRepository: &Repository{
+				Stars:        2000,
+				License:      "MIT",
+				LatestTag:    "v1.10.0",
+				LastCommitAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+				Readme:       "# Goravel",
+			},
+		},
+		{
+			name: "Happy path - empty repository",
+			setup: func() {
+				s.handlers["/repos/goravel/framework"] = s.json(`{"stargazers_count": 0, "license": null}`)
+				s.handlers["/repos/goravel/framework/tags"] = s.json(`[]`)
+				s.handlers["/repos/goravel/framework/commits"] = func(w http.ResponseWriter, req *http.Request) {
+					w.WriteHeader(http.StatusConflict)
+				}
+			},
+			expectRepository: &Repository{},
+		},
+		{
+			name: "Sad path - Repository does not exist",
+			setup: func() {
+				s.mockLang.On("Get", "not_exist.github_repository").Return("repository not exist").Once()
+			},
+			expectedErr: utilserrors.NewNotFound("repository not exist"),
+		},
+		{
+			name: "Sad path - Rate limit exceeded",
+			setup: func() {
+				s.handlers["/repos/goravel/framework"] = func(w http.ResponseWriter, req *http.Request) {
+					w.WriteHeader(http.StatusForbidden)
+				}
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "request GitHub /repos/goravel/framework failed with status 403"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			defer s.TearDownTest()
+			test.setup()
+
+			repository, err := s.client.GetRepository(s.ctx, "goravel", "framework")
+			s.Equal(test.expectRepository, repository)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
+
+func TestParseRepository(t *testing.T) {
+	tests := []struct {
+		link        string
+		expectOwner string
+		expectName  string
+		expectOk    bool
+	}{
+		{link: "https://github.com/goravel/framework", expectOwner: "goravel", expectName: "framework", expectOk: true},
+		{link: "http://www.github.com/goravel/framework/", expectOwner: "goravel", expectName: "framework", expectOk: true},
+		{link: "github.com/goravel/framework.git", expectOwner: "goravel", expectName: "framework", expectOk: true},
+		{link: "https://github.com/goravel/goravel.dev/tree/master/docs", expectOwner: "goravel", expectName: "goravel.dev", expectOk: true},
+		{link: "https://github.com/goravel", expectOk: false},
+		{link: "https://gitlab.com/goravel/framework", expectOk: false},
+		{link: "https://goravel.dev", expectOk: false},
+		{link: "", expectOk: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.link, func(t *testing.T) {
+			owner, name, ok := ParseRepository(test.link)
+			assert.Equal(t, test.expectOwner, owner)
+			assert.Equal(t, test.expectName, name)
+			assert.Equal(t, test.expectOk, ok)
+		})
+	}
+}
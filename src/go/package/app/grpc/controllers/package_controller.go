@@ -4,43 +4,340 @@ import (
 	"context"
 
 	"github.com/goravel/framework/facades"
+	"github.com/spf13/cast"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
 
+	"market.goravel.dev/package/app/models"
 	"market.goravel.dev/package/app/services"
 	protopackage "market.goravel.dev/proto/package"
 	utilserrors "market.goravel.dev/utils/errors"
+	utilsetag "market.goravel.dev/utils/etag"
 	utilspagination "market.goravel.dev/utils/pagination"
 	utilsresponse "market.goravel.dev/utils/response"
 )
 
 type PackageController struct {
 	protopackage.UnimplementedPackageServiceServer
-	packageService services.Package
-	tagService     services.Tag
+	commentService           services.Comment
+	idempotencyService       services.Idempotency
+	packageService           services.Package
+	packageCoverService      services.PackageCover
+	packageFavoriteService   services.PackageFavorite
+	packageMaintainerService services.PackageMaintainer
+	packageSyncService       services.PackageSync
+	packageViewService       services.PackageView
+	releaseService           services.Release
+	reviewService            services.Review
+	tagService               services.Tag
 }
 
 func NewPackageController() *PackageController {
 	return &PackageController{
-		packageService: services.NewPackageImpl(),
-		tagService:     services.NewTagImpl(),
+		commentService:           services.NewCommentImpl(),
+		idempotencyService:       services.NewIdempotencyImpl(),
+		packageService:           services.NewPackageImpl(),
+		packageCoverService:      services.NewPackageCoverImpl(),
+		packageFavoriteService:   services.NewPackageFavoriteImpl(),
+		packageMaintainerService: services.NewPackageMaintainerImpl(),
+		packageSyncService:       services.NewPackageSyncImpl(),
+		packageViewService:       services.NewPackageViewImpl(),
+		releaseService:           services.NewReleaseImpl(),
+		reviewService:            services.NewReviewImpl(),
+		tagService:               services.NewTagImpl(),
 	}
 }
 
+func (r *PackageController) AcceptPackageTransfer(ctx context.Context, req *protopackage.AcceptPackageTransferRequest) (*protopackage.AcceptPackageTransferResponse, error) {
+	packageID := req.GetId()
+	if packageID == "" {
+		return nil, utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.package_id"))
+	}
+
+	pkg, err := r.packageMaintainerService.AcceptPackageTransfer(ctx, packageID, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protopackage.AcceptPackageTransferResponse{
+		Status:  utilsresponse.NewOkStatus(),
+		Package: pkg.ToProto(),
+	}, nil
+}
+
+func (r *PackageController) AddMaintainer(ctx context.Context, req *protopackage.AddMaintainerRequest) (*protopackage.AddMaintainerResponse, error) {
+	translate := facades.Lang(ctx)
+	if req.GetPackageId() == "" {
+		return nil, utilserrors.NewBadRequest(translate.Get("required.package_id"))
+	}
+	if req.GetMaintainerId() == "" {
+		return nil, utilserrors.NewBadRequest(translate.Get("required.maintainer_id"))
+	}
+
+	maintainer, err := r.packageMaintainerService.AddMaintainer(ctx, req.GetPackageId(), req.GetUserId(), req.GetMaintainerId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protopackage.AddMaintainerResponse{
+		Status:     utilsresponse.NewOkStatus(),
+		Maintainer: maintainer.ToProto(),
+	}, nil
+}
+
+func (r *PackageController) ApprovePackage(ctx context.Context, req *protopackage.ApprovePackageRequest) (*protopackage.ApprovePackageResponse, error) {
+	packageID := req.GetId()
+	if packageID == "" {
+		return nil, utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.package_id"))
+	}
+
+	pkg, err := r.packageService.ApprovePackage(ctx, packageID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protopackage.ApprovePackageResponse{
+		Status:  utilsresponse.NewOkStatus(),
+		Package: pkg.ToProto(),
+	}, nil
+}
+
+func (r *PackageController) CreateComment(ctx context.Context, req *protopackage.CreateCommentRequest) (*protopackage.CreateCommentResponse, error) {
+	if req.GetPackageId() == "" {
+		return nil, utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.package_id"))
+	}
+	if err := validateCommentContent(ctx, req.GetContent()); err != nil {
+		return nil, err
+	}
+
+	comment, err := r.commentService.CreateComment(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protopackage.CreateCommentResponse{
+		Status:  utilsresponse.NewOkStatus(),
+		Comment: comment.ToProto(),
+	}, nil
+}
+
 func (r *PackageController) CreatePackage(ctx context.Context, req *protopackage.CreatePackageRequest) (*protopackage.CreatePackageResponse, error) {
 	if err := validateCreatePackageRequest(ctx, req); err != nil {
 		return nil, err
 	}
 
-	pkg, err := r.packageService.CreatePackage(req)
+	idempotencyKey := getIdempotencyKey(ctx)
+	if err := validateIdempotencyKey(ctx, idempotencyKey); err != nil {
+		return nil, err
+	}
+
+	// The retries of the request with the same idempotency key get the package created by the first request.
+	var resp protopackage.CreatePackageResponse
+	if err := r.idempotencyService.Do(ctx, "create_package:"+req.GetUserId(), idempotencyKey, req, &resp, func() (proto.Message, error) {
+		pkg, err := r.packageService.CreatePackage(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return &protopackage.CreatePackageResponse{
+			Status:  utilsresponse.NewOkStatus(),
+			Package: pkg.ToProto(),
+		}, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (r *PackageController) CreateRelease(ctx context.Context, req *protopackage.CreateReleaseRequest) (*protopackage.CreateReleaseResponse, error) {
+	if err := validateCreateReleaseRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	release, err := r.releaseService.CreateRelease(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protopackage.CreateReleaseResponse{
+		Status:  utilsresponse.NewOkStatus(),
+		Release: release.ToProto(),
+	}, nil
+}
+
+func (r *PackageController) CreateReview(ctx context.Context, req *protopackage.CreateReviewRequest) (*protopackage.CreateReviewResponse, error) {
+	if err := validateCreateReviewRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	review, err := r.reviewService.CreateReview(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protopackage.CreateReviewResponse{
+		Status: utilsresponse.NewOkStatus(),
+		Review: review.ToProto(),
+	}, nil
+}
+
+func (r *PackageController) CreateTag(ctx context.Context, req *protopackage.CreateTagRequest) (*protopackage.CreateTagResponse, error) {
+	if err := validateCreateTagRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	tag, err := r.tagService.CreateTag(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protopackage.CreateTagResponse{
+		Status: utilsresponse.NewOkStatus(),
+		Tag:    tag.ToProto(),
+	}, nil
+}
+
+func (r *PackageController) CreateTagAlias(ctx context.Context, req *protopackage.CreateTagAliasRequest) (*protopackage.CreateTagAliasResponse, error) {
+	if err := validateCreateTagAliasRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	alias, err := r.tagService.CreateTagAlias(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protopackage.CreateTagAliasResponse{
+		Status: utilsresponse.NewOkStatus(),
+		Alias:  alias.ToProto(),
+	}, nil
+}
+
+func (r *PackageController) DeclinePackageTransfer(ctx context.Context, req *protopackage.DeclinePackageTransferRequest) (*protopackage.DeclinePackageTransferResponse, error) {
+	packageID := req.GetId()
+	if packageID == "" {
+		return nil, utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.package_id"))
+	}
+
+	pkg, err := r.packageMaintainerService.DeclinePackageTransfer(ctx, packageID, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protopackage.DeclinePackageTransferResponse{
+		Status:  utilsresponse.NewOkStatus(),
+		Package: pkg.ToProto(),
+	}, nil
+}
+
+func (r *PackageController) DeleteComment(ctx context.Context, req *protopackage.DeleteCommentRequest) (*protopackage.DeleteCommentResponse, error) {
+	translate := facades.Lang(ctx)
+	if req.GetPackageId() == "" {
+		return nil, utilserrors.NewBadRequest(translate.Get("required.package_id"))
+	}
+	if req.GetId() == "" {
+		return nil, utilserrors.NewBadRequest(translate.Get("required.id"))
+	}
+
+	if err := r.commentService.DeleteComment(ctx, req.GetUserId(), req.GetPackageId(), req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &protopackage.DeleteCommentResponse{
+		Status: utilsresponse.NewOkStatus(),
+	}, nil
+}
+
+func (r *PackageController) DeletePackage(ctx context.Context, req *protopackage.DeletePackageRequest) (*protopackage.DeletePackageResponse, error) {
+	packageID := req.GetId()
+	if packageID == "" {
+		return nil, utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.package_id"))
+	}
+
+	if err := r.packageService.DeletePackage(ctx, packageID, req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	return &protopackage.DeletePackageResponse{
+		Status: utilsresponse.NewOkStatus(),
+	}, nil
+}
+
+func (r *PackageController) DeleteReview(ctx context.Context, req *protopackage.DeleteReviewRequest) (*protopackage.DeleteReviewResponse, error) {
+	translate := facades.Lang(ctx)
+	if req.GetPackageId() == "" {
+		return nil, utilserrors.NewBadRequest(translate.Get("required.package_id"))
+	}
+	if req.GetId() == "" {
+		return nil, utilserrors.NewBadRequest(translate.Get("required.id"))
+	}
+
+	if err := r.reviewService.DeleteReview(ctx, req.GetUserId(), req.GetPackageId(), req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &protopackage.DeleteReviewResponse{
+		Status: utilsresponse.NewOkStatus(),
+	}, nil
+}
+
+func (r *PackageController) DeleteTagAlias(ctx context.Context, req *protopackage.DeleteTagAliasRequest) (*protopackage.DeleteTagAliasResponse, error) {
+	translate := facades.Lang(ctx)
+	if req.GetTagId() == "" {
+		return nil, utilserrors.NewBadRequest(translate.Get("required.tag_id"))
+	}
+	if req.GetName() == "" {
+		return nil, utilserrors.NewBadRequest(translate.Get("required.name"))
+	}
+
+	if err := r.tagService.DeleteTagAlias(ctx, req.GetTagId(), req.GetName()); err != nil {
+		return nil, err
+	}
+
+	return &protopackage.DeleteTagAliasResponse{
+		Status: utilsresponse.NewOkStatus(),
+	}, nil
+}
+
+func (r *PackageController) FavoritePackage(ctx context.Context, req *protopackage.FavoritePackageRequest) (*protopackage.FavoritePackageResponse, error) {
+	packageID := req.GetId()
+	if packageID == "" {
+		return nil, utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.package_id"))
+	}
+
+	pkg, err := r.packageFavoriteService.FavoritePackage(ctx, packageID, req.GetUserId())
 	if err != nil {
 		return nil, err
 	}
 
-	return &protopackage.CreatePackageResponse{
+	return &protopackage.FavoritePackageResponse{
 		Status:  utilsresponse.NewOkStatus(),
 		Package: pkg.ToProto(),
 	}, nil
 }
 
+func (r *PackageController) GetMaintainers(ctx context.Context, req *protopackage.GetMaintainersRequest) (*protopackage.GetMaintainersResponse, error) {
+	if req.GetPackageId() == "" {
+		return nil, utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.package_id"))
+	}
+
+	maintainers, err := r.packageMaintainerService.GetMaintainers(ctx, req.GetPackageId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	maintainersProto := make([]*protopackage.Maintainer, 0, len(maintainers))
+	for _, maintainer := range maintainers {
+		maintainersProto = append(maintainersProto, maintainer.ToProto())
+	}
+
+	return &protopackage.GetMaintainersResponse{
+		Status:      utilsresponse.NewOkStatus(),
+		Maintainers: maintainersProto,
+	}, nil
+}
+
 func (r *PackageController) GetPackage(ctx context.Context, req *protopackage.GetPackageRequest) (*protopackage.GetPackageResponse, error) {
 	packageID := req.GetId()
 	if packageID == "" {
@@ -52,8 +349,8 @@ func (r *PackageController) GetPackage(ctx context.Context, req *protopackage.Ge
 		return nil, err
 	}
 
-	if pkg.ID == 0 {
-		return nil, utilserrors.NewNotFound(facades.Lang(ctx).Get("not_exist.package"))
+	if err := r.viewPackage(ctx, pkg, req); err != nil {
+		return nil, err
 	}
 
 	return &protopackage.GetPackageResponse{
@@ -62,58 +359,117 @@ func (r *PackageController) GetPackage(ctx context.Context, req *protopackage.Ge
 	}, nil
 }
 
-func (r *PackageController) GetPackages(_ context.Context, req *protopackage.GetPackagesRequest) (*protopackage.GetPackagesResponse, error) {
-	query := req.GetQuery()
-	pagination := req.GetPagination()
+func (r *PackageController) GetPackageBySlug(ctx context.Context, req *protopackage.GetPackageBySlugRequest) (*protopackage.GetPackageBySlugResponse, error) {
+	slug := req.GetSlug()
+	if slug == "" {
+		return nil, utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.slug"))
+	}
 
-	if pagination == nil {
-		pagination = utilspagination.Default()
+	pkg, err := r.packageService.GetPackageBySlug(slug)
+	if err != nil {
+		return nil, err
 	}
 
-	if pagination.GetPage() <= 0 {
-		pagination.Page = 1
+	if err := r.viewPackage(ctx, pkg, req); err != nil {
+		return nil, err
 	}
 
-	if pagination.GetLimit() <= 0 {
-		pagination.Limit = 10
+	return &protopackage.GetPackageBySlugResponse{
+		Status:  utilsresponse.NewOkStatus(),
+		Package: pkg.ToProto(),
+	}, nil
+}
+
+func (r *PackageController) GetPackages(ctx context.Context, req *protopackage.GetPackagesRequest) (*protopackage.GetPackagesResponse, error) {
+	if err := validateGetPackagesRequest(ctx, req); err != nil {
+		return nil, err
 	}
 
-	packages, total, err := r.packageService.GetPackages(query, pagination)
+	query := req.GetQuery()
+	pagination := utilspagination.Normalize(req.GetPagination())
+
+	packages, total, nextCursor, err := r.packageService.GetPackages(ctx, req.GetUserId(), query, pagination)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := r.packageFavoriteService.FillFavorited(req.GetUserId(), packages); err != nil {
+		return nil, err
+	}
+
 	packagesProto := make([]*protopackage.Package, 0, len(packages))
 	for _, pkg := range packages {
 		packagesProto = append(packagesProto, pkg.ToProto())
 	}
 
 	return &protopackage.GetPackagesResponse{
-		Status:   utilsresponse.NewOkStatus(),
-		Packages: packagesProto,
-		Total:    total,
+		Status:     utilsresponse.NewOkStatus(),
+		Packages:   packagesProto,
+		Total:      total,
+		NextCursor: nextCursor,
 	}, nil
 }
 
-func (r *PackageController) GetTags(_ context.Context, req *protopackage.GetTagsRequest) (*protopackage.GetTagsResponse, error) {
-	query := req.GetQuery()
-	packageID := query.GetPackageId()
-	name := query.GetName()
-	pagination := req.GetPagination()
+func (r *PackageController) GetPendingPackages(ctx context.Context, req *protopackage.GetPendingPackagesRequest) (*protopackage.GetPendingPackagesResponse, error) {
+	if err := validatePagination(ctx, req.GetPagination()); err != nil {
+		return nil, err
+	}
+
+	pagination := utilspagination.Normalize(req.GetPagination())
+
+	packages, total, nextCursor, err := r.packageService.GetPendingPackages(ctx, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	packagesProto := make([]*protopackage.Package, 0, len(packages))
+	for _, pkg := range packages {
+		packagesProto = append(packagesProto, pkg.ToProto())
+	}
+
+	return &protopackage.GetPendingPackagesResponse{
+		Status:     utilsresponse.NewOkStatus(),
+		Packages:   packagesProto,
+		Total:      total,
+		NextCursor: nextCursor,
+	}, nil
+}
 
-	if pagination == nil {
-		pagination = utilspagination.Default()
+func (r *PackageController) GetRelease(ctx context.Context, req *protopackage.GetReleaseRequest) (*protopackage.GetReleaseResponse, error) {
+	if req.GetPackageId() == "" {
+		return nil, utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.package_id"))
+	}
+	if req.GetVersion() == "" {
+		return nil, utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.version"))
+	}
+
+	release, err := r.releaseService.GetRelease(ctx, req.GetUserId(), req.GetPackageId(), req.GetVersion())
+	if err != nil {
+		return nil, err
 	}
 
-	if pagination.GetPage() <= 0 {
-		pagination.Page = 1
+	releaseProto := release.ToProto()
+	if req.GetFrameworkVersion() != "" {
+		releaseProto.IsCompatible = release.SupportsFramework(req.GetFrameworkVersion())
 	}
 
-	if pagination.GetLimit() <= 0 {
-		pagination.Limit = 10
+	return &protopackage.GetReleaseResponse{
+		Status:  utilsresponse.NewOkStatus(),
+		Release: releaseProto,
+	}, nil
+}
+
+func (r *PackageController) GetTags(ctx context.Context, req *protopackage.GetTagsRequest) (*protopackage.GetTagsResponse, error) {
+	if err := validateGetTagsRequest(ctx, req); err != nil {
+		return nil, err
 	}
 
-	tags, total, err := r.tagService.GetTags(packageID, name, pagination)
+	query := req.GetQuery()
+	packageID := query.GetPackageId()
+	name := query.GetName()
+	pagination := utilspagination.Normalize(req.GetPagination())
+
+	tags, total, nextCursor, err := r.tagService.GetTags(ctx, req.GetUserId(), packageID, name, query.GetSort(), pagination)
 	if err != nil {
 		return nil, err
 	}
@@ -124,9 +480,270 @@ func (r *PackageController) GetTags(_ context.Context, req *protopackage.GetTags
 	}
 
 	return &protopackage.GetTagsResponse{
+		Status:     utilsresponse.NewOkStatus(),
+		Tags:       tagsProto,
+		Total:      total,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (r *PackageController) ListComments(ctx context.Context, req *protopackage.ListCommentsRequest) (*protopackage.ListCommentsResponse, error) {
+	if req.GetPackageId() == "" {
+		return nil, utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.package_id"))
+	}
+	if err := validatePagination(ctx, req.GetPagination()); err != nil {
+		return nil, err
+	}
+
+	pagination := utilspagination.Normalize(req.GetPagination())
+
+	comments, total, nextCursor, err := r.commentService.ListComments(ctx, req.GetUserId(), req.GetPackageId(), req.GetParentId(), pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	commentsProto := make([]*protopackage.Comment, 0, len(comments))
+	for _, comment := range comments {
+		commentsProto = append(commentsProto, comment.ToProto())
+	}
+
+	return &protopackage.ListCommentsResponse{
+		Status:     utilsresponse.NewOkStatus(),
+		Comments:   commentsProto,
+		Total:      total,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (r *PackageController) ListFavoritePackages(ctx context.Context, req *protopackage.ListFavoritePackagesRequest) (*protopackage.ListFavoritePackagesResponse, error) {
+	if err := validatePagination(ctx, req.GetPagination()); err != nil {
+		return nil, err
+	}
+
+	pagination := utilspagination.Normalize(req.GetPagination())
+
+	packages, total, nextCursor, err := r.packageFavoriteService.ListFavoritePackages(ctx, req.GetUserId(), pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	packagesProto := make([]*protopackage.Package, 0, len(packages))
+	for _, pkg := range packages {
+		packagesProto = append(packagesProto, pkg.ToProto())
+	}
+
+	return &protopackage.ListFavoritePackagesResponse{
+		Status:     utilsresponse.NewOkStatus(),
+		Packages:   packagesProto,
+		Total:      total,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (r *PackageController) ListReleases(ctx context.Context, req *protopackage.ListReleasesRequest) (*protopackage.ListReleasesResponse, error) {
+	if err := validateListReleasesRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	query := req.GetQuery()
+	pagination := utilspagination.Normalize(req.GetPagination())
+
+	releases, total, nextCursor, err := r.releaseService.ListReleases(ctx, req.GetUserId(), req.GetPackageId(), query, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	releasesProto := make([]*protopackage.Release, 0, len(releases))
+	for _, release := range releases {
+		releaseProto := release.ToProto()
+		if query.GetFrameworkVersion() != "" {
+			releaseProto.IsCompatible = release.SupportsFramework(query.GetFrameworkVersion())
+		}
+
+		releasesProto = append(releasesProto, releaseProto)
+	}
+
+	return &protopackage.ListReleasesResponse{
+		Status:     utilsresponse.NewOkStatus(),
+		Releases:   releasesProto,
+		Total:      total,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (r *PackageController) ListReviews(ctx context.Context, req *protopackage.ListReviewsRequest) (*protopackage.ListReviewsResponse, error) {
+	if req.GetPackageId() == "" {
+		return nil, utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.package_id"))
+	}
+	if err := validatePagination(ctx, req.GetPagination()); err != nil {
+		return nil, err
+	}
+
+	pagination := utilspagination.Normalize(req.GetPagination())
+
+	reviews, total, nextCursor, err := r.reviewService.ListReviews(ctx, req.GetUserId(), req.GetPackageId(), pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewsProto := make([]*protopackage.Review, 0, len(reviews))
+	for _, review := range reviews {
+		reviewsProto = append(reviewsProto, review.ToProto())
+	}
+
+	return &protopackage.ListReviewsResponse{
+		Status:     utilsresponse.NewOkStatus(),
+		Reviews:    reviewsProto,
+		Total:      total,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (r *PackageController) MergeTags(ctx context.Context, req *protopackage.MergeTagsRequest) (*protopackage.MergeTagsResponse, error) {
+	translate := facades.Lang(ctx)
+	if req.GetId() == "" {
+		return nil, utilserrors.NewBadRequest(translate.Get("required.id"))
+	}
+	if req.GetTargetId() == "" {
+		return nil, utilserrors.NewBadRequest(translate.Get("required.target_id"))
+	}
+
+	tag, err := r.tagService.MergeTags(ctx, req.GetId(), req.GetTargetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protopackage.MergeTagsResponse{
+		Status: utilsresponse.NewOkStatus(),
+		Tag:    tag.ToProto(),
+	}, nil
+}
+
+func (r *PackageController) RejectPackage(ctx context.Context, req *protopackage.RejectPackageRequest) (*protopackage.RejectPackageResponse, error) {
+	if err := validateRejectPackageRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	pkg, err := r.packageService.RejectPackage(ctx, req.GetId(), req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protopackage.RejectPackageResponse{
+		Status:  utilsresponse.NewOkStatus(),
+		Package: pkg.ToProto(),
+	}, nil
+}
+
+func (r *PackageController) RemoveMaintainer(ctx context.Context, req *protopackage.RemoveMaintainerRequest) (*protopackage.RemoveMaintainerResponse, error) {
+	translate := facades.Lang(ctx)
+	if req.GetPackageId() == "" {
+		return nil, utilserrors.NewBadRequest(translate.Get("required.package_id"))
+	}
+	if req.GetMaintainerId() == "" {
+		return nil, utilserrors.NewBadRequest(translate.Get("required.maintainer_id"))
+	}
+
+	if err := r.packageMaintainerService.RemoveMaintainer(ctx, req.GetPackageId(), req.GetUserId(), req.GetMaintainerId()); err != nil {
+		return nil, err
+	}
+
+	return &protopackage.RemoveMaintainerResponse{
 		Status: utilsresponse.NewOkStatus(),
-		Tags:   tagsProto,
-		Total:  total,
+	}, nil
+}
+
+func (r *PackageController) RestorePackage(ctx context.Context, req *protopackage.RestorePackageRequest) (*protopackage.RestorePackageResponse, error) {
+	packageID := req.GetId()
+	if packageID == "" {
+		return nil, utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.package_id"))
+	}
+
+	pkg, err := r.packageService.RestorePackage(ctx, packageID, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protopackage.RestorePackageResponse{
+		Status:  utilsresponse.NewOkStatus(),
+		Package: pkg.ToProto(),
+	}, nil
+}
+
+func (r *PackageController) SyncPackage(ctx context.Context, req *protopackage.SyncPackageRequest) (*protopackage.SyncPackageResponse, error) {
+	packageID := req.GetId()
+	if packageID == "" {
+		return nil, utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.package_id"))
+	}
+
+	pkg, err := r.packageSyncService.SyncPackage(ctx, packageID, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protopackage.SyncPackageResponse{
+		Status:  utilsresponse.NewOkStatus(),
+		Package: pkg.ToProto(),
+	}, nil
+}
+
+func (r *PackageController) TransferPackage(ctx context.Context, req *protopackage.TransferPackageRequest) (*protopackage.TransferPackageResponse, error) {
+	translate := facades.Lang(ctx)
+	if req.GetId() == "" {
+		return nil, utilserrors.NewBadRequest(translate.Get("required.package_id"))
+	}
+	if req.GetOwnerId() == "" {
+		return nil, utilserrors.NewBadRequest(translate.Get("required.owner_id"))
+	}
+
+	pkg, err := r.packageMaintainerService.TransferPackage(ctx, req.GetId(), req.GetUserId(), req.GetOwnerId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protopackage.TransferPackageResponse{
+		Status:  utilsresponse.NewOkStatus(),
+		Package: pkg.ToProto(),
+	}, nil
+}
+
+func (r *PackageController) UnfavoritePackage(ctx context.Context, req *protopackage.UnfavoritePackageRequest) (*protopackage.UnfavoritePackageResponse, error) {
+	packageID := req.GetId()
+	if packageID == "" {
+		return nil, utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.package_id"))
+	}
+
+	pkg, err := r.packageFavoriteService.UnfavoritePackage(ctx, packageID, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protopackage.UnfavoritePackageResponse{
+		Status:  utilsresponse.NewOkStatus(),
+		Package: pkg.ToProto(),
+	}, nil
+}
+
+func (r *PackageController) UpdateComment(ctx context.Context, req *protopackage.UpdateCommentRequest) (*protopackage.UpdateCommentResponse, error) {
+	translate := facades.Lang(ctx)
+	if req.GetPackageId() == "" {
+		return nil, utilserrors.NewBadRequest(translate.Get("required.package_id"))
+	}
+	if req.GetId() == "" {
+		return nil, utilserrors.NewBadRequest(translate.Get("required.id"))
+	}
+	if err := validateCommentContent(ctx, req.GetContent()); err != nil {
+		return nil, err
+	}
+
+	comment, err := r.commentService.UpdateComment(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protopackage.UpdateCommentResponse{
+		Status:  utilsresponse.NewOkStatus(),
+		Comment: comment.ToProto(),
 	}, nil
 }
 
@@ -135,6 +752,15 @@ func (r *PackageController) UpdatePackage(ctx context.Context, req *protopackage
 		return nil, err
 	}
 
+	// The revision in the request takes precedence over the If-Match header.
+	if req.GetRevision() == 0 {
+		revision, ok := utilsetag.IfMatch(ctx)
+		if !ok {
+			return nil, utilserrors.NewBadRequest(facades.Lang(ctx).Get("invalid.if_match"))
+		}
+		req.Revision = revision
+	}
+
 	pkg, err := r.packageService.UpdatePackage(ctx, req)
 	if err != nil {
 		return nil, err
@@ -145,3 +771,114 @@ func (r *PackageController) UpdatePackage(ctx context.Context, req *protopackage
 		Package: pkg.ToProto(),
 	}, nil
 }
+
+func (r *PackageController) UpdateReview(ctx context.Context, req *protopackage.UpdateReviewRequest) (*protopackage.UpdateReviewResponse, error) {
+	if err := validateUpdateReviewRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	review, err := r.reviewService.UpdateReview(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protopackage.UpdateReviewResponse{
+		Status: utilsresponse.NewOkStatus(),
+		Review: review.ToProto(),
+	}, nil
+}
+
+func (r *PackageController) UpdateTag(ctx context.Context, req *protopackage.UpdateTagRequest) (*protopackage.UpdateTagResponse, error) {
+	if err := validateUpdateTagRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	tag, err := r.tagService.UpdateTag(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protopackage.UpdateTagResponse{
+		Status: utilsresponse.NewOkStatus(),
+		Tag:    tag.ToProto(),
+	}, nil
+}
+
+func (r *PackageController) UploadPackageCover(ctx context.Context, req *protopackage.UploadPackageCoverRequest) (*protopackage.UploadPackageCoverResponse, error) {
+	packageID := req.GetId()
+	if packageID == "" {
+		return nil, utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.package_id"))
+	}
+	if len(req.GetImage()) == 0 {
+		return nil, utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.image"))
+	}
+
+	pkg, err := r.packageCoverService.UploadCover(ctx, packageID, req.GetUserId(), req.GetImage())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protopackage.UploadPackageCoverResponse{
+		Status:  utilsresponse.NewOkStatus(),
+		Package: pkg.ToProto(),
+	}, nil
+}
+
+// viewPackage checks that the package can be read by the viewer, counts the view and fills whether the viewer has
+// favorited the package.
+func (r *PackageController) viewPackage(ctx context.Context, pkg *models.Package, req viewerRequest) error {
+	if pkg.ID == 0 {
+		return utilserrors.NewNotFound(facades.Lang(ctx).Get("not_exist.package"))
+	}
+
+	visible, err := r.packageService.IsPackageVisible(ctx, pkg, req.GetUserId())
+	if err != nil {
+		return err
+	}
+	if !visible {
+		return utilserrors.NewNotFound(facades.Lang(ctx).Get("not_exist.package"))
+	}
+
+	// Failing to count the view shouldn't fail the read.
+	if err := r.packageViewService.RecordView(cast.ToString(pkg.ID), viewerID(req)); err != nil {
+		facades.Log().Errorf("record package view error: %+v", err)
+	}
+
+	return r.packageFavoriteService.FillFavorited(req.GetUserId(), []*models.Package{pkg})
+}
+
+// viewerRequest is a request reading a package, the user ID and the fingerprint are injected by the API Gateway.
+type viewerRequest interface {
+	GetUserId() string
+	GetFingerprint() string
+}
+
+// viewerID identifies who views the package, signed-in users are identified by the user ID, anonymous users by the
+// client fingerprint.
+func viewerID(req viewerRequest) string {
+	if req.GetUserId() != "" {
+		return "user:" + req.GetUserId()
+	}
+
+	if req.GetFingerprint() != "" {
+		return "fingerprint:" + req.GetFingerprint()
+	}
+
+	return ""
+}
+
+// getIdempotencyKey gets the idempotency key of the request, the gateway forwards the Idempotency-Key header as the
+// idempotency-key metadata.
+func getIdempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get("idempotency-key")
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
@@ -6,10 +6,15 @@ import (
 	"fmt"
 	"testing"
 
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
 	mockstranslation "github.com/goravel/framework/mocks/translation"
+	"github.com/goravel/framework/support/carbon"
 	testingmock "github.com/goravel/framework/testing/mock"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
 
 	mocksservice "market.goravel.dev/package/app/mocks/services"
 	"market.goravel.dev/package/app/models"
@@ -22,11 +27,24 @@ import (
 
 type PackageControllerSuite struct {
 	suite.Suite
-	ctx                context.Context
-	packageController  *PackageController
-	mockLang           *mockstranslation.Translator
-	mockPackageService *mocksservice.Package
-	mockTagService     *mocksservice.Tag
+	ctx                          context.Context
+	idempotencyCtx               context.Context
+	packageController            *PackageController
+	mockConfig                   *mocksconfig.Config
+	mockLang                     *mockstranslation.Translator
+	mockOrm                      *mocksorm.Orm
+	mockOrmQuery                 *mocksorm.Query
+	mockCommentService           *mocksservice.Comment
+	mockIdempotencyService       *mocksservice.Idempotency
+	mockPackageService           *mocksservice.Package
+	mockPackageCoverService      *mocksservice.PackageCover
+	mockPackageFavoriteService   *mocksservice.PackageFavorite
+	mockPackageMaintainerService *mocksservice.PackageMaintainer
+	mockPackageSyncService       *mocksservice.PackageSync
+	mockPackageViewService       *mocksservice.PackageView
+	mockReleaseService           *mocksservice.Release
+	mockReviewService            *mocksservice.Review
+	mockTagService               *mocksservice.Tag
 }
 
 func TestPackageControllerSuite(t *testing.T) {
@@ -36,15 +54,45 @@ func TestPackageControllerSuite(t *testing.T) {
 func (s *PackageControllerSuite) SetupTest() {
 	s.ctx = context.Background()
 	mockFactory := testingmock.Factory()
+	s.mockConfig = mockFactory.Config()
 	s.mockLang = mockFactory.Lang(s.ctx)
+	// The context of the requests carrying an idempotency key shares the translator.
+	s.idempotencyCtx = metadata.NewIncomingContext(s.ctx, metadata.Pairs("idempotency-key", "key"))
+	mockFactory.App().On("MakeLang", s.idempotencyCtx).Return(s.mockLang)
+	mockFactory.Log()
+	s.mockOrm = mockFactory.Orm()
+	s.mockOrmQuery = mockFactory.OrmQuery()
+	s.mockCommentService = &mocksservice.Comment{}
+	s.mockIdempotencyService = &mocksservice.Idempotency{}
 	s.mockPackageService = &mocksservice.Package{}
+	s.mockPackageCoverService = &mocksservice.PackageCover{}
+	s.mockPackageFavoriteService = &mocksservice.PackageFavorite{}
+	s.mockPackageMaintainerService = &mocksservice.PackageMaintainer{}
+	s.mockPackageSyncService = &mocksservice.PackageSync{}
+	s.mockPackageViewService = &mocksservice.PackageView{}
+	s.mockReleaseService = &mocksservice.Release{}
+	s.mockReviewService = &mocksservice.Review{}
 	s.mockTagService = &mocksservice.Tag{}
 	s.packageController = &PackageController{
-		packageService: s.mockPackageService,
-		tagService:     s.mockTagService,
+		commentService:           s.mockCommentService,
+		idempotencyService:       s.mockIdempotencyService,
+		packageService:           s.mockPackageService,
+		packageCoverService:      s.mockPackageCoverService,
+		packageFavoriteService:   s.mockPackageFavoriteService,
+		packageMaintainerService: s.mockPackageMaintainerService,
+		packageSyncService:       s.mockPackageSyncService,
+		packageViewService:       s.mockPackageViewService,
+		releaseService:           s.mockReleaseService,
+		reviewService:            s.mockReviewService,
+		tagService:               s.mockTagService,
 	}
 }
 
+// expectVisible expects the visibility check of the package read by the user.
+func (s *PackageControllerSuite) expectVisible(userID string, visible bool) {
+	s.mockPackageService.On("IsPackageVisible", s.ctx, mock.AnythingOfType("*models.Package"), userID).Return(visible, nil).Once()
+}
+
 func (s *PackageControllerSuite) TestCreatePackage() {
 	var (
 		name   = "goravel"
@@ -59,10 +107,23 @@ func (s *PackageControllerSuite) TestCreatePackage() {
 			UserID: userID,
 			Link:   url,
 		}
+
+		// handleIdempotency calls the handler as the idempotency service does when the key has no cached response.
+		handleIdempotency = func(_ context.Context, _, _ string, _, resp proto.Message, handler func() (proto.Message, error)) error {
+			result, err := handler()
+			if err != nil {
+				return err
+			}
+
+			proto.Merge(resp, result)
+
+			return nil
+		}
 	)
 
 	tests := []struct {
 		name             string
+		ctx              context.Context
 		request          *protopackage.CreatePackageRequest
 		setup            func()
 		expectedResponse *protopackage.CreatePackageResponse
@@ -76,7 +137,27 @@ func (s *PackageControllerSuite) TestCreatePackage() {
 				Url:    url,
 			},
 			setup: func() {
-				s.mockPackageService.On("CreatePackage", mock.MatchedBy(func(req *protopackage.CreatePackageRequest) bool {
+				s.mockIdempotencyService.On("Do", mock.Anything, "create_package:1", "", mock.Anything, mock.Anything, mock.Anything).Return(handleIdempotency).Once()
+				s.mockPackageService.On("CreatePackage", mock.Anything, mock.MatchedBy(func(req *protopackage.CreatePackageRequest) bool {
+					return req.GetUserId() == fmt.Sprint(userID) && req.GetName() == name && req.GetUrl() == url
+				})).Return(&pkg, nil).Once()
+			},
+			expectedResponse: &protopackage.CreatePackageResponse{
+				Status:  utilsresponse.NewOkStatus(),
+				Package: pkg.ToProto(),
+			},
+		},
+		{
+			name: "Happy path - with idempotency key",
+			ctx:  s.idempotencyCtx,
+			request: &protopackage.CreatePackageRequest{
+				UserId: fmt.Sprint(userID),
+				Name:   name,
+				Url:    url,
+			},
+			setup: func() {
+				s.mockIdempotencyService.On("Do", mock.Anything, "create_package:1", "key", mock.Anything, mock.Anything, mock.Anything).Return(handleIdempotency).Once()
+				s.mockPackageService.On("CreatePackage", mock.Anything, mock.MatchedBy(func(req *protopackage.CreatePackageRequest) bool {
 					return req.GetUserId() == fmt.Sprint(userID) && req.GetName() == name && req.GetUrl() == url
 				})).Return(&pkg, nil).Once()
 			},
@@ -85,6 +166,44 @@ func (s *PackageControllerSuite) TestCreatePackage() {
 				Package: pkg.ToProto(),
 			},
 		},
+		{
+			name: "Happy path - retry replays the cached response",
+			ctx:  s.idempotencyCtx,
+			request: &protopackage.CreatePackageRequest{
+				UserId: fmt.Sprint(userID),
+				Name:   name,
+				Url:    url,
+			},
+			setup: func() {
+				s.mockIdempotencyService.On("Do", mock.Anything, "create_package:1", "key", mock.Anything, mock.Anything, mock.Anything).
+					Return(func(_ context.Context, _, _ string, _, resp proto.Message, _ func() (proto.Message, error)) error {
+						proto.Merge(resp, &protopackage.CreatePackageResponse{
+							Status:  utilsresponse.NewOkStatus(),
+							Package: pkg.ToProto(),
+						})
+
+						return nil
+					}).Once()
+			},
+			expectedResponse: &protopackage.CreatePackageResponse{
+				Status:  utilsresponse.NewOkStatus(),
+				Package: pkg.ToProto(),
+			},
+		},
+		{
+			name: "Sad path - idempotency key is reused",
+			ctx:  s.idempotencyCtx,
+			request: &protopackage.CreatePackageRequest{
+				UserId: fmt.Sprint(userID),
+				Name:   name,
+				Url:    url,
+			},
+			setup: func() {
+				s.mockIdempotencyService.On("Do", mock.Anything, "create_package:1", "key", mock.Anything, mock.Anything, mock.Anything).
+					Return(utilserrors.NewConflict("reused")).Once()
+			},
+			expectedErr: utilserrors.NewConflict("reused"),
+		},
 		{
 			name: "Sad path - CreatePackage returns error",
 			request: &protopackage.CreatePackageRequest{
@@ -93,7 +212,8 @@ func (s *PackageControllerSuite) TestCreatePackage() {
 				Url:    url,
 			},
 			setup: func() {
-				s.mockPackageService.On("CreatePackage", mock.MatchedBy(func(req *protopackage.CreatePackageRequest) bool {
+				s.mockIdempotencyService.On("Do", mock.Anything, "create_package:1", "", mock.Anything, mock.Anything, mock.Anything).Return(handleIdempotency).Once()
+				s.mockPackageService.On("CreatePackage", mock.Anything, mock.MatchedBy(func(req *protopackage.CreatePackageRequest) bool {
 					return req.GetUserId() == fmt.Sprint(userID) && req.GetName() == name && req.GetUrl() == url
 				})).Return(nil, errors.New("error")).Once()
 			},
@@ -117,10 +237,16 @@ func (s *PackageControllerSuite) TestCreatePackage() {
 	for _, test := range tests {
 		s.Run(test.name, func() {
 			test.setup()
-			response, err := s.packageController.CreatePackage(s.ctx, test.request)
-			s.Equal(test.expectedResponse, response)
+			ctx := s.ctx
+			if test.ctx != nil {
+				ctx = test.ctx
+			}
+
+			response, err := s.packageController.CreatePackage(ctx, test.request)
+			s.True(proto.Equal(test.expectedResponse, response))
 			s.Equal(test.expectedErr, err)
 
+			s.mockIdempotencyService.AssertExpectations(s.T())
 			s.mockPackageService.AssertExpectations(s.T())
 		})
 	}
@@ -155,18 +281,24 @@ func (s *PackageControllerSuite) TestGetPackage() {
 		{
 			name: "Happy path",
 			request: &protopackage.GetPackageRequest{
-				Id: packageID,
+				Fingerprint: "fingerprint",
+				Id:          packageID,
 			},
 			setup: func() {
+				s.mockPackageViewService.On("RecordView", packageID, "fingerprint:fingerprint").Return(nil).Once()
 				s.mockPackageService.On("GetPackageByID", packageID).Return(&models.Package{
 					UUIDModel: models.UUIDModel{
 						ID: 1,
 					},
-					UserID: userID,
-					Name:   name,
-					User:   user,
-					Tags:   tags,
+					UserID:     userID,
+					Name:       name,
+					User:       user,
+					Tags:       tags,
+					IsApproved: models.PackageApproved,
+					IsPublic:   models.PackagePublic,
 				}, nil).Once()
+				s.expectVisible("", true)
+				s.mockPackageFavoriteService.On("FillFavorited", "", mock.Anything).Return(nil).Once()
 			},
 			expectedResponse: &protopackage.GetPackageResponse{
 				Status: utilsresponse.NewOkStatus(),
@@ -181,9 +313,135 @@ func (s *PackageControllerSuite) TestGetPackage() {
 							Name: "test",
 						},
 					},
+					IsApproved: true,
+					IsPublic:   true,
+				},
+			},
+		},
+		{
+			name: "Happy path - owner gets the unapproved package",
+			request: &protopackage.GetPackageRequest{
+				UserId:      fmt.Sprint(userID),
+				Fingerprint: "fingerprint",
+				Id:          packageID,
+			},
+			setup: func() {
+				s.mockPackageViewService.On("RecordView", packageID, "user:1").Return(errors.New("error")).Once()
+				s.mockPackageService.On("GetPackageByID", packageID).Return(&models.Package{
+					UUIDModel: models.UUIDModel{
+						ID: 1,
+					},
+					UserID:     userID,
+					Name:       name,
+					User:       user,
+					IsApproved: models.PackageNotApproved,
+				}, nil).Once()
+				s.expectVisible("1", true)
+				s.mockPackageFavoriteService.On("FillFavorited", fmt.Sprint(userID), mock.Anything).Run(func(args mock.Arguments) {
+					args.Get(1).([]*models.Package)[0].IsFavorited = true
+				}).Return(nil).Once()
+			},
+			expectedResponse: &protopackage.GetPackageResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Package: &protopackage.Package{
+					Id:          packageID,
+					UserId:      fmt.Sprint(userID),
+					Name:        name,
+					User:        user,
+					Tags:        []*protopackage.Tag{},
+					IsFavorited: true,
+				},
+			},
+		},
+		{
+			name: "Happy path - maintainer gets the unapproved package",
+			request: &protopackage.GetPackageRequest{
+				UserId: "2",
+				Id:     packageID,
+			},
+			setup: func() {
+				s.mockPackageViewService.On("RecordView", packageID, "user:2").Return(nil).Once()
+				s.mockPackageService.On("GetPackageByID", packageID).Return(&models.Package{
+					UUIDModel: models.UUIDModel{
+						ID: 1,
+					},
+					UserID:     userID,
+					Name:       name,
+					IsApproved: models.PackageNotApproved,
+				}, nil).Once()
+				s.expectVisible("2", true)
+				s.mockPackageFavoriteService.On("FillFavorited", "2", mock.Anything).Return(nil).Once()
+			},
+			expectedResponse: &protopackage.GetPackageResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Package: &protopackage.Package{
+					Id:     packageID,
+					UserId: fmt.Sprint(userID),
+					Name:   name,
+					Tags:   []*protopackage.Tag{},
 				},
 			},
 		},
+		{
+			name: "Sad path - other users can't get the unapproved package",
+			request: &protopackage.GetPackageRequest{
+				UserId: "2",
+				Id:     packageID,
+			},
+			setup: func() {
+				s.mockPackageService.On("GetPackageByID", packageID).Return(&models.Package{
+					UUIDModel: models.UUIDModel{
+						ID: 1,
+					},
+					UserID:     userID,
+					Name:       name,
+					IsApproved: models.PackageNotApproved,
+				}, nil).Once()
+				s.expectVisible("2", false)
+				s.mockLang.On("Get", "not_exist.package").Return("Package not found").Once()
+			},
+			expectedErr: utilserrors.NewNotFound("Package not found"),
+		},
+		{
+			name: "Sad path - anonymous users can't get the private package",
+			request: &protopackage.GetPackageRequest{
+				Id: packageID,
+			},
+			setup: func() {
+				s.mockPackageService.On("GetPackageByID", packageID).Return(&models.Package{
+					UUIDModel: models.UUIDModel{
+						ID: 1,
+					},
+					UserID:     userID,
+					Name:       name,
+					IsApproved: models.PackageApproved,
+					IsPublic:   models.PackagePrivate,
+				}, nil).Once()
+				s.expectVisible("", false)
+				s.mockLang.On("Get", "not_exist.package").Return("Package not found").Once()
+			},
+			expectedErr: utilserrors.NewNotFound("Package not found"),
+		},
+		{
+			name: "Sad path - FillFavorited returns error",
+			request: &protopackage.GetPackageRequest{
+				UserId:      fmt.Sprint(userID),
+				Fingerprint: "fingerprint",
+				Id:          packageID,
+			},
+			setup: func() {
+				s.mockPackageViewService.On("RecordView", packageID, "user:1").Return(nil).Once()
+				s.mockPackageService.On("GetPackageByID", packageID).Return(&models.Package{
+					UUIDModel: models.UUIDModel{
+						ID: 1,
+					},
+					UserID: userID,
+				}, nil).Once()
+				s.expectVisible("1", true)
+				s.mockPackageFavoriteService.On("FillFavorited", fmt.Sprint(userID), mock.Anything).Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
 		{
 			name:    "Sad path - PackageID is empty",
 			request: &protopackage.GetPackageRequest{},
@@ -224,12 +482,164 @@ func (s *PackageControllerSuite) TestGetPackage() {
 
 			s.mockLang.AssertExpectations(s.T())
 			s.mockPackageService.AssertExpectations(s.T())
+			s.mockPackageFavoriteService.AssertExpectations(s.T())
+			s.mockPackageViewService.AssertExpectations(s.T())
 			s.mockTagService.AssertExpectations(s.T())
 		})
 
 	}
 }
 
+func (s *PackageControllerSuite) TestGetPackageBySlug() {
+	var (
+		slug = "goravel-gin"
+		user = &protouser.User{
+			Id:   "1",
+			Name: "test",
+		}
+		userID = uint64(1)
+		name   = "goravel/gin"
+	)
+
+	tests := []struct {
+		name             string
+		request          *protopackage.GetPackageBySlugRequest
+		setup            func()
+		expectedResponse *protopackage.GetPackageBySlugResponse
+		expectedErr      error
+	}{
+		{
+			name: "Happy path",
+			request: &protopackage.GetPackageBySlugRequest{
+				Fingerprint: "fingerprint",
+				Slug:        slug,
+			},
+			setup: func() {
+				s.mockPackageService.On("GetPackageBySlug", slug).Return(&models.Package{
+					UUIDModel: models.UUIDModel{
+						ID: 1,
+					},
+					UserID:     userID,
+					Name:       name,
+					Slug:       slug,
+					User:       user,
+					IsApproved: models.PackageApproved,
+					IsPublic:   models.PackagePublic,
+				}, nil).Once()
+				s.expectVisible("", true)
+				s.mockPackageViewService.On("RecordView", "1", "fingerprint:fingerprint").Return(nil).Once()
+				s.mockPackageFavoriteService.On("FillFavorited", "", mock.Anything).Return(nil).Once()
+			},
+			expectedResponse: &protopackage.GetPackageBySlugResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Package: &protopackage.Package{
+					Id:         "1",
+					UserId:     fmt.Sprint(userID),
+					Name:       name,
+					Slug:       slug,
+					User:       user,
+					Tags:       []*protopackage.Tag{},
+					IsApproved: true,
+					IsPublic:   true,
+				},
+			},
+		},
+		{
+			name: "Happy path - previous slug gets the package with the current slug",
+			request: &protopackage.GetPackageBySlugRequest{
+				UserId: fmt.Sprint(userID),
+				Slug:   "goravel-fiber",
+			},
+			setup: func() {
+				s.mockPackageService.On("GetPackageBySlug", "goravel-fiber").Return(&models.Package{
+					UUIDModel: models.UUIDModel{
+						ID: 1,
+					},
+					UserID: userID,
+					Name:   name,
+					Slug:   slug,
+				}, nil).Once()
+				s.expectVisible("1", true)
+				s.mockPackageViewService.On("RecordView", "1", "user:1").Return(nil).Once()
+				s.mockPackageFavoriteService.On("FillFavorited", fmt.Sprint(userID), mock.Anything).Return(nil).Once()
+			},
+			expectedResponse: &protopackage.GetPackageBySlugResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Package: &protopackage.Package{
+					Id:     "1",
+					UserId: fmt.Sprint(userID),
+					Name:   name,
+					Slug:   slug,
+					Tags:   []*protopackage.Tag{},
+				},
+			},
+		},
+		{
+			name: "Sad path - other users can't get the private package",
+			request: &protopackage.GetPackageBySlugRequest{
+				UserId: "2",
+				Slug:   slug,
+			},
+			setup: func() {
+				s.mockPackageService.On("GetPackageBySlug", slug).Return(&models.Package{
+					UUIDModel: models.UUIDModel{
+						ID: 1,
+					},
+					UserID:     userID,
+					IsApproved: models.PackageApproved,
+					IsPublic:   models.PackagePrivate,
+				}, nil).Once()
+				s.expectVisible("2", false)
+				s.mockLang.On("Get", "not_exist.package").Return("Package not found").Once()
+			},
+			expectedErr: utilserrors.NewNotFound("Package not found"),
+		},
+		{
+			name:    "Sad path - slug is empty",
+			request: &protopackage.GetPackageBySlugRequest{},
+			setup: func() {
+				s.mockLang.On("Get", "required.slug").Return("Slug is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("Slug is required"),
+		},
+		{
+			name: "Sad path - GetPackageBySlug returns error",
+			request: &protopackage.GetPackageBySlugRequest{
+				Slug: slug,
+			},
+			setup: func() {
+				s.mockPackageService.On("GetPackageBySlug", slug).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			name: "Sad path - package not found",
+			request: &protopackage.GetPackageBySlugRequest{
+				Slug: slug,
+			},
+			setup: func() {
+				s.mockPackageService.On("GetPackageBySlug", slug).Return(&models.Package{}, nil).Once()
+				s.mockLang.On("Get", "not_exist.package").Return("Package not found").Once()
+			},
+			expectedErr: utilserrors.NewNotFound("Package not found"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.GetPackageBySlug(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockPackageService.AssertExpectations(s.T())
+			s.mockPackageFavoriteService.AssertExpectations(s.T())
+			s.mockPackageViewService.AssertExpectations(s.T())
+		})
+	}
+}
+
 func (s *PackageControllerSuite) TestGetPackages() {
 	var (
 		users = []*protouser.User{
@@ -265,7 +675,7 @@ func (s *PackageControllerSuite) TestGetPackages() {
 			},
 			setup: func() {
 				total = 1
-				s.mockPackageService.On("GetPackages", query, pagination).Return([]*models.Package{
+				s.mockPackageService.On("GetPackages", s.ctx, "", query, pagination).Return([]*models.Package{
 					{
 						UUIDModel: models.UUIDModel{
 							ID: 1,
@@ -274,7 +684,8 @@ func (s *PackageControllerSuite) TestGetPackages() {
 						Name:   name,
 						User:   users[0],
 					},
-				}, total, nil).Once()
+				}, total, "", nil).Once()
+				s.mockPackageFavoriteService.On("FillFavorited", "", mock.Anything).Return(nil).Once()
 			},
 			expectedResponse: &protopackage.GetPackagesResponse{
 				Status: utilsresponse.NewOkStatus(),
@@ -298,7 +709,7 @@ func (s *PackageControllerSuite) TestGetPackages() {
 			},
 			setup: func() {
 				total = 0
-				s.mockPackageService.On("GetPackages", query, pagination).Return(nil, total, errors.New("error")).Once()
+				s.mockPackageService.On("GetPackages", s.ctx, "", query, pagination).Return(nil, total, "", errors.New("error")).Once()
 			},
 			expectedErr: errors.New("error"),
 		},
@@ -310,7 +721,8 @@ func (s *PackageControllerSuite) TestGetPackages() {
 			},
 			setup: func() {
 				total = 0
-				s.mockPackageService.On("GetPackages", query, pagination).Return([]*models.Package{}, total, nil).Once()
+				s.mockPackageService.On("GetPackages", s.ctx, "", query, pagination).Return([]*models.Package{}, total, "", nil).Once()
+				s.mockPackageFavoriteService.On("FillFavorited", "", mock.Anything).Return(nil).Once()
 			},
 			expectedResponse: &protopackage.GetPackagesResponse{
 				Status:   utilsresponse.NewOkStatus(),
@@ -324,13 +736,42 @@ func (s *PackageControllerSuite) TestGetPackages() {
 			},
 			setup: func() {
 				total = 0
-				s.mockPackageService.On("GetPackages", query, &protobase.Pagination{Page: 1, Limit: 10}).Return([]*models.Package{}, total, nil).Once()
+				s.mockPackageService.On("GetPackages", s.ctx, "", query, &protobase.Pagination{Page: 1, Limit: 10}).Return([]*models.Package{}, total, "", nil).Once()
+				s.mockPackageFavoriteService.On("FillFavorited", "", mock.Anything).Return(nil).Once()
 			},
 			expectedResponse: &protopackage.GetPackagesResponse{
 				Status:   utilsresponse.NewOkStatus(),
 				Packages: []*protopackage.Package{},
 			},
 		},
+		{
+			name: "Happy path - limit is capped and the next cursor is returned",
+			request: &protopackage.GetPackagesRequest{
+				Pagination: &protobase.Pagination{Page: 1, Limit: 1000},
+				Query:      query,
+			},
+			setup: func() {
+				total = 0
+				s.mockPackageService.On("GetPackages", s.ctx, "", query, &protobase.Pagination{Page: 1, Limit: 100}).Return([]*models.Package{}, total, "cursor", nil).Once()
+				s.mockPackageFavoriteService.On("FillFavorited", "", mock.Anything).Return(nil).Once()
+			},
+			expectedResponse: &protopackage.GetPackagesResponse{
+				Status:     utilsresponse.NewOkStatus(),
+				Packages:   []*protopackage.Package{},
+				NextCursor: "cursor",
+			},
+		},
+		{
+			name: "Sad path - cursor is invalid",
+			request: &protopackage.GetPackagesRequest{
+				Pagination: &protobase.Pagination{Limit: 10, Cursor: "invalid"},
+				Query:      query,
+			},
+			setup: func() {
+				s.mockLang.On("Get", "invalid.cursor").Return("invalid cursor").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("invalid cursor"),
+		},
 		{
 			name: "Happy path - query is nil",
 			request: &protopackage.GetPackagesRequest{
@@ -338,7 +779,7 @@ func (s *PackageControllerSuite) TestGetPackages() {
 			},
 			setup: func() {
 				total = 1
-				s.mockPackageService.On("GetPackages", (*protopackage.PackagesQuery)(nil), pagination).Return([]*models.Package{
+				s.mockPackageService.On("GetPackages", s.ctx, "", (*protopackage.PackagesQuery)(nil), pagination).Return([]*models.Package{
 					{
 						UUIDModel: models.UUIDModel{
 							ID: 1,
@@ -347,7 +788,8 @@ func (s *PackageControllerSuite) TestGetPackages() {
 						Name:   name,
 						User:   users[0],
 					},
-				}, total, nil).Once()
+				}, total, "", nil).Once()
+				s.mockPackageFavoriteService.On("FillFavorited", "", mock.Anything).Return(nil).Once()
 			},
 			expectedResponse: &protopackage.GetPackagesResponse{
 				Status: utilsresponse.NewOkStatus(),
@@ -373,6 +815,7 @@ func (s *PackageControllerSuite) TestGetPackages() {
 			s.Equal(test.expectedErr, err)
 
 			s.mockLang.AssertExpectations(s.T())
+			s.mockPackageFavoriteService.AssertExpectations(s.T())
 			s.mockTagService.AssertExpectations(s.T())
 		})
 	}
@@ -408,7 +851,7 @@ func (s *PackageControllerSuite) TestGetTags() {
 			},
 			setup: func() {
 				total = 1
-				s.mockTagService.On("GetTags", packageID, name, pagination).Return([]*models.Tag{
+				s.mockTagService.On("GetTags", s.ctx, "", packageID, name, "", pagination).Return([]*models.Tag{
 					{
 						UUIDModel: models.UUIDModel{
 							ID: 1,
@@ -416,7 +859,7 @@ func (s *PackageControllerSuite) TestGetTags() {
 						UserID: userID,
 						Name:   name,
 					},
-				}, total, nil).Once()
+				}, total, "", nil).Once()
 			},
 			expectedResponse: &protopackage.GetTagsResponse{
 				Status: utilsresponse.NewOkStatus(),
@@ -431,33 +874,78 @@ func (s *PackageControllerSuite) TestGetTags() {
 			},
 		},
 		{
-			name: "Sad path - GetTags returns error",
+			name: "Happy path - sorted by popularity",
 			request: &protopackage.GetTagsRequest{
 				Pagination: pagination,
 				Query: &protopackage.TagsQuery{
-					Name: name,
+					Sort: models.TagSortPopular,
 				},
 			},
 			setup: func() {
-				total = 0
-				s.mockTagService.On("GetTags", "", name, pagination).Return(nil, total, errors.New("error")).Once()
+				total = 1
+				s.mockTagService.On("GetTags", s.ctx, "", "", "", models.TagSortPopular, pagination).Return([]*models.Tag{
+					{
+						UUIDModel: models.UUIDModel{
+							ID: 1,
+						},
+						Name:         name,
+						PackageCount: 3,
+					},
+				}, total, "", nil).Once()
+			},
+			expectedResponse: &protopackage.GetTagsResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Tags: []*protopackage.Tag{
+					{
+						Id:           "1",
+						Name:         name,
+						PackageCount: 3,
+					},
+				},
+				Total: 1,
 			},
-			expectedErr: errors.New("error"),
 		},
 		{
-			name: "Happy path - tags is empty",
+			name: "Sad path - sort is invalid",
 			request: &protopackage.GetTagsRequest{
 				Pagination: pagination,
 				Query: &protopackage.TagsQuery{
-					Name: name,
+					Sort: "views",
 				},
 			},
 			setup: func() {
-				total = 0
-				s.mockTagService.On("GetTags", "", name, pagination).Return([]*models.Tag{}, total, nil).Once()
+				s.mockLang.On("Get", "invalid.tag_sort").Return("Sort must be \"popular\"").Once()
 			},
-			expectedResponse: &protopackage.GetTagsResponse{
-				Status: utilsresponse.NewOkStatus(),
+			expectedErr: utilserrors.NewBadRequest("Sort must be \"popular\""),
+		},
+		{
+			name: "Sad path - GetTags returns error",
+			request: &protopackage.GetTagsRequest{
+				Pagination: pagination,
+				Query: &protopackage.TagsQuery{
+					Name: name,
+				},
+			},
+			setup: func() {
+				total = 0
+				s.mockTagService.On("GetTags", s.ctx, "", "", name, "", pagination).Return(nil, total, "", errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			name: "Happy path - tags is empty",
+			request: &protopackage.GetTagsRequest{
+				Pagination: pagination,
+				Query: &protopackage.TagsQuery{
+					Name: name,
+				},
+			},
+			setup: func() {
+				total = 0
+				s.mockTagService.On("GetTags", s.ctx, "", "", name, "", pagination).Return([]*models.Tag{}, total, "", nil).Once()
+			},
+			expectedResponse: &protopackage.GetTagsResponse{
+				Status: utilsresponse.NewOkStatus(),
 				Tags:   []*protopackage.Tag{},
 			},
 		},
@@ -470,7 +958,7 @@ func (s *PackageControllerSuite) TestGetTags() {
 			},
 			setup: func() {
 				total = 0
-				s.mockTagService.On("GetTags", "", name, &protobase.Pagination{Page: 1, Limit: 10}).Return([]*models.Tag{}, total, nil).Once()
+				s.mockTagService.On("GetTags", s.ctx, "", "", name, "", &protobase.Pagination{Page: 1, Limit: 10}).Return([]*models.Tag{}, total, "", nil).Once()
 			},
 			expectedResponse: &protopackage.GetTagsResponse{
 				Status: utilsresponse.NewOkStatus(),
@@ -484,7 +972,7 @@ func (s *PackageControllerSuite) TestGetTags() {
 			},
 			setup: func() {
 				total = 1
-				s.mockTagService.On("GetTags", "", "", pagination).Return([]*models.Tag{
+				s.mockTagService.On("GetTags", s.ctx, "", "", "", "", pagination).Return([]*models.Tag{
 					{
 						UUIDModel: models.UUIDModel{
 							ID: 1,
@@ -492,7 +980,7 @@ func (s *PackageControllerSuite) TestGetTags() {
 						UserID: userID,
 						Name:   name,
 					},
-				}, total, nil).Once()
+				}, total, "", nil).Once()
 			},
 			expectedResponse: &protopackage.GetTagsResponse{
 				Status: utilsresponse.NewOkStatus(),
@@ -547,6 +1035,7 @@ func (s *PackageControllerSuite) TestUpdatePackage() {
 				UserId: fmt.Sprint(userID),
 			},
 			setup: func() {
+				s.mockConfig.On("GetString", "package.tag_blocklist").Return("").Once()
 				s.mockPackageService.On("UpdatePackage", s.ctx, mock.MatchedBy(func(req *protopackage.UpdatePackageRequest) bool {
 					return req.GetId() == packageID && req.GetName() == name && req.GetUrl() == url && req.GetUserId() == fmt.Sprint(userID)
 				})).Return(&models.Package{
@@ -577,12 +1066,28 @@ func (s *PackageControllerSuite) TestUpdatePackage() {
 				UserId: fmt.Sprint(userID),
 			},
 			setup: func() {
+				s.mockConfig.On("GetString", "package.tag_blocklist").Return("").Once()
 				s.mockPackageService.On("UpdatePackage", s.ctx, mock.MatchedBy(func(req *protopackage.UpdatePackageRequest) bool {
 					return req.GetId() == packageID && req.GetName() == name && req.GetUrl() == url && req.GetUserId() == fmt.Sprint(userID)
 				})).Return(nil, errors.New("error")).Once()
 			},
 			expectedErr: errors.New("error"),
 		},
+		{
+			name: "Sad path - tag is blocked",
+			request: &protopackage.UpdatePackageRequest{
+				Id:     packageID,
+				Name:   name,
+				Url:    url,
+				Tags:   []string{"goravel", "Casino"},
+				UserId: fmt.Sprint(userID),
+			},
+			setup: func() {
+				s.mockConfig.On("GetString", "package.tag_blocklist").Return("spam, casino").Once()
+				s.mockLang.On("Get", "invalid.blocked_tag", mock.Anything).Return("The tag \"Casino\" isn't allowed").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("The tag \"Casino\" isn't allowed"),
+		},
 		{
 			name: "Sad path - Request validation error",
 			request: &protopackage.UpdatePackageRequest{
@@ -605,8 +1110,2017 @@ func (s *PackageControllerSuite) TestUpdatePackage() {
 			s.Equal(test.expectedResponse, response)
 			s.Equal(test.expectedErr, err)
 
+			s.mockConfig.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+			s.mockPackageService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestGetPendingPackages() {
+	var (
+		users = []*protouser.User{
+			{
+				Id:   "1",
+				Name: "test",
+			},
+		}
+		userID     = uint64(1)
+		name       = "goravel"
+		pagination = &protobase.Pagination{
+			Page:  1,
+			Limit: 10,
+		}
+	)
+
+	tests := []struct {
+		name             string
+		request          *protopackage.GetPendingPackagesRequest
+		setup            func()
+		expectedResponse *protopackage.GetPendingPackagesResponse
+		expectedErr      error
+	}{
+		{
+			name: "Happy path",
+			request: &protopackage.GetPendingPackagesRequest{
+				UserId:     "1",
+				Pagination: pagination,
+			},
+			setup: func() {
+				s.mockPackageService.On("GetPendingPackages", s.ctx, pagination).Return([]*models.Package{
+					{
+						UUIDModel: models.UUIDModel{
+							ID: 1,
+						},
+						UserID:     userID,
+						Name:       name,
+						User:       users[0],
+						IsApproved: models.PackageNotApproved,
+					},
+				}, int64(1), "", nil).Once()
+			},
+			expectedResponse: &protopackage.GetPendingPackagesResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Packages: []*protopackage.Package{
+					{
+						Id:     "1",
+						UserId: fmt.Sprint(userID),
+						Name:   name,
+						User:   users[0],
+						Tags:   []*protopackage.Tag{},
+					},
+				},
+				Total: 1,
+			},
+		},
+		{
+			name: "Happy path - pagination is nil",
+			request: &protopackage.GetPendingPackagesRequest{
+				UserId: "1",
+			},
+			setup: func() {
+				s.mockPackageService.On("GetPendingPackages", s.ctx, &protobase.Pagination{Page: 1, Limit: 10}).Return([]*models.Package{}, int64(0), "", nil).Once()
+			},
+			expectedResponse: &protopackage.GetPendingPackagesResponse{
+				Status:   utilsresponse.NewOkStatus(),
+				Packages: []*protopackage.Package{},
+			},
+		},
+		{
+			name: "Sad path - GetPendingPackages returns error",
+			request: &protopackage.GetPendingPackagesRequest{
+				UserId:     "1",
+				Pagination: pagination,
+			},
+			setup: func() {
+				s.mockPackageService.On("GetPendingPackages", s.ctx, pagination).Return(nil, int64(0), "", errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.GetPendingPackages(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockPackageService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestApprovePackage() {
+	var (
+		packageID = "1"
+		userID    = uint64(1)
+		name      = "goravel"
+	)
+
+	tests := []struct {
+		name             string
+		request          *protopackage.ApprovePackageRequest
+		setup            func()
+		expectedResponse *protopackage.ApprovePackageResponse
+		expectedErr      error
+	}{
+		{
+			name: "Happy path",
+			request: &protopackage.ApprovePackageRequest{
+				UserId: "2",
+				Id:     packageID,
+			},
+			setup: func() {
+				s.mockPackageService.On("ApprovePackage", s.ctx, packageID).Return(&models.Package{
+					UUIDModel: models.UUIDModel{
+						ID: 1,
+					},
+					UserID:     userID,
+					Name:       name,
+					IsApproved: models.PackageApproved,
+				}, nil).Once()
+			},
+			expectedResponse: &protopackage.ApprovePackageResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Package: &protopackage.Package{
+					Id:         packageID,
+					UserId:     fmt.Sprint(userID),
+					Name:       name,
+					Tags:       []*protopackage.Tag{},
+					IsApproved: true,
+				},
+			},
+		},
+		{
+			name:    "Sad path - PackageID is empty",
+			request: &protopackage.ApprovePackageRequest{UserId: "2"},
+			setup: func() {
+				s.mockLang.On("Get", "required.package_id").Return("PackageID is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("PackageID is required"),
+		},
+		{
+			name: "Sad path - ApprovePackage returns error",
+			request: &protopackage.ApprovePackageRequest{
+				UserId: "2",
+				Id:     packageID,
+			},
+			setup: func() {
+				s.mockPackageService.On("ApprovePackage", s.ctx, packageID).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.ApprovePackage(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockPackageService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestDeletePackage() {
+	var (
+		packageID = "1"
+		userID    = "1"
+	)
+
+	tests := []struct {
+		name             string
+		request          *protopackage.DeletePackageRequest
+		setup            func()
+		expectedResponse *protopackage.DeletePackageResponse
+		expectedErr      error
+	}{
+		{
+			name: "Happy path",
+			request: &protopackage.DeletePackageRequest{
+				UserId: userID,
+				Id:     packageID,
+			},
+			setup: func() {
+				s.mockPackageService.On("DeletePackage", s.ctx, packageID, userID).Return(nil).Once()
+			},
+			expectedResponse: &protopackage.DeletePackageResponse{
+				Status: utilsresponse.NewOkStatus(),
+			},
+		},
+		{
+			name:    "Sad path - PackageID is empty",
+			request: &protopackage.DeletePackageRequest{UserId: userID},
+			setup: func() {
+				s.mockLang.On("Get", "required.package_id").Return("PackageID is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("PackageID is required"),
+		},
+		{
+			name: "Sad path - DeletePackage returns error",
+			request: &protopackage.DeletePackageRequest{
+				UserId: userID,
+				Id:     packageID,
+			},
+			setup: func() {
+				s.mockPackageService.On("DeletePackage", s.ctx, packageID, userID).Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.DeletePackage(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
 			s.mockLang.AssertExpectations(s.T())
 			s.mockPackageService.AssertExpectations(s.T())
 		})
 	}
 }
+
+func (s *PackageControllerSuite) TestRejectPackage() {
+	var (
+		packageID = "1"
+		userID    = uint64(1)
+		name      = "goravel"
+		reason    = "The link is broken"
+	)
+
+	tests := []struct {
+		name             string
+		request          *protopackage.RejectPackageRequest
+		setup            func()
+		expectedResponse *protopackage.RejectPackageResponse
+		expectedErr      error
+	}{
+		{
+			name: "Happy path",
+			request: &protopackage.RejectPackageRequest{
+				UserId: "2",
+				Id:     packageID,
+				Reason: reason,
+			},
+			setup: func() {
+				s.mockPackageService.On("RejectPackage", s.ctx, packageID, reason).Return(&models.Package{
+					UUIDModel: models.UUIDModel{
+						ID: 1,
+					},
+					UserID:       userID,
+					Name:         name,
+					IsApproved:   models.PackageRejected,
+					RejectReason: reason,
+				}, nil).Once()
+			},
+			expectedResponse: &protopackage.RejectPackageResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Package: &protopackage.Package{
+					Id:           packageID,
+					UserId:       fmt.Sprint(userID),
+					Name:         name,
+					Tags:         []*protopackage.Tag{},
+					RejectReason: reason,
+				},
+			},
+		},
+		{
+			name: "Sad path - Request validation error",
+			request: &protopackage.RejectPackageRequest{
+				UserId: "2",
+				Id:     packageID,
+			},
+			setup: func() {
+				s.mockLang.On("Get", "required.reason").Return("Reason is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("Reason is required"),
+		},
+		{
+			name: "Sad path - RejectPackage returns error",
+			request: &protopackage.RejectPackageRequest{
+				UserId: "2",
+				Id:     packageID,
+				Reason: reason,
+			},
+			setup: func() {
+				s.mockPackageService.On("RejectPackage", s.ctx, packageID, reason).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.RejectPackage(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockPackageService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestRestorePackage() {
+	var (
+		packageID = "1"
+		userID    = uint64(1)
+		name      = "goravel"
+	)
+
+	tests := []struct {
+		name             string
+		request          *protopackage.RestorePackageRequest
+		setup            func()
+		expectedResponse *protopackage.RestorePackageResponse
+		expectedErr      error
+	}{
+		{
+			name: "Happy path",
+			request: &protopackage.RestorePackageRequest{
+				UserId: fmt.Sprint(userID),
+				Id:     packageID,
+			},
+			setup: func() {
+				s.mockPackageService.On("RestorePackage", s.ctx, packageID, fmt.Sprint(userID)).Return(&models.Package{
+					UUIDModel: models.UUIDModel{
+						ID: 1,
+					},
+					UserID: userID,
+					Name:   name,
+				}, nil).Once()
+			},
+			expectedResponse: &protopackage.RestorePackageResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Package: &protopackage.Package{
+					Id:     packageID,
+					UserId: fmt.Sprint(userID),
+					Name:   name,
+					Tags:   []*protopackage.Tag{},
+				},
+			},
+		},
+		{
+			name:    "Sad path - PackageID is empty",
+			request: &protopackage.RestorePackageRequest{UserId: fmt.Sprint(userID)},
+			setup: func() {
+				s.mockLang.On("Get", "required.package_id").Return("PackageID is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("PackageID is required"),
+		},
+		{
+			name: "Sad path - RestorePackage returns error",
+			request: &protopackage.RestorePackageRequest{
+				UserId: fmt.Sprint(userID),
+				Id:     packageID,
+			},
+			setup: func() {
+				s.mockPackageService.On("RestorePackage", s.ctx, packageID, fmt.Sprint(userID)).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.RestorePackage(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockPackageService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestCreateRelease() {
+	var (
+		packageID = "1"
+		userID    = "1"
+	)
+
+	tests := []struct {
+		name             string
+		request          *protopackage.CreateReleaseRequest
+		setup            func()
+		expectedResponse *protopackage.CreateReleaseResponse
+		expectedErr      error
+	}{
+		{
+			name: "Happy path",
+			request: &protopackage.CreateReleaseRequest{
+				UserId:            userID,
+				PackageId:         packageID,
+				Version:           "v1.0.0",
+				FrameworkVersions: []string{"v1.14"},
+			},
+			setup: func() {
+				s.mockReleaseService.On("CreateRelease", s.ctx, mock.Anything).Return(&models.Release{
+					UUIDModel:         models.UUIDModel{ID: 1},
+					PackageID:         1,
+					UserID:            1,
+					Version:           "v1.0.0",
+					FrameworkVersions: "v1.14",
+				}, nil).Once()
+			},
+			expectedResponse: &protopackage.CreateReleaseResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Release: &protopackage.Release{
+					Id:                "1",
+					PackageId:         packageID,
+					UserId:            userID,
+					Version:           "v1.0.0",
+					FrameworkVersions: []string{"v1.14"},
+				},
+			},
+		},
+		{
+			name: "Sad path - Version is invalid",
+			request: &protopackage.CreateReleaseRequest{
+				UserId:    userID,
+				PackageId: packageID,
+				Version:   "latest",
+			},
+			setup: func() {
+				s.mockLang.On("Get", "invalid.version").Return("version is invalid").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("version is invalid"),
+		},
+		{
+			name: "Sad path - CreateRelease returns error",
+			request: &protopackage.CreateReleaseRequest{
+				UserId:    userID,
+				PackageId: packageID,
+				Version:   "v1.0.0",
+			},
+			setup: func() {
+				s.mockReleaseService.On("CreateRelease", s.ctx, mock.Anything).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.CreateRelease(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockReleaseService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestGetRelease() {
+	var (
+		packageID = "1"
+		userID    = "1"
+		release   = &models.Release{
+			UUIDModel:         models.UUIDModel{ID: 1},
+			PackageID:         1,
+			UserID:            1,
+			Version:           "v1.0.0",
+			FrameworkVersions: "v1.14",
+		}
+	)
+
+	tests := []struct {
+		name             string
+		request          *protopackage.GetReleaseRequest
+		setup            func()
+		expectedResponse *protopackage.GetReleaseResponse
+		expectedErr      error
+	}{
+		{
+			name: "Happy path",
+			request: &protopackage.GetReleaseRequest{
+				UserId:    userID,
+				PackageId: packageID,
+				Version:   "v1.0.0",
+			},
+			setup: func() {
+				s.mockReleaseService.On("GetRelease", s.ctx, userID, packageID, "v1.0.0").Return(release, nil).Once()
+			},
+			expectedResponse: &protopackage.GetReleaseResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Release: &protopackage.Release{
+					Id:                "1",
+					PackageId:         packageID,
+					UserId:            userID,
+					Version:           "v1.0.0",
+					FrameworkVersions: []string{"v1.14"},
+				},
+			},
+		},
+		{
+			name: "Happy path - with framework version",
+			request: &protopackage.GetReleaseRequest{
+				UserId:           userID,
+				PackageId:        packageID,
+				Version:          "v1.0.0",
+				FrameworkVersion: "v1.14.3",
+			},
+			setup: func() {
+				s.mockReleaseService.On("GetRelease", s.ctx, userID, packageID, "v1.0.0").Return(release, nil).Once()
+			},
+			expectedResponse: &protopackage.GetReleaseResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Release: &protopackage.Release{
+					Id:                "1",
+					PackageId:         packageID,
+					UserId:            userID,
+					Version:           "v1.0.0",
+					FrameworkVersions: []string{"v1.14"},
+					IsCompatible:      true,
+				},
+			},
+		},
+		{
+			name:    "Sad path - Version is empty",
+			request: &protopackage.GetReleaseRequest{UserId: userID, PackageId: packageID},
+			setup: func() {
+				s.mockLang.On("Get", "required.version").Return("version is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("version is required"),
+		},
+		{
+			name: "Sad path - GetRelease returns error",
+			request: &protopackage.GetReleaseRequest{
+				UserId:    userID,
+				PackageId: packageID,
+				Version:   "v1.0.0",
+			},
+			setup: func() {
+				s.mockReleaseService.On("GetRelease", s.ctx, userID, packageID, "v1.0.0").Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.GetRelease(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockReleaseService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestListReleases() {
+	var (
+		packageID = "1"
+		userID    = "1"
+		releases  = []*models.Release{
+			{UUIDModel: models.UUIDModel{ID: 2}, PackageID: 1, UserID: 1, Version: "v1.1.0", FrameworkVersions: "v1.15"},
+			{UUIDModel: models.UUIDModel{ID: 1}, PackageID: 1, UserID: 1, Version: "v1.0.0", FrameworkVersions: "v1.14"},
+		}
+	)
+
+	tests := []struct {
+		name             string
+		request          *protopackage.ListReleasesRequest
+		setup            func()
+		expectedResponse *protopackage.ListReleasesResponse
+		expectedErr      error
+	}{
+		{
+			name: "Happy path",
+			request: &protopackage.ListReleasesRequest{
+				UserId:    userID,
+				PackageId: packageID,
+				Query:     &protopackage.ReleasesQuery{FrameworkVersion: "v1.14.0"},
+			},
+			setup: func() {
+				s.mockReleaseService.On("ListReleases", s.ctx, userID, packageID, &protopackage.ReleasesQuery{FrameworkVersion: "v1.14.0"}, &protobase.Pagination{Page: 1, Limit: 10}).Return(releases, int64(2), "", nil).Once()
+			},
+			expectedResponse: &protopackage.ListReleasesResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Releases: []*protopackage.Release{
+					{Id: "2", PackageId: packageID, UserId: userID, Version: "v1.1.0", FrameworkVersions: []string{"v1.15"}},
+					{Id: "1", PackageId: packageID, UserId: userID, Version: "v1.0.0", FrameworkVersions: []string{"v1.14"}, IsCompatible: true},
+				},
+				Total: 2,
+			},
+		},
+		{
+			name: "Sad path - Version range is invalid",
+			request: &protopackage.ListReleasesRequest{
+				UserId:    userID,
+				PackageId: packageID,
+				Query:     &protopackage.ReleasesQuery{FromVersion: "latest"},
+			},
+			setup: func() {
+				s.mockLang.On("Get", "invalid.version").Return("version is invalid").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("version is invalid"),
+		},
+		{
+			name: "Sad path - ListReleases returns error",
+			request: &protopackage.ListReleasesRequest{
+				UserId:     userID,
+				PackageId:  packageID,
+				Pagination: &protobase.Pagination{Page: 2, Limit: 5},
+			},
+			setup: func() {
+				s.mockReleaseService.On("ListReleases", s.ctx, userID, packageID, (*protopackage.ReleasesQuery)(nil), &protobase.Pagination{Page: 2, Limit: 5}).Return(nil, int64(0), "", errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.ListReleases(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockReleaseService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestSyncPackage() {
+	var (
+		packageID = "1"
+		userID    = "1"
+	)
+
+	tests := []struct {
+		name             string
+		request          *protopackage.SyncPackageRequest
+		setup            func()
+		expectedResponse *protopackage.SyncPackageResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: &protopackage.SyncPackageRequest{UserId: userID, Id: packageID},
+			setup: func() {
+				s.mockPackageSyncService.On("SyncPackage", s.ctx, packageID, userID).Return(&models.Package{
+					UUIDModel: models.UUIDModel{ID: 1},
+					UserID:    1,
+					Stars:     2000,
+					License:   "MIT",
+				}, nil).Once()
+			},
+			expectedResponse: &protopackage.SyncPackageResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Package: &protopackage.Package{
+					Id:      packageID,
+					UserId:  userID,
+					Stars:   2000,
+					License: "MIT",
+					Tags:    []*protopackage.Tag{},
+				},
+			},
+		},
+		{
+			name:    "Sad path - PackageID is empty",
+			request: &protopackage.SyncPackageRequest{UserId: userID},
+			setup: func() {
+				s.mockLang.On("Get", "required.package_id").Return("PackageID is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("PackageID is required"),
+		},
+		{
+			name:    "Sad path - SyncPackage returns error",
+			request: &protopackage.SyncPackageRequest{UserId: userID, Id: packageID},
+			setup: func() {
+				s.mockPackageSyncService.On("SyncPackage", s.ctx, packageID, userID).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.SyncPackage(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockPackageSyncService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestUploadPackageCover() {
+	var (
+		packageID = "1"
+		userID    = "1"
+		image     = []byte("image")
+		cover     = "https://goravel.dev/storage/covers/1/a.png"
+		thumbnail = "https://goravel.dev/storage/covers/1/a_thumb.png"
+	)
+
+	tests := []struct {
+		name             string
+		request          *protopackage.UploadPackageCoverRequest
+		setup            func()
+		expectedResponse *protopackage.UploadPackageCoverResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: &protopackage.UploadPackageCoverRequest{UserId: userID, Id: packageID, Image: image},
+			setup: func() {
+				s.mockPackageCoverService.On("UploadCover", s.ctx, packageID, userID, image).Return(&models.Package{
+					UUIDModel:      models.UUIDModel{ID: 1},
+					UserID:         1,
+					Cover:          cover,
+					CoverThumbnail: thumbnail,
+				}, nil).Once()
+			},
+			expectedResponse: &protopackage.UploadPackageCoverResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Package: &protopackage.Package{
+					Id:             packageID,
+					UserId:         userID,
+					Cover:          cover,
+					CoverThumbnail: thumbnail,
+					Tags:           []*protopackage.Tag{},
+				},
+			},
+		},
+		{
+			name:    "Sad path - PackageID is empty",
+			request: &protopackage.UploadPackageCoverRequest{UserId: userID, Image: image},
+			setup: func() {
+				s.mockLang.On("Get", "required.package_id").Return("PackageID is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("PackageID is required"),
+		},
+		{
+			name:    "Sad path - Image is empty",
+			request: &protopackage.UploadPackageCoverRequest{UserId: userID, Id: packageID},
+			setup: func() {
+				s.mockLang.On("Get", "required.image").Return("Image is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("Image is required"),
+		},
+		{
+			name:    "Sad path - UploadCover returns error",
+			request: &protopackage.UploadPackageCoverRequest{UserId: userID, Id: packageID, Image: image},
+			setup: func() {
+				s.mockPackageCoverService.On("UploadCover", s.ctx, packageID, userID, image).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.UploadPackageCover(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockPackageCoverService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestFavoritePackage() {
+	var (
+		packageID = "1"
+		userID    = "1"
+	)
+
+	tests := []struct {
+		name             string
+		request          *protopackage.FavoritePackageRequest
+		setup            func()
+		expectedResponse *protopackage.FavoritePackageResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: &protopackage.FavoritePackageRequest{UserId: userID, Id: packageID},
+			setup: func() {
+				s.mockPackageFavoriteService.On("FavoritePackage", s.ctx, packageID, userID).Return(&models.Package{
+					UUIDModel:     models.UUIDModel{ID: 1},
+					UserID:        2,
+					FavoriteCount: 1,
+					IsFavorited:   true,
+				}, nil).Once()
+			},
+			expectedResponse: &protopackage.FavoritePackageResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Package: &protopackage.Package{
+					Id:            packageID,
+					UserId:        "2",
+					FavoriteCount: 1,
+					IsFavorited:   true,
+					Tags:          []*protopackage.Tag{},
+				},
+			},
+		},
+		{
+			name:    "Sad path - PackageID is empty",
+			request: &protopackage.FavoritePackageRequest{UserId: userID},
+			setup: func() {
+				s.mockLang.On("Get", "required.package_id").Return("PackageID is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("PackageID is required"),
+		},
+		{
+			name:    "Sad path - FavoritePackage returns error",
+			request: &protopackage.FavoritePackageRequest{UserId: userID, Id: packageID},
+			setup: func() {
+				s.mockPackageFavoriteService.On("FavoritePackage", s.ctx, packageID, userID).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.FavoritePackage(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockPackageFavoriteService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestUnfavoritePackage() {
+	var (
+		packageID = "1"
+		userID    = "1"
+	)
+
+	tests := []struct {
+		name             string
+		request          *protopackage.UnfavoritePackageRequest
+		setup            func()
+		expectedResponse *protopackage.UnfavoritePackageResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: &protopackage.UnfavoritePackageRequest{UserId: userID, Id: packageID},
+			setup: func() {
+				s.mockPackageFavoriteService.On("UnfavoritePackage", s.ctx, packageID, userID).Return(&models.Package{
+					UUIDModel: models.UUIDModel{ID: 1},
+					UserID:    2,
+				}, nil).Once()
+			},
+			expectedResponse: &protopackage.UnfavoritePackageResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Package: &protopackage.Package{
+					Id:     packageID,
+					UserId: "2",
+					Tags:   []*protopackage.Tag{},
+				},
+			},
+		},
+		{
+			name:    "Sad path - PackageID is empty",
+			request: &protopackage.UnfavoritePackageRequest{UserId: userID},
+			setup: func() {
+				s.mockLang.On("Get", "required.package_id").Return("PackageID is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("PackageID is required"),
+		},
+		{
+			name:    "Sad path - UnfavoritePackage returns error",
+			request: &protopackage.UnfavoritePackageRequest{UserId: userID, Id: packageID},
+			setup: func() {
+				s.mockPackageFavoriteService.On("UnfavoritePackage", s.ctx, packageID, userID).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.UnfavoritePackage(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockPackageFavoriteService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestListFavoritePackages() {
+	userID := "1"
+
+	tests := []struct {
+		name             string
+		request          *protopackage.ListFavoritePackagesRequest
+		setup            func()
+		expectedResponse *protopackage.ListFavoritePackagesResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: &protopackage.ListFavoritePackagesRequest{UserId: userID},
+			setup: func() {
+				s.mockPackageFavoriteService.On("ListFavoritePackages", s.ctx, userID, &protobase.Pagination{Page: 1, Limit: 10}).Return([]*models.Package{
+					{UUIDModel: models.UUIDModel{ID: 1}, UserID: 2, IsFavorited: true},
+				}, int64(2), "cursor", nil).Once()
+			},
+			expectedResponse: &protopackage.ListFavoritePackagesResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Packages: []*protopackage.Package{
+					{Id: "1", UserId: "2", IsFavorited: true, Tags: []*protopackage.Tag{}},
+				},
+				Total:      2,
+				NextCursor: "cursor",
+			},
+		},
+		{
+			name:    "Sad path - cursor is invalid",
+			request: &protopackage.ListFavoritePackagesRequest{UserId: userID, Pagination: &protobase.Pagination{Cursor: "invalid"}},
+			setup: func() {
+				s.mockLang.On("Get", "invalid.cursor").Return("invalid cursor").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("invalid cursor"),
+		},
+		{
+			name:    "Sad path - ListFavoritePackages returns error",
+			request: &protopackage.ListFavoritePackagesRequest{UserId: userID},
+			setup: func() {
+				s.mockPackageFavoriteService.On("ListFavoritePackages", s.ctx, userID, &protobase.Pagination{Page: 1, Limit: 10}).Return(nil, int64(0), "", errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.ListFavoritePackages(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockPackageFavoriteService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestCreateReview() {
+	request := &protopackage.CreateReviewRequest{UserId: "2", PackageId: "1", Rating: 5, Content: "Great"}
+
+	tests := []struct {
+		name             string
+		request          *protopackage.CreateReviewRequest
+		setup            func()
+		expectedResponse *protopackage.CreateReviewResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: request,
+			setup: func() {
+				s.mockReviewService.On("CreateReview", s.ctx, request).Return(&models.Review{
+					UUIDModel: models.UUIDModel{ID: 10},
+					PackageID: 1,
+					UserID:    2,
+					Rating:    5,
+					Content:   "Great",
+				}, nil).Once()
+			},
+			expectedResponse: &protopackage.CreateReviewResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Review: &protopackage.Review{Id: "10", PackageId: "1", UserId: "2", Rating: 5, Content: "Great"},
+			},
+		},
+		{
+			name:    "Sad path - rating is invalid",
+			request: &protopackage.CreateReviewRequest{UserId: "2", PackageId: "1"},
+			setup: func() {
+				s.mockLang.On("Get", "invalid.rating").Return("rating is invalid").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("rating is invalid"),
+		},
+		{
+			name:    "Sad path - CreateReview returns error",
+			request: request,
+			setup: func() {
+				s.mockReviewService.On("CreateReview", s.ctx, request).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.CreateReview(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockReviewService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestUpdateReview() {
+	request := &protopackage.UpdateReviewRequest{UserId: "2", PackageId: "1", Id: "10", Rating: 3, Content: "Good"}
+
+	tests := []struct {
+		name             string
+		request          *protopackage.UpdateReviewRequest
+		setup            func()
+		expectedResponse *protopackage.UpdateReviewResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: request,
+			setup: func() {
+				s.mockReviewService.On("UpdateReview", s.ctx, request).Return(&models.Review{
+					UUIDModel: models.UUIDModel{ID: 10},
+					PackageID: 1,
+					UserID:    2,
+					Rating:    3,
+					Content:   "Good",
+				}, nil).Once()
+			},
+			expectedResponse: &protopackage.UpdateReviewResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Review: &protopackage.Review{Id: "10", PackageId: "1", UserId: "2", Rating: 3, Content: "Good"},
+			},
+		},
+		{
+			name:    "Sad path - ID is empty",
+			request: &protopackage.UpdateReviewRequest{UserId: "2", PackageId: "1", Rating: 3},
+			setup: func() {
+				s.mockLang.On("Get", "required.id").Return("ID is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("ID is required"),
+		},
+		{
+			name:    "Sad path - UpdateReview returns error",
+			request: request,
+			setup: func() {
+				s.mockReviewService.On("UpdateReview", s.ctx, request).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.UpdateReview(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockReviewService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestDeleteReview() {
+	tests := []struct {
+		name             string
+		request          *protopackage.DeleteReviewRequest
+		setup            func()
+		expectedResponse *protopackage.DeleteReviewResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: &protopackage.DeleteReviewRequest{UserId: "2", PackageId: "1", Id: "10"},
+			setup: func() {
+				s.mockReviewService.On("DeleteReview", s.ctx, "2", "1", "10").Return(nil).Once()
+			},
+			expectedResponse: &protopackage.DeleteReviewResponse{
+				Status: utilsresponse.NewOkStatus(),
+			},
+		},
+		{
+			name:    "Sad path - PackageID is empty",
+			request: &protopackage.DeleteReviewRequest{UserId: "2", Id: "10"},
+			setup: func() {
+				s.mockLang.On("Get", "required.package_id").Return("PackageID is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("PackageID is required"),
+		},
+		{
+			name:    "Sad path - ID is empty",
+			request: &protopackage.DeleteReviewRequest{UserId: "2", PackageId: "1"},
+			setup: func() {
+				s.mockLang.On("Get", "required.id").Return("ID is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("ID is required"),
+		},
+		{
+			name:    "Sad path - DeleteReview returns error",
+			request: &protopackage.DeleteReviewRequest{UserId: "2", PackageId: "1", Id: "10"},
+			setup: func() {
+				s.mockReviewService.On("DeleteReview", s.ctx, "2", "1", "10").Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.DeleteReview(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockReviewService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestListReviews() {
+	user := &protouser.User{Id: "2", Name: "goravel"}
+
+	tests := []struct {
+		name             string
+		request          *protopackage.ListReviewsRequest
+		setup            func()
+		expectedResponse *protopackage.ListReviewsResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: &protopackage.ListReviewsRequest{PackageId: "1"},
+			setup: func() {
+				s.mockReviewService.On("ListReviews", s.ctx, "", "1", &protobase.Pagination{Page: 1, Limit: 10}).Return([]*models.Review{
+					{UUIDModel: models.UUIDModel{ID: 10}, PackageID: 1, UserID: 2, Rating: 4, User: user},
+				}, int64(2), "cursor", nil).Once()
+			},
+			expectedResponse: &protopackage.ListReviewsResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Reviews: []*protopackage.Review{
+					{Id: "10", PackageId: "1", UserId: "2", Rating: 4, User: user},
+				},
+				Total:      2,
+				NextCursor: "cursor",
+			},
+		},
+		{
+			name:    "Sad path - PackageID is empty",
+			request: &protopackage.ListReviewsRequest{},
+			setup: func() {
+				s.mockLang.On("Get", "required.package_id").Return("PackageID is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("PackageID is required"),
+		},
+		{
+			name:    "Sad path - cursor is invalid",
+			request: &protopackage.ListReviewsRequest{PackageId: "1", Pagination: &protobase.Pagination{Cursor: "invalid"}},
+			setup: func() {
+				s.mockLang.On("Get", "invalid.cursor").Return("invalid cursor").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("invalid cursor"),
+		},
+		{
+			name:    "Sad path - ListReviews returns error",
+			request: &protopackage.ListReviewsRequest{PackageId: "1"},
+			setup: func() {
+				s.mockReviewService.On("ListReviews", s.ctx, "", "1", &protobase.Pagination{Page: 1, Limit: 10}).Return(nil, int64(0), "", errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.ListReviews(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockReviewService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestCreateComment() {
+	request := &protopackage.CreateCommentRequest{UserId: "2", PackageId: "1", ParentId: "10", Content: "Answer"}
+
+	tests := []struct {
+		name             string
+		request          *protopackage.CreateCommentRequest
+		setup            func()
+		expectedResponse *protopackage.CreateCommentResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: request,
+			setup: func() {
+				s.mockCommentService.On("CreateComment", s.ctx, request).Return(&models.Comment{
+					UUIDModel:       models.UUIDModel{ID: 11},
+					PackageID:       1,
+					UserID:          2,
+					ParentID:        10,
+					Content:         "Answer",
+					IsPackageAuthor: true,
+				}, nil).Once()
+			},
+			expectedResponse: &protopackage.CreateCommentResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Comment: &protopackage.Comment{
+					Id:              "11",
+					PackageId:       "1",
+					UserId:          "2",
+					ParentId:        "10",
+					Content:         "Answer",
+					IsPackageAuthor: true,
+					Replies:         []*protopackage.Comment{},
+				},
+			},
+		},
+		{
+			name:    "Sad path - PackageID is empty",
+			request: &protopackage.CreateCommentRequest{UserId: "2", Content: "Answer"},
+			setup: func() {
+				s.mockLang.On("Get", "required.package_id").Return("PackageID is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("PackageID is required"),
+		},
+		{
+			name:    "Sad path - content is empty",
+			request: &protopackage.CreateCommentRequest{UserId: "2", PackageId: "1"},
+			setup: func() {
+				s.mockLang.On("Get", "required.content").Return("Content is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("Content is required"),
+		},
+		{
+			name:    "Sad path - CreateComment returns error",
+			request: request,
+			setup: func() {
+				s.mockCommentService.On("CreateComment", s.ctx, request).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.CreateComment(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockCommentService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestUpdateComment() {
+	request := &protopackage.UpdateCommentRequest{UserId: "2", PackageId: "1", Id: "10", Content: "Edited"}
+
+	tests := []struct {
+		name             string
+		request          *protopackage.UpdateCommentRequest
+		setup            func()
+		expectedResponse *protopackage.UpdateCommentResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: request,
+			setup: func() {
+				s.mockCommentService.On("UpdateComment", s.ctx, request).Return(&models.Comment{
+					UUIDModel: models.UUIDModel{ID: 10},
+					PackageID: 1,
+					UserID:    2,
+					Content:   "Edited",
+				}, nil).Once()
+			},
+			expectedResponse: &protopackage.UpdateCommentResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Comment: &protopackage.Comment{
+					Id:        "10",
+					PackageId: "1",
+					UserId:    "2",
+					Content:   "Edited",
+					Replies:   []*protopackage.Comment{},
+				},
+			},
+		},
+		{
+			name:    "Sad path - ID is empty",
+			request: &protopackage.UpdateCommentRequest{UserId: "2", PackageId: "1", Content: "Edited"},
+			setup: func() {
+				s.mockLang.On("Get", "required.id").Return("ID is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("ID is required"),
+		},
+		{
+			name:    "Sad path - UpdateComment returns error",
+			request: request,
+			setup: func() {
+				s.mockCommentService.On("UpdateComment", s.ctx, request).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.UpdateComment(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockCommentService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestDeleteComment() {
+	tests := []struct {
+		name             string
+		request          *protopackage.DeleteCommentRequest
+		setup            func()
+		expectedResponse *protopackage.DeleteCommentResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: &protopackage.DeleteCommentRequest{UserId: "2", PackageId: "1", Id: "10"},
+			setup: func() {
+				s.mockCommentService.On("DeleteComment", s.ctx, "2", "1", "10").Return(nil).Once()
+			},
+			expectedResponse: &protopackage.DeleteCommentResponse{
+				Status: utilsresponse.NewOkStatus(),
+			},
+		},
+		{
+			name:    "Sad path - ID is empty",
+			request: &protopackage.DeleteCommentRequest{UserId: "2", PackageId: "1"},
+			setup: func() {
+				s.mockLang.On("Get", "required.id").Return("ID is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("ID is required"),
+		},
+		{
+			name:    "Sad path - DeleteComment returns error",
+			request: &protopackage.DeleteCommentRequest{UserId: "2", PackageId: "1", Id: "10"},
+			setup: func() {
+				s.mockCommentService.On("DeleteComment", s.ctx, "2", "1", "10").Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.DeleteComment(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockCommentService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestListComments() {
+	user := &protouser.User{Id: "2", Name: "goravel"}
+
+	tests := []struct {
+		name             string
+		request          *protopackage.ListCommentsRequest
+		setup            func()
+		expectedResponse *protopackage.ListCommentsResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: &protopackage.ListCommentsRequest{PackageId: "1"},
+			setup: func() {
+				s.mockCommentService.On("ListComments", s.ctx, "", "1", "", &protobase.Pagination{Page: 1, Limit: 10}).Return([]*models.Comment{
+					{
+						UUIDModel:      models.UUIDModel{ID: 10},
+						PackageID:      1,
+						UserID:         2,
+						Content:        "Question",
+						User:           user,
+						Replies:        []*models.Comment{{UUIDModel: models.UUIDModel{ID: 11}, PackageID: 1, UserID: 3, ParentID: 10, Content: "Answer", IsPackageAuthor: true}},
+						HasMoreReplies: true,
+					},
+				}, int64(1), "", nil).Once()
+			},
+			expectedResponse: &protopackage.ListCommentsResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Comments: []*protopackage.Comment{
+					{
+						Id:        "10",
+						PackageId: "1",
+						UserId:    "2",
+						Content:   "Question",
+						User:      user,
+						Replies: []*protopackage.Comment{
+							{Id: "11", PackageId: "1", UserId: "3", ParentId: "10", Content: "Answer", IsPackageAuthor: true, Replies: []*protopackage.Comment{}},
+						},
+						HasMoreReplies: true,
+					},
+				},
+				Total: 1,
+			},
+		},
+		{
+			name:    "Happy path - list the replies of the parent",
+			request: &protopackage.ListCommentsRequest{PackageId: "1", ParentId: "10", Pagination: &protobase.Pagination{Limit: 20}},
+			setup: func() {
+				s.mockCommentService.On("ListComments", s.ctx, "", "1", "10", &protobase.Pagination{Page: 1, Limit: 20}).Return([]*models.Comment{
+					{UUIDModel: models.UUIDModel{ID: 11}, PackageID: 1, UserID: 3, ParentID: 10, Content: "Answer"},
+				}, int64(5), "cursor", nil).Once()
+			},
+			expectedResponse: &protopackage.ListCommentsResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Comments: []*protopackage.Comment{
+					{Id: "11", PackageId: "1", UserId: "3", ParentId: "10", Content: "Answer", Replies: []*protopackage.Comment{}},
+				},
+				Total:      5,
+				NextCursor: "cursor",
+			},
+		},
+		{
+			name:    "Sad path - PackageID is empty",
+			request: &protopackage.ListCommentsRequest{},
+			setup: func() {
+				s.mockLang.On("Get", "required.package_id").Return("PackageID is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("PackageID is required"),
+		},
+		{
+			name:    "Sad path - ListComments returns error",
+			request: &protopackage.ListCommentsRequest{PackageId: "1"},
+			setup: func() {
+				s.mockCommentService.On("ListComments", s.ctx, "", "1", "", &protobase.Pagination{Page: 1, Limit: 10}).Return(nil, int64(0), "", errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.ListComments(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockCommentService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestCreateTag() {
+	request := &protopackage.CreateTagRequest{UserId: "1", Name: "orm"}
+
+	tests := []struct {
+		name             string
+		request          *protopackage.CreateTagRequest
+		setup            func()
+		expectedResponse *protopackage.CreateTagResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: request,
+			setup: func() {
+				s.mockConfig.On("GetString", "package.tag_blocklist").Return("").Once()
+				s.mockTagService.On("CreateTag", s.ctx, request).Return(&models.Tag{UUIDModel: models.UUIDModel{ID: 2}, UserID: 1, Name: "orm", IsShow: models.TagShown}, nil).Once()
+			},
+			expectedResponse: &protopackage.CreateTagResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Tag:    &protopackage.Tag{Id: "2", UserId: "1", Name: "orm", IsShow: 1},
+			},
+		},
+		{
+			name:    "Sad path - name is empty",
+			request: &protopackage.CreateTagRequest{UserId: "1"},
+			setup: func() {
+				s.mockLang.On("Get", "required.name").Return("Name is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("Name is required"),
+		},
+		{
+			name:    "Sad path - CreateTag returns error",
+			request: request,
+			setup: func() {
+				s.mockConfig.On("GetString", "package.tag_blocklist").Return("").Once()
+				s.mockTagService.On("CreateTag", s.ctx, request).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.CreateTag(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockTagService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestUpdateTag() {
+	request := &protopackage.UpdateTagRequest{UserId: "1", Id: "2", IsShow: 2}
+
+	tests := []struct {
+		name             string
+		request          *protopackage.UpdateTagRequest
+		setup            func()
+		expectedResponse *protopackage.UpdateTagResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: request,
+			setup: func() {
+				s.mockTagService.On("UpdateTag", s.ctx, request).Return(&models.Tag{UUIDModel: models.UUIDModel{ID: 2}, Name: "orm", IsShow: models.TagHidden}, nil).Once()
+			},
+			expectedResponse: &protopackage.UpdateTagResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Tag:    &protopackage.Tag{Id: "2", Name: "orm", IsShow: 2},
+			},
+		},
+		{
+			name:    "Sad path - IsShow is invalid",
+			request: &protopackage.UpdateTagRequest{UserId: "1", Id: "2", IsShow: 3},
+			setup: func() {
+				s.mockLang.On("Get", "invalid.is_show").Return("IsShow is invalid").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("IsShow is invalid"),
+		},
+		{
+			name:    "Sad path - UpdateTag returns error",
+			request: request,
+			setup: func() {
+				s.mockTagService.On("UpdateTag", s.ctx, request).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.UpdateTag(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockTagService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestMergeTags() {
+	tests := []struct {
+		name             string
+		request          *protopackage.MergeTagsRequest
+		setup            func()
+		expectedResponse *protopackage.MergeTagsResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: &protopackage.MergeTagsRequest{UserId: "1", Id: "3", TargetId: "2"},
+			setup: func() {
+				s.mockTagService.On("MergeTags", s.ctx, "3", "2").Return(&models.Tag{UUIDModel: models.UUIDModel{ID: 2}, Name: "orm", IsShow: models.TagShown}, nil).Once()
+			},
+			expectedResponse: &protopackage.MergeTagsResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Tag:    &protopackage.Tag{Id: "2", Name: "orm", IsShow: 1},
+			},
+		},
+		{
+			name:    "Sad path - TargetID is empty",
+			request: &protopackage.MergeTagsRequest{UserId: "1", Id: "3"},
+			setup: func() {
+				s.mockLang.On("Get", "required.target_id").Return("TargetID is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("TargetID is required"),
+		},
+		{
+			name:    "Sad path - MergeTags returns error",
+			request: &protopackage.MergeTagsRequest{UserId: "1", Id: "3", TargetId: "2"},
+			setup: func() {
+				s.mockTagService.On("MergeTags", s.ctx, "3", "2").Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.MergeTags(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockTagService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestCreateTagAlias() {
+	request := &protopackage.CreateTagAliasRequest{UserId: "1", TagId: "2", Name: "ORM"}
+
+	tests := []struct {
+		name             string
+		request          *protopackage.CreateTagAliasRequest
+		setup            func()
+		expectedResponse *protopackage.CreateTagAliasResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: request,
+			setup: func() {
+				s.mockConfig.On("GetString", "package.tag_blocklist").Return("").Once()
+				s.mockTagService.On("CreateTagAlias", s.ctx, request).Return(&models.TagAlias{UUIDModel: models.UUIDModel{ID: 3}, TagID: 2, Name: "orm"}, nil).Once()
+			},
+			expectedResponse: &protopackage.CreateTagAliasResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Alias:  &protopackage.TagAlias{Id: "3", TagId: "2", Name: "orm", CreatedAt: carbon.DateTime{}.ToString(), UpdatedAt: carbon.DateTime{}.ToString()},
+			},
+		},
+		{
+			name:    "Sad path - TagID is empty",
+			request: &protopackage.CreateTagAliasRequest{UserId: "1", Name: "ORM"},
+			setup: func() {
+				s.mockLang.On("Get", "required.tag_id").Return("TagID is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("TagID is required"),
+		},
+		{
+			name:    "Sad path - CreateTagAlias returns error",
+			request: request,
+			setup: func() {
+				s.mockConfig.On("GetString", "package.tag_blocklist").Return("").Once()
+				s.mockTagService.On("CreateTagAlias", s.ctx, request).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.CreateTagAlias(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockTagService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestDeleteTagAlias() {
+	tests := []struct {
+		name             string
+		request          *protopackage.DeleteTagAliasRequest
+		setup            func()
+		expectedResponse *protopackage.DeleteTagAliasResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: &protopackage.DeleteTagAliasRequest{UserId: "1", TagId: "2", Name: "orm"},
+			setup: func() {
+				s.mockTagService.On("DeleteTagAlias", s.ctx, "2", "orm").Return(nil).Once()
+			},
+			expectedResponse: &protopackage.DeleteTagAliasResponse{
+				Status: utilsresponse.NewOkStatus(),
+			},
+		},
+		{
+			name:    "Sad path - name is empty",
+			request: &protopackage.DeleteTagAliasRequest{UserId: "1", TagId: "2"},
+			setup: func() {
+				s.mockLang.On("Get", "required.name").Return("Name is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("Name is required"),
+		},
+		{
+			name:    "Sad path - DeleteTagAlias returns error",
+			request: &protopackage.DeleteTagAliasRequest{UserId: "1", TagId: "2", Name: "orm"},
+			setup: func() {
+				s.mockTagService.On("DeleteTagAlias", s.ctx, "2", "orm").Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.DeleteTagAlias(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockTagService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestGetMaintainers() {
+	user := &protouser.User{Id: "2", Name: "goravel"}
+
+	tests := []struct {
+		name             string
+		request          *protopackage.GetMaintainersRequest
+		setup            func()
+		expectedResponse *protopackage.GetMaintainersResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: &protopackage.GetMaintainersRequest{PackageId: "1"},
+			setup: func() {
+				s.mockPackageMaintainerService.On("GetMaintainers", s.ctx, "1", "").Return([]*models.Maintainer{
+					{UUIDModel: models.UUIDModel{ID: 1}, PackageID: 1, UserID: 2, Role: models.MaintainerRoleOwner, User: user},
+				}, nil).Once()
+			},
+			expectedResponse: &protopackage.GetMaintainersResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Maintainers: []*protopackage.Maintainer{
+					{PackageId: "1", UserId: "2", Role: models.MaintainerRoleOwner, User: user},
+				},
+			},
+		},
+		{
+			name:    "Sad path - PackageID is empty",
+			request: &protopackage.GetMaintainersRequest{},
+			setup: func() {
+				s.mockLang.On("Get", "required.package_id").Return("PackageID is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("PackageID is required"),
+		},
+		{
+			name:    "Sad path - GetMaintainers returns error",
+			request: &protopackage.GetMaintainersRequest{PackageId: "1"},
+			setup: func() {
+				s.mockPackageMaintainerService.On("GetMaintainers", s.ctx, "1", "").Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.GetMaintainers(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockPackageMaintainerService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestAddMaintainer() {
+	tests := []struct {
+		name             string
+		request          *protopackage.AddMaintainerRequest
+		setup            func()
+		expectedResponse *protopackage.AddMaintainerResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: &protopackage.AddMaintainerRequest{UserId: "1", PackageId: "1", MaintainerId: "2"},
+			setup: func() {
+				s.mockPackageMaintainerService.On("AddMaintainer", s.ctx, "1", "1", "2").Return(&models.Maintainer{UUIDModel: models.UUIDModel{ID: 2}, PackageID: 1, UserID: 2, Role: models.MaintainerRoleMaintainer}, nil).Once()
+			},
+			expectedResponse: &protopackage.AddMaintainerResponse{
+				Status:     utilsresponse.NewOkStatus(),
+				Maintainer: &protopackage.Maintainer{PackageId: "1", UserId: "2", Role: models.MaintainerRoleMaintainer},
+			},
+		},
+		{
+			name:    "Sad path - MaintainerID is empty",
+			request: &protopackage.AddMaintainerRequest{UserId: "1", PackageId: "1"},
+			setup: func() {
+				s.mockLang.On("Get", "required.maintainer_id").Return("MaintainerID is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("MaintainerID is required"),
+		},
+		{
+			name:    "Sad path - AddMaintainer returns error",
+			request: &protopackage.AddMaintainerRequest{UserId: "1", PackageId: "1", MaintainerId: "2"},
+			setup: func() {
+				s.mockPackageMaintainerService.On("AddMaintainer", s.ctx, "1", "1", "2").Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.AddMaintainer(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockPackageMaintainerService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestRemoveMaintainer() {
+	tests := []struct {
+		name             string
+		request          *protopackage.RemoveMaintainerRequest
+		setup            func()
+		expectedResponse *protopackage.RemoveMaintainerResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: &protopackage.RemoveMaintainerRequest{UserId: "1", PackageId: "1", MaintainerId: "2"},
+			setup: func() {
+				s.mockPackageMaintainerService.On("RemoveMaintainer", s.ctx, "1", "1", "2").Return(nil).Once()
+			},
+			expectedResponse: &protopackage.RemoveMaintainerResponse{
+				Status: utilsresponse.NewOkStatus(),
+			},
+		},
+		{
+			name:    "Sad path - MaintainerID is empty",
+			request: &protopackage.RemoveMaintainerRequest{UserId: "1", PackageId: "1"},
+			setup: func() {
+				s.mockLang.On("Get", "required.maintainer_id").Return("MaintainerID is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("MaintainerID is required"),
+		},
+		{
+			name:    "Sad path - RemoveMaintainer returns error",
+			request: &protopackage.RemoveMaintainerRequest{UserId: "1", PackageId: "1", MaintainerId: "2"},
+			setup: func() {
+				s.mockPackageMaintainerService.On("RemoveMaintainer", s.ctx, "1", "1", "2").Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.RemoveMaintainer(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockPackageMaintainerService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestTransferPackage() {
+	tests := []struct {
+		name             string
+		request          *protopackage.TransferPackageRequest
+		setup            func()
+		expectedResponse *protopackage.TransferPackageResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: &protopackage.TransferPackageRequest{UserId: "1", Id: "1", OwnerId: "2"},
+			setup: func() {
+				s.mockPackageMaintainerService.On("TransferPackage", s.ctx, "1", "1", "2").Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, PendingOwnerID: 2}, nil).Once()
+			},
+			expectedResponse: &protopackage.TransferPackageResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Package: &protopackage.Package{
+					Id:             "1",
+					UserId:         "1",
+					PendingOwnerId: "2",
+					Tags:           []*protopackage.Tag{},
+				},
+			},
+		},
+		{
+			name:    "Sad path - OwnerID is empty",
+			request: &protopackage.TransferPackageRequest{UserId: "1", Id: "1"},
+			setup: func() {
+				s.mockLang.On("Get", "required.owner_id").Return("OwnerID is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("OwnerID is required"),
+		},
+		{
+			name:    "Sad path - TransferPackage returns error",
+			request: &protopackage.TransferPackageRequest{UserId: "1", Id: "1", OwnerId: "2"},
+			setup: func() {
+				s.mockPackageMaintainerService.On("TransferPackage", s.ctx, "1", "1", "2").Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.TransferPackage(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockPackageMaintainerService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageControllerSuite) TestAcceptPackageTransfer() {
+	tests := []struct {
+		name             string
+		request          *protopackage.AcceptPackageTransferRequest
+		setup            func()
+		expectedResponse *protopackage.AcceptPackageTransferResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: &protopackage.AcceptPackageTransferRequest{UserId: "2", Id: "1"},
+			setup: func() {
+				s.mockPackageMaintainerService.On("AcceptPackageTransfer", s.ctx, "1", "2").Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 2}, nil).Once()
+			},
+			expectedResponse: &protopackage.AcceptPackageTransferResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Package: &protopackage.Package{
+					Id:     "1",
+					UserId: "2",
+					Tags:   []*protopackage.Tag{},
+				},
+			},
+		},
+		{
+			name:    "Sad path - PackageID is empty",
+			request: &protopackage.AcceptPackageTransferRequest{UserId: "2"},
+			setup: func() {
+				s.mockLang.On("Get", "required.package_id").Return("PackageID is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("PackageID is required"),
+		},
+		{
+			name:    "Sad path - AcceptPackageTransfer returns error",
+			request: &protopackage.AcceptPackageTransferRequest{UserId: "2", Id: "1"},
+			setup: func() {
+				s.mockPackageMaintainerService.On("AcceptPackageTransfer", s.ctx, "1", "2").Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.packageController.AcceptPackageTransfer(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockPackageMaintainerService.AssertExpectations(s.T())
+		})
+	}
+}
@@ -2,15 +2,31 @@ package controllers
 
 import (
 	"context"
+	"regexp"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/goravel/framework/contracts/translation"
 	"github.com/goravel/framework/facades"
 	"github.com/goravel/framework/support/carbon"
+	"github.com/spf13/cast"
+	"golang.org/x/mod/semver"
 
+	"market.goravel.dev/package/app/models"
+	protobase "market.goravel.dev/proto/base"
 	protopackage "market.goravel.dev/proto/package"
 	utilserrors "market.goravel.dev/utils/errors"
+	utilsfieldmask "market.goravel.dev/utils/fieldmask"
+	utilspagination "market.goravel.dev/utils/pagination"
 )
 
+// updatePackageFields are the fields of UpdatePackageRequest that can be set in its update mask.
+var updatePackageFields = []string{"name", "cover", "url", "summary", "description", "is_public", "version", "tags", "last_updated_at", "organization_id"}
+
+// tagNameRegexp matches the normalized tag names, they can only contain letters, digits, "-", ".", "+" and "#", e.g.
+// "orm", "c++" or "vue.js".
+var tagNameRegexp = regexp.MustCompile(`^[\p{L}\p{N}.+#-]+$`)
+
 func validateCreatePackageRequest(ctx context.Context, req *protopackage.CreatePackageRequest) error {
 	name := req.GetName()
 	url := req.GetUrl()
@@ -18,45 +34,60 @@ func validateCreatePackageRequest(ctx context.Context, req *protopackage.CreateP
 	summery := req.GetSummary()
 	description := req.GetDescription()
 	userID := req.GetUserId()
-	return validatePackageRequest(ctx, name, url, tags, summery, description, userID, req.GetLastUpdatedAt())
+	return validatePackageRequest(ctx, name, url, tags, summery, description, userID, req.GetLastUpdatedAt(), req.GetIsPublic(), nil)
 }
 
-func validatePackageRequest(ctx context.Context, name, url string, tags []string, summary, description, userID, lastUpdatedAt string) error {
-	translate := facades.Lang(ctx)
-	if userID == "" {
-		return utilserrors.NewBadRequest(translate.Get("required.user_id"))
-	}
-	if name == "" {
-		return utilserrors.NewBadRequest(translate.Get("required.name"))
-	}
-	if len(name) > 100 {
-		return utilserrors.NewBadRequest(translate.Get("max.name", translation.Option{
+func validateIdempotencyKey(ctx context.Context, key string) error {
+	if len(key) > 255 {
+		return utilserrors.NewBadRequest(facades.Lang(ctx).Get("max.idempotency_key", translation.Option{
 			Replace: map[string]string{
-				"max": "100",
+				"max": "255",
 			},
 		}))
 	}
 
-	if url == "" {
-		return utilserrors.NewBadRequest(translate.Get("required.url"))
+	return nil
+}
+
+// validatePackageRequest validates the fields of the package in the mask, all of them are validated if it's empty.
+func validatePackageRequest(ctx context.Context, name, url string, tags []string, summary, description, userID, lastUpdatedAt string, isPublic int32, fields utilsfieldmask.Mask) error {
+	translate := facades.Lang(ctx)
+	if userID == "" {
+		return utilserrors.NewBadRequest(translate.Get("required.user_id"))
 	}
-	if len(url) > 100 {
-		return utilserrors.NewBadRequest(translate.Get("max.url", translation.Option{
-			Replace: map[string]string{
-				"max": "100",
-			},
-		}))
+	if fields.Has("name") {
+		if name == "" {
+			return utilserrors.NewBadRequest(translate.Get("required.name"))
+		}
+		if len(name) > 100 {
+			return utilserrors.NewBadRequest(translate.Get("max.name", translation.Option{
+				Replace: map[string]string{
+					"max": "100",
+				},
+			}))
+		}
 	}
 
-	if len(tags) > 10 {
-		return utilserrors.NewBadRequest(translate.Get("max.tags", translation.Option{
-			Replace: map[string]string{
-				"max": "10",
-			},
-		}))
+	if fields.Has("url") {
+		if url == "" {
+			return utilserrors.NewBadRequest(translate.Get("required.url"))
+		}
+		if len(url) > 100 {
+			return utilserrors.NewBadRequest(translate.Get("max.url", translation.Option{
+				Replace: map[string]string{
+					"max": "100",
+				},
+			}))
+		}
+	}
+
+	if fields.Has("tags") {
+		if err := validateTags(ctx, tags); err != nil {
+			return err
+		}
 	}
 
-	if len(summary) > 200 {
+	if fields.Has("summary") && len(summary) > 200 {
 		return utilserrors.NewBadRequest(translate.Get("max.summary", translation.Option{
 			Replace: map[string]string{
 				"max": "200",
@@ -64,7 +95,7 @@ func validatePackageRequest(ctx context.Context, name, url string, tags []string
 		}))
 	}
 
-	if len(description) > 10000 {
+	if fields.Has("description") && len(description) > 10000 {
 		return utilserrors.NewBadRequest(translate.Get("max.description", translation.Option{
 			Replace: map[string]string{
 				"max": "10000",
@@ -72,10 +103,36 @@ func validatePackageRequest(ctx context.Context, name, url string, tags []string
 		}))
 	}
 
-	if lastUpdatedAt != "" && carbon.Parse(lastUpdatedAt).IsZero() {
+	if fields.Has("last_updated_at") && lastUpdatedAt != "" && carbon.Parse(lastUpdatedAt).IsZero() {
 		return utilserrors.NewBadRequest(translate.Get("invalid.last_updated_at"))
 	}
 
+	// 0 means the field is omitted, the package will be private.
+	if fields.Has("is_public") && isPublic != 0 && isPublic != models.PackagePublic && isPublic != models.PackagePrivate {
+		return utilserrors.NewBadRequest(translate.Get("invalid.is_public"))
+	}
+
+	return nil
+}
+
+func validateRejectPackageRequest(ctx context.Context, req *protopackage.RejectPackageRequest) error {
+	translate := facades.Lang(ctx)
+	if req.GetId() == "" {
+		return utilserrors.NewBadRequest(translate.Get("required.package_id"))
+	}
+
+	reason := req.GetReason()
+	if reason == "" {
+		return utilserrors.NewBadRequest(translate.Get("required.reason"))
+	}
+	if len(reason) > 200 {
+		return utilserrors.NewBadRequest(translate.Get("max.reason", translation.Option{
+			Replace: map[string]string{
+				"max": "200",
+			},
+		}))
+	}
+
 	return nil
 }
 
@@ -91,5 +148,298 @@ func validateUpdatePackageRequest(ctx context.Context, req *protopackage.UpdateP
 		return utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.id"))
 	}
 
-	return validatePackageRequest(ctx, name, url, tags, summery, description, userID, req.GetLastUpdatedAt())
+	if field := utilsfieldmask.Unknown(req.GetUpdateMask(), updatePackageFields); field != "" {
+		return utilserrors.NewBadRequest(facades.Lang(ctx).Get("invalid.update_mask", translation.Option{
+			Replace: map[string]string{
+				"field": field,
+			},
+		}))
+	}
+
+	return validatePackageRequest(ctx, name, url, tags, summery, description, userID, req.GetLastUpdatedAt(), req.GetIsPublic(), utilsfieldmask.New(req.GetUpdateMask()))
+}
+
+func validateGetPackagesRequest(ctx context.Context, req *protopackage.GetPackagesRequest) error {
+	translate := facades.Lang(ctx)
+	query := req.GetQuery()
+	if len(query.GetSearch()) > 100 {
+		return utilserrors.NewBadRequest(translate.Get("max.search", translation.Option{
+			Replace: map[string]string{
+				"max": "100",
+			},
+		}))
+	}
+
+	if len(query.GetTagIds())+len(query.GetTagNames()) > 10 {
+		return utilserrors.NewBadRequest(translate.Get("max.tag_filters", translation.Option{
+			Replace: map[string]string{
+				"max": "10",
+			},
+		}))
+	}
+	for _, tagID := range query.GetTagIds() {
+		if cast.ToUint64(tagID) == 0 {
+			return utilserrors.NewBadRequest(translate.Get("invalid.tag_id"))
+		}
+	}
+
+	if tagMatch := query.GetTagMatch(); tagMatch != "" && tagMatch != models.TagMatchAny && tagMatch != models.TagMatchAll {
+		return utilserrors.NewBadRequest(translate.Get("invalid.tag_match"))
+	}
+
+	if _, ok := models.PackageSortColumns[query.GetSort()]; query.GetSort() != "" && !ok {
+		return utilserrors.NewBadRequest(translate.Get("invalid.sort"))
+	}
+
+	if direction := query.GetDirection(); direction != "" && direction != "asc" && direction != "desc" {
+		return utilserrors.NewBadRequest(translate.Get("invalid.direction"))
+	}
+
+	return validatePagination(ctx, req.GetPagination())
+}
+
+func validateGetTagsRequest(ctx context.Context, req *protopackage.GetTagsRequest) error {
+	if sort := req.GetQuery().GetSort(); sort != "" && sort != models.TagSortPopular {
+		return utilserrors.NewBadRequest(facades.Lang(ctx).Get("invalid.tag_sort"))
+	}
+
+	return validatePagination(ctx, req.GetPagination())
+}
+
+func validateCreateReleaseRequest(ctx context.Context, req *protopackage.CreateReleaseRequest) error {
+	translate := facades.Lang(ctx)
+	if req.GetPackageId() == "" {
+		return utilserrors.NewBadRequest(translate.Get("required.package_id"))
+	}
+
+	if req.GetVersion() == "" {
+		return utilserrors.NewBadRequest(translate.Get("required.version"))
+	}
+	if !isValidVersion(req.GetVersion()) {
+		return utilserrors.NewBadRequest(translate.Get("invalid.version"))
+	}
+
+	if len(req.GetChangelog()) > 10000 {
+		return utilserrors.NewBadRequest(translate.Get("max.changelog", translation.Option{
+			Replace: map[string]string{
+				"max": "10000",
+			},
+		}))
+	}
+
+	if len(req.GetFrameworkVersions()) > 20 {
+		return utilserrors.NewBadRequest(translate.Get("max.framework_versions", translation.Option{
+			Replace: map[string]string{
+				"max": "20",
+			},
+		}))
+	}
+	for _, frameworkVersion := range req.GetFrameworkVersions() {
+		if !isValidVersion(frameworkVersion) {
+			return utilserrors.NewBadRequest(translate.Get("invalid.framework_version"))
+		}
+	}
+
+	if req.GetPublishedAt() != "" && carbon.Parse(req.GetPublishedAt()).IsZero() {
+		return utilserrors.NewBadRequest(translate.Get("invalid.published_at"))
+	}
+
+	return nil
+}
+
+func validateListReleasesRequest(ctx context.Context, req *protopackage.ListReleasesRequest) error {
+	translate := facades.Lang(ctx)
+	if req.GetPackageId() == "" {
+		return utilserrors.NewBadRequest(translate.Get("required.package_id"))
+	}
+
+	query := req.GetQuery()
+	for _, version := range []string{query.GetFromVersion(), query.GetToVersion()} {
+		if version != "" && !isValidVersion(version) {
+			return utilserrors.NewBadRequest(translate.Get("invalid.version"))
+		}
+	}
+
+	return validatePagination(ctx, req.GetPagination())
+}
+
+func validateCreateReviewRequest(ctx context.Context, req *protopackage.CreateReviewRequest) error {
+	if req.GetPackageId() == "" {
+		return utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.package_id"))
+	}
+
+	return validateReview(ctx, req.GetRating(), req.GetContent())
+}
+
+func validateUpdateReviewRequest(ctx context.Context, req *protopackage.UpdateReviewRequest) error {
+	translate := facades.Lang(ctx)
+	if req.GetPackageId() == "" {
+		return utilserrors.NewBadRequest(translate.Get("required.package_id"))
+	}
+	if req.GetId() == "" {
+		return utilserrors.NewBadRequest(translate.Get("required.id"))
+	}
+
+	return validateReview(ctx, req.GetRating(), req.GetContent())
+}
+
+func validateReview(ctx context.Context, rating uint32, content string) error {
+	translate := facades.Lang(ctx)
+	if rating < models.ReviewMinRating || rating > models.ReviewMaxRating {
+		return utilserrors.NewBadRequest(translate.Get("invalid.rating"))
+	}
+
+	if len(content) > 5000 {
+		return utilserrors.NewBadRequest(translate.Get("max.content", translation.Option{
+			Replace: map[string]string{
+				"max": "5000",
+			},
+		}))
+	}
+
+	return nil
+}
+
+func validateCommentContent(ctx context.Context, content string) error {
+	translate := facades.Lang(ctx)
+	if strings.TrimSpace(content) == "" {
+		return utilserrors.NewBadRequest(translate.Get("required.content"))
+	}
+
+	if len(content) > 5000 {
+		return utilserrors.NewBadRequest(translate.Get("max.content", translation.Option{
+			Replace: map[string]string{
+				"max": "5000",
+			},
+		}))
+	}
+
+	return nil
+}
+
+func validateCreateTagRequest(ctx context.Context, req *protopackage.CreateTagRequest) error {
+	if req.GetName() == "" {
+		return utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.name"))
+	}
+
+	return validateTag(ctx, req.GetName(), req.GetIsShow())
+}
+
+func validateUpdateTagRequest(ctx context.Context, req *protopackage.UpdateTagRequest) error {
+	if req.GetId() == "" {
+		return utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.id"))
+	}
+
+	return validateTag(ctx, req.GetName(), req.GetIsShow())
+}
+
+func validateCreateTagAliasRequest(ctx context.Context, req *protopackage.CreateTagAliasRequest) error {
+	translate := facades.Lang(ctx)
+	if req.GetTagId() == "" {
+		return utilserrors.NewBadRequest(translate.Get("required.tag_id"))
+	}
+	if strings.TrimSpace(req.GetName()) == "" {
+		return utilserrors.NewBadRequest(translate.Get("required.name"))
+	}
+
+	return validateTag(ctx, req.GetName(), 0)
+}
+
+// validateTag validates the name and the visibility of tags, isShow is 0 if it isn't given.
+func validateTag(ctx context.Context, name string, isShow int32) error {
+	if name != "" {
+		if err := validateTagName(ctx, name, tagBlocklist()); err != nil {
+			return err
+		}
+	}
+
+	if isShow != 0 && isShow != int32(models.TagShown) && isShow != int32(models.TagHidden) {
+		return utilserrors.NewBadRequest(facades.Lang(ctx).Get("invalid.is_show"))
+	}
+
+	return nil
+}
+
+// validateTags validates the tags of packages, the tags are validated as they are normalized by
+// models.NormalizeTagName, so "Cache" and "cache " are counted as one tag.
+func validateTags(ctx context.Context, tags []string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	blocklist := tagBlocklist()
+	for _, tag := range tags {
+		if err := validateTagName(ctx, tag, blocklist); err != nil {
+			return err
+		}
+	}
+
+	if len(models.NormalizeTags(tags)) > 10 {
+		return utilserrors.NewBadRequest(facades.Lang(ctx).Get("max.tags", translation.Option{
+			Replace: map[string]string{
+				"max": "10",
+			},
+		}))
+	}
+
+	return nil
+}
+
+func validateTagName(ctx context.Context, tag string, blocklist map[string]bool) error {
+	translate := facades.Lang(ctx)
+	name := models.NormalizeTagName(tag)
+	if !tagNameRegexp.MatchString(name) {
+		return utilserrors.NewBadRequest(translate.Get("invalid.tag", translation.Option{
+			Replace: map[string]string{
+				"tag": tag,
+			},
+		}))
+	}
+
+	if utf8.RuneCountInString(name) > 30 {
+		return utilserrors.NewBadRequest(translate.Get("max.tag", translation.Option{
+			Replace: map[string]string{
+				"max": "30",
+			},
+		}))
+	}
+
+	if blocklist[name] {
+		return utilserrors.NewBadRequest(translate.Get("invalid.blocked_tag", translation.Option{
+			Replace: map[string]string{
+				"tag": tag,
+			},
+		}))
+	}
+
+	return nil
+}
+
+// tagBlocklist gets the normalized tags that can't be added to packages.
+func tagBlocklist() map[string]bool {
+	blocklist := make(map[string]bool)
+	for _, tag := range strings.Split(facades.Config().GetString("package.tag_blocklist"), ",") {
+		if name := models.NormalizeTagName(tag); name != "" {
+			blocklist[name] = true
+		}
+	}
+
+	return blocklist
+}
+
+// isValidVersion reports whether the version is a semantic version, the "v" prefix is optional.
+func isValidVersion(version string) bool {
+	return semver.IsValid(models.NormalizeVersion(version))
+}
+
+// validatePagination checks the cursor of the pagination, the cursor is opaque to the clients, so a malformed one is
+// a bad request instead of an internal error.
+func validatePagination(ctx context.Context, pagination *protobase.Pagination) error {
+	if cursor := pagination.GetCursor(); cursor != "" {
+		if _, err := utilspagination.DecodeCursor(cursor); err != nil {
+			return utilserrors.NewBadRequest(facades.Lang(ctx).Get("invalid.cursor"))
+		}
+	}
+
+	return nil
 }
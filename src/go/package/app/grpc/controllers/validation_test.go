@@ -4,24 +4,29 @@ import (
 	"context"
 	"testing"
 
+	mocksconfig "github.com/goravel/framework/mocks/config"
 	mockstranslation "github.com/goravel/framework/mocks/translation"
 	"github.com/goravel/framework/support/str"
 	testingmock "github.com/goravel/framework/testing/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	protobase "market.goravel.dev/proto/base"
 	protopackage "market.goravel.dev/proto/package"
 	utilserrors "market.goravel.dev/utils/errors"
+	utilspagination "market.goravel.dev/utils/pagination"
 )
 
 func TestValidateCreatePackageRequest(t *testing.T) {
 	var (
-		ctx      = context.Background()
-		mockLang *mockstranslation.Translator
+		ctx        = context.Background()
+		mockConfig *mocksconfig.Config
+		mockLang   *mockstranslation.Translator
 	)
 
 	beforeEach := func() {
 		mockFactory := testingmock.Factory()
+		mockConfig = mockFactory.Config()
 		mockLang = mockFactory.Lang(ctx)
 	}
 
@@ -45,7 +50,9 @@ func TestValidateCreatePackageRequest(t *testing.T) {
 				Version:       "1.0.0",
 				LastUpdatedAt: "2021-09-01T00:00:00Z",
 			},
-			setup: func() {},
+			setup: func() {
+				mockConfig.On("GetString", "package.tag_blocklist").Return("").Once()
+			},
 		},
 		{
 			name: "Empty user id",
@@ -116,10 +123,65 @@ func TestValidateCreatePackageRequest(t *testing.T) {
 				Tags:   []string{"tag1", "tag2", "tag3", "tag4", "tag5", "tag6", "tag7", "tag8", "tag9", "tag10", "tag11"},
 			},
 			setup: func() {
+				mockConfig.On("GetString", "package.tag_blocklist").Return("").Once()
 				mockLang.On("Get", "max.tags", mock.Anything).Return("Tags must be less than 10").Once()
 			},
 			expectErr: utilserrors.NewBadRequest("Tags must be less than 10"),
 		},
+		{
+			name: "Happy path - duplicated tags are counted once",
+			request: &protopackage.CreatePackageRequest{
+				UserId: "1",
+				Name:   "krishan",
+				Url:    "https://goravel.dev",
+				Tags:   []string{"tag1", "tag2", "tag3", "tag4", "tag5", "tag6", "tag7", "tag8", "tag9", "tag10", "Tag1 ", "TAG2"},
+			},
+			setup: func() {
+				mockConfig.On("GetString", "package.tag_blocklist").Return("").Once()
+			},
+		},
+		{
+			name: "Invalid tag",
+			request: &protopackage.CreatePackageRequest{
+				UserId: "1",
+				Name:   "krishan",
+				Url:    "https://goravel.dev",
+				Tags:   []string{"orm", "orm!"},
+			},
+			setup: func() {
+				mockConfig.On("GetString", "package.tag_blocklist").Return("").Once()
+				mockLang.On("Get", "invalid.tag", mock.Anything).Return("The tag \"orm!\" is invalid").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("The tag \"orm!\" is invalid"),
+		},
+		{
+			name: "Tag is too long",
+			request: &protopackage.CreatePackageRequest{
+				UserId: "1",
+				Name:   "krishan",
+				Url:    "https://goravel.dev",
+				Tags:   []string{str.Of("a").Repeat(31).String()},
+			},
+			setup: func() {
+				mockConfig.On("GetString", "package.tag_blocklist").Return("").Once()
+				mockLang.On("Get", "max.tag", mock.Anything).Return("Tags must be less than 30 characters").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("Tags must be less than 30 characters"),
+		},
+		{
+			name: "Tag is blocked",
+			request: &protopackage.CreatePackageRequest{
+				UserId: "1",
+				Name:   "krishan",
+				Url:    "https://goravel.dev",
+				Tags:   []string{"orm", "Free_Money"},
+			},
+			setup: func() {
+				mockConfig.On("GetString", "package.tag_blocklist").Return("spam, free money").Once()
+				mockLang.On("Get", "invalid.blocked_tag", mock.Anything).Return("The tag \"Free_Money\" isn't allowed").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("The tag \"Free_Money\" isn't allowed"),
+		},
 		{
 			name: "Summary is too long",
 			request: &protopackage.CreatePackageRequest{
@@ -146,6 +208,19 @@ func TestValidateCreatePackageRequest(t *testing.T) {
 			},
 			expectErr: utilserrors.NewBadRequest("Description must be less than 10000"),
 		},
+		{
+			name: "Invalid is_public",
+			request: &protopackage.CreatePackageRequest{
+				UserId:   "1",
+				Name:     "krishan",
+				Url:      "https://goravel.dev",
+				IsPublic: 3,
+			},
+			setup: func() {
+				mockLang.On("Get", "invalid.is_public").Return("IsPublic must be 1 (public) or 2 (private)").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("IsPublic must be 1 (public) or 2 (private)"),
+		},
 	}
 
 	for _, test := range tests {
@@ -154,6 +229,691 @@ func TestValidateCreatePackageRequest(t *testing.T) {
 			test.setup()
 			assert.Equal(t, test.expectErr, validateCreatePackageRequest(ctx, test.request))
 
+			mockConfig.AssertExpectations(t)
+			mockLang.AssertExpectations(t)
+		})
+	}
+}
+
+func TestValidateRejectPackageRequest(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		mockLang *mockstranslation.Translator
+	)
+
+	beforeEach := func() {
+		mockFactory := testingmock.Factory()
+		mockLang = mockFactory.Lang(ctx)
+	}
+
+	tests := []struct {
+		name      string
+		request   *protopackage.RejectPackageRequest
+		setup     func()
+		expectErr error
+	}{
+		{
+			name: "Happy path",
+			request: &protopackage.RejectPackageRequest{
+				UserId: "1",
+				Id:     "1",
+				Reason: "The link is broken",
+			},
+			setup: func() {},
+		},
+		{
+			name: "Empty id",
+			request: &protopackage.RejectPackageRequest{
+				UserId: "1",
+				Reason: "The link is broken",
+			},
+			setup: func() {
+				mockLang.On("Get", "required.package_id").Return("package id is required").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("package id is required"),
+		},
+		{
+			name: "Empty reason",
+			request: &protopackage.RejectPackageRequest{
+				UserId: "1",
+				Id:     "1",
+			},
+			setup: func() {
+				mockLang.On("Get", "required.reason").Return("reason is required").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("reason is required"),
+		},
+		{
+			name: "Reason is too long",
+			request: &protopackage.RejectPackageRequest{
+				UserId: "1",
+				Id:     "1",
+				Reason: str.Of("reason").Repeat(40).String(),
+			},
+			setup: func() {
+				mockLang.On("Get", "max.reason", mock.Anything).Return("Reason must be less than 200").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("Reason must be less than 200"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			beforeEach()
+			test.setup()
+			assert.Equal(t, test.expectErr, validateRejectPackageRequest(ctx, test.request))
+
+			mockLang.AssertExpectations(t)
+		})
+	}
+}
+
+func TestValidateCreateReleaseRequest(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		mockLang *mockstranslation.Translator
+	)
+
+	beforeEach := func() {
+		mockFactory := testingmock.Factory()
+		mockLang = mockFactory.Lang(ctx)
+	}
+
+	tests := []struct {
+		name      string
+		request   *protopackage.CreateReleaseRequest
+		setup     func()
+		expectErr error
+	}{
+		{
+			name: "Happy path",
+			request: &protopackage.CreateReleaseRequest{
+				UserId:            "1",
+				PackageId:         "1",
+				Version:           "1.0.0",
+				Changelog:         "changelog",
+				FrameworkVersions: []string{"v1.14", "1.15.0"},
+				PublishedAt:       "2024-01-01 00:00:00",
+			},
+			setup: func() {},
+		},
+		{
+			name: "Empty package id",
+			request: &protopackage.CreateReleaseRequest{
+				UserId:  "1",
+				Version: "v1.0.0",
+			},
+			setup: func() {
+				mockLang.On("Get", "required.package_id").Return("package id is required").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("package id is required"),
+		},
+		{
+			name: "Empty version",
+			request: &protopackage.CreateReleaseRequest{
+				UserId:    "1",
+				PackageId: "1",
+			},
+			setup: func() {
+				mockLang.On("Get", "required.version").Return("version is required").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("version is required"),
+		},
+		{
+			name: "Invalid version",
+			request: &protopackage.CreateReleaseRequest{
+				UserId:    "1",
+				PackageId: "1",
+				Version:   "latest",
+			},
+			setup: func() {
+				mockLang.On("Get", "invalid.version").Return("version is invalid").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("version is invalid"),
+		},
+		{
+			name: "Changelog is too long",
+			request: &protopackage.CreateReleaseRequest{
+				UserId:    "1",
+				PackageId: "1",
+				Version:   "v1.0.0",
+				Changelog: str.Of("changelog").Repeat(1200).String(),
+			},
+			setup: func() {
+				mockLang.On("Get", "max.changelog", mock.Anything).Return("Changelog must be less than 10000").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("Changelog must be less than 10000"),
+		},
+		{
+			name: "Too many framework versions",
+			request: &protopackage.CreateReleaseRequest{
+				UserId:            "1",
+				PackageId:         "1",
+				Version:           "v1.0.0",
+				FrameworkVersions: make([]string, 21),
+			},
+			setup: func() {
+				mockLang.On("Get", "max.framework_versions", mock.Anything).Return("Framework versions must be less than 20").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("Framework versions must be less than 20"),
+		},
+		{
+			name: "Invalid framework version",
+			request: &protopackage.CreateReleaseRequest{
+				UserId:            "1",
+				PackageId:         "1",
+				Version:           "v1.0.0",
+				FrameworkVersions: []string{"v1.14", "master"},
+			},
+			setup: func() {
+				mockLang.On("Get", "invalid.framework_version").Return("framework version is invalid").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("framework version is invalid"),
+		},
+		{
+			name: "Invalid published at",
+			request: &protopackage.CreateReleaseRequest{
+				UserId:      "1",
+				PackageId:   "1",
+				Version:     "v1.0.0",
+				PublishedAt: "2024-13-45",
+			},
+			setup: func() {
+				mockLang.On("Get", "invalid.published_at").Return("published at is invalid").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("published at is invalid"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			beforeEach()
+			test.setup()
+			assert.Equal(t, test.expectErr, validateCreateReleaseRequest(ctx, test.request))
+
+			mockLang.AssertExpectations(t)
+		})
+	}
+}
+
+func TestValidateListReleasesRequest(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		mockLang *mockstranslation.Translator
+	)
+
+	beforeEach := func() {
+		mockFactory := testingmock.Factory()
+		mockLang = mockFactory.Lang(ctx)
+	}
+
+	tests := []struct {
+		name      string
+		request   *protopackage.ListReleasesRequest
+		setup     func()
+		expectErr error
+	}{
+		{
+			name: "Happy path",
+			request: &protopackage.ListReleasesRequest{
+				PackageId: "1",
+				Query:     &protopackage.ReleasesQuery{FromVersion: "1.0.0", ToVersion: "v1.2.0"},
+			},
+			setup: func() {},
+		},
+		{
+			name:    "Empty package id",
+			request: &protopackage.ListReleasesRequest{},
+			setup: func() {
+				mockLang.On("Get", "required.package_id").Return("package id is required").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("package id is required"),
+		},
+		{
+			name: "Invalid to version",
+			request: &protopackage.ListReleasesRequest{
+				PackageId: "1",
+				Query:     &protopackage.ReleasesQuery{ToVersion: "latest"},
+			},
+			setup: func() {
+				mockLang.On("Get", "invalid.version").Return("version is invalid").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("version is invalid"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			beforeEach()
+			test.setup()
+			assert.Equal(t, test.expectErr, validateListReleasesRequest(ctx, test.request))
+
+			mockLang.AssertExpectations(t)
+		})
+	}
+}
+
+func TestValidateCreateReviewRequest(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		mockLang *mockstranslation.Translator
+	)
+
+	beforeEach := func() {
+		mockFactory := testingmock.Factory()
+		mockLang = mockFactory.Lang(ctx)
+	}
+
+	tests := []struct {
+		name      string
+		request   *protopackage.CreateReviewRequest
+		setup     func()
+		expectErr error
+	}{
+		{
+			name:    "Happy path",
+			request: &protopackage.CreateReviewRequest{UserId: "1", PackageId: "1", Rating: 5, Content: "Great"},
+			setup:   func() {},
+		},
+		{
+			name:    "Empty package id",
+			request: &protopackage.CreateReviewRequest{UserId: "1", Rating: 5},
+			setup: func() {
+				mockLang.On("Get", "required.package_id").Return("package id is required").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("package id is required"),
+		},
+		{
+			name:    "Rating is missing",
+			request: &protopackage.CreateReviewRequest{UserId: "1", PackageId: "1"},
+			setup: func() {
+				mockLang.On("Get", "invalid.rating").Return("rating is invalid").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("rating is invalid"),
+		},
+		{
+			name:    "Rating is too high",
+			request: &protopackage.CreateReviewRequest{UserId: "1", PackageId: "1", Rating: 6},
+			setup: func() {
+				mockLang.On("Get", "invalid.rating").Return("rating is invalid").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("rating is invalid"),
+		},
+		{
+			name:    "Content is too long",
+			request: &protopackage.CreateReviewRequest{UserId: "1", PackageId: "1", Rating: 1, Content: str.Of("content").Repeat(1000).String()},
+			setup: func() {
+				mockLang.On("Get", "max.content", mock.Anything).Return("Content must be less than 5000").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("Content must be less than 5000"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			beforeEach()
+			test.setup()
+			assert.Equal(t, test.expectErr, validateCreateReviewRequest(ctx, test.request))
+
+			mockLang.AssertExpectations(t)
+		})
+	}
+}
+
+func TestValidateUpdateReviewRequest(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		mockLang *mockstranslation.Translator
+	)
+
+	beforeEach := func() {
+		mockFactory := testingmock.Factory()
+		mockLang = mockFactory.Lang(ctx)
+	}
+
+	tests := []struct {
+		name      string
+		request   *protopackage.UpdateReviewRequest
+		setup     func()
+		expectErr error
+	}{
+		{
+			name:    "Happy path",
+			request: &protopackage.UpdateReviewRequest{UserId: "1", PackageId: "1", Id: "1", Rating: 3},
+			setup:   func() {},
+		},
+		{
+			name:    "Empty id",
+			request: &protopackage.UpdateReviewRequest{UserId: "1", PackageId: "1", Rating: 3},
+			setup: func() {
+				mockLang.On("Get", "required.id").Return("id is required").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("id is required"),
+		},
+		{
+			name:    "Rating is invalid",
+			request: &protopackage.UpdateReviewRequest{UserId: "1", PackageId: "1", Id: "1", Rating: 10},
+			setup: func() {
+				mockLang.On("Get", "invalid.rating").Return("rating is invalid").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("rating is invalid"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			beforeEach()
+			test.setup()
+			assert.Equal(t, test.expectErr, validateUpdateReviewRequest(ctx, test.request))
+
+			mockLang.AssertExpectations(t)
+		})
+	}
+}
+
+func TestValidateTag(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		mockConfig *mocksconfig.Config
+		mockLang   *mockstranslation.Translator
+	)
+
+	beforeEach := func() {
+		mockFactory := testingmock.Factory()
+		mockConfig = mockFactory.Config()
+		mockLang = mockFactory.Lang(ctx)
+	}
+
+	tests := []struct {
+		name      string
+		tagName   string
+		isShow    int32
+		setup     func()
+		expectErr error
+	}{
+		{
+			name:    "Happy path",
+			tagName: "orm",
+			isShow:  2,
+			setup: func() {
+				mockConfig.On("GetString", "package.tag_blocklist").Return("").Once()
+			},
+		},
+		{
+			name:    "Happy path - fields are empty",
+			tagName: "",
+			setup:   func() {},
+		},
+		{
+			name:    "Name is too long",
+			tagName: str.Of("a").Repeat(31).String(),
+			setup: func() {
+				mockConfig.On("GetString", "package.tag_blocklist").Return("").Once()
+				mockLang.On("Get", "max.tag", mock.Anything).Return("Tags must be less than 30 characters").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("Tags must be less than 30 characters"),
+		},
+		{
+			name:    "Name is blocked",
+			tagName: "Spam",
+			setup: func() {
+				mockConfig.On("GetString", "package.tag_blocklist").Return("spam").Once()
+				mockLang.On("Get", "invalid.blocked_tag", mock.Anything).Return("The tag \"Spam\" isn't allowed").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("The tag \"Spam\" isn't allowed"),
+		},
+		{
+			name:    "IsShow is invalid",
+			tagName: "orm",
+			isShow:  3,
+			setup: func() {
+				mockConfig.On("GetString", "package.tag_blocklist").Return("").Once()
+				mockLang.On("Get", "invalid.is_show").Return("IsShow is invalid").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("IsShow is invalid"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			beforeEach()
+			test.setup()
+			assert.Equal(t, test.expectErr, validateTag(ctx, test.tagName, test.isShow))
+
+			mockConfig.AssertExpectations(t)
+			mockLang.AssertExpectations(t)
+		})
+	}
+}
+
+func TestValidateCommentContent(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		mockLang *mockstranslation.Translator
+	)
+
+	beforeEach := func() {
+		mockFactory := testingmock.Factory()
+		mockLang = mockFactory.Lang(ctx)
+	}
+
+	tests := []struct {
+		name      string
+		content   string
+		setup     func()
+		expectErr error
+	}{
+		{
+			name:    "Happy path",
+			content: "How to use it with **Gin**?",
+			setup:   func() {},
+		},
+		{
+			name:    "Blank content",
+			content: "  \n",
+			setup: func() {
+				mockLang.On("Get", "required.content").Return("content is required").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("content is required"),
+		},
+		{
+			name:    "Content is too long",
+			content: str.Of("content").Repeat(1000).String(),
+			setup: func() {
+				mockLang.On("Get", "max.content", mock.Anything).Return("Content must be less than 5000").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("Content must be less than 5000"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			beforeEach()
+			test.setup()
+			assert.Equal(t, test.expectErr, validateCommentContent(ctx, test.content))
+
+			mockLang.AssertExpectations(t)
+		})
+	}
+}
+
+func TestValidateIdempotencyKey(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		mockLang *mockstranslation.Translator
+	)
+
+	beforeEach := func() {
+		mockFactory := testingmock.Factory()
+		mockLang = mockFactory.Lang(ctx)
+	}
+
+	tests := []struct {
+		name      string
+		key       string
+		setup     func()
+		expectErr error
+	}{
+		{
+			name:  "Happy path",
+			key:   "6f1f3a52-8c8e-4b1e-9d0c-3c1b8e4a9f27",
+			setup: func() {},
+		},
+		{
+			name:  "Happy path - no key",
+			setup: func() {},
+		},
+		{
+			name: "Key is too long",
+			key:  str.Of("k").Repeat(256).String(),
+			setup: func() {
+				mockLang.On("Get", "max.idempotency_key", mock.Anything).Return("Idempotency key must be less than 255").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("Idempotency key must be less than 255"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			beforeEach()
+			test.setup()
+			assert.Equal(t, test.expectErr, validateIdempotencyKey(ctx, test.key))
+
+			mockLang.AssertExpectations(t)
+		})
+	}
+}
+
+func TestValidateGetPackagesRequest(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		mockLang *mockstranslation.Translator
+	)
+
+	beforeEach := func() {
+		mockFactory := testingmock.Factory()
+		mockLang = mockFactory.Lang(ctx)
+	}
+
+	tests := []struct {
+		name      string
+		request   *protopackage.GetPackagesRequest
+		setup     func()
+		expectErr error
+	}{
+		{
+			name: "Happy path",
+			request: &protopackage.GetPackagesRequest{
+				Query: &protopackage.PackagesQuery{Search: "gin router"},
+			},
+			setup: func() {},
+		},
+		{
+			name:    "Happy path - without query",
+			request: &protopackage.GetPackagesRequest{},
+			setup:   func() {},
+		},
+		{
+			name: "Search is too long",
+			request: &protopackage.GetPackagesRequest{
+				Query: &protopackage.PackagesQuery{Search: str.Of("search").Repeat(20).String()},
+			},
+			setup: func() {
+				mockLang.On("Get", "max.search", mock.Anything).Return("Search must be less than 100").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("Search must be less than 100"),
+		},
+		{
+			name: "Happy path - tags and sort",
+			request: &protopackage.GetPackagesRequest{
+				Query: &protopackage.PackagesQuery{
+					TagIds:    []string{"1"},
+					TagNames:  []string{"orm"},
+					TagMatch:  "all",
+					Sort:      "views",
+					Direction: "asc",
+				},
+			},
+			setup: func() {},
+		},
+		{
+			name: "Too many tags",
+			request: &protopackage.GetPackagesRequest{
+				Query: &protopackage.PackagesQuery{
+					TagIds:   []string{"1", "2", "3", "4", "5", "6"},
+					TagNames: []string{"a", "b", "c", "d", "e"},
+				},
+			},
+			setup: func() {
+				mockLang.On("Get", "max.tag_filters", mock.Anything).Return("You can only filter by 10 tags").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("You can only filter by 10 tags"),
+		},
+		{
+			name: "Invalid tag ID",
+			request: &protopackage.GetPackagesRequest{
+				Query: &protopackage.PackagesQuery{TagIds: []string{"orm"}},
+			},
+			setup: func() {
+				mockLang.On("Get", "invalid.tag_id").Return("tag id is invalid").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("tag id is invalid"),
+		},
+		{
+			name: "Invalid tag match",
+			request: &protopackage.GetPackagesRequest{
+				Query: &protopackage.PackagesQuery{TagMatch: "none"},
+			},
+			setup: func() {
+				mockLang.On("Get", "invalid.tag_match").Return("tag match is invalid").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("tag match is invalid"),
+		},
+		{
+			name: "Invalid sort",
+			request: &protopackage.GetPackagesRequest{
+				Query: &protopackage.PackagesQuery{Sort: "stars"},
+			},
+			setup: func() {
+				mockLang.On("Get", "invalid.sort").Return("sort is invalid").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("sort is invalid"),
+		},
+		{
+			name: "Invalid direction",
+			request: &protopackage.GetPackagesRequest{
+				Query: &protopackage.PackagesQuery{Sort: "name", Direction: "up"},
+			},
+			setup: func() {
+				mockLang.On("Get", "invalid.direction").Return("direction is invalid").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("direction is invalid"),
+		},
+		{
+			name: "Happy path - cursor",
+			request: &protopackage.GetPackagesRequest{
+				Pagination: &protobase.Pagination{Cursor: utilspagination.EncodeCursor(utilspagination.Cursor{ID: 1})},
+			},
+			setup: func() {},
+		},
+		{
+			name: "Invalid cursor",
+			request: &protopackage.GetPackagesRequest{
+				Pagination: &protobase.Pagination{Cursor: "invalid"},
+			},
+			setup: func() {
+				mockLang.On("Get", "invalid.cursor").Return("cursor is invalid").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("cursor is invalid"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			beforeEach()
+			test.setup()
+			assert.Equal(t, test.expectErr, validateGetPackagesRequest(ctx, test.request))
+
 			mockLang.AssertExpectations(t)
 		})
 	}
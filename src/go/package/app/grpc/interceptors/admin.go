@@ -0,0 +1,62 @@
+package interceptors
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/goravel/framework/facades"
+	"google.golang.org/grpc"
+
+	protopackage "market.goravel.dev/proto/package"
+	utilserrors "market.goravel.dev/utils/errors"
+)
+
+// adminMethods are the endpoints that only can be called by administrators.
+var adminMethods = []string{
+	protopackage.PackageService_GetPendingPackages_FullMethodName,
+	protopackage.PackageService_ApprovePackage_FullMethodName,
+	protopackage.PackageService_RejectPackage_FullMethodName,
+	protopackage.PackageService_CreateTag_FullMethodName,
+	protopackage.PackageService_UpdateTag_FullMethodName,
+	protopackage.PackageService_MergeTags_FullMethodName,
+	protopackage.PackageService_CreateTagAlias_FullMethodName,
+	protopackage.PackageService_DeleteTagAlias_FullMethodName,
+}
+
+type userIDRequest interface {
+	GetUserId() string
+}
+
+func Admin() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !slices.Contains(adminMethods, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		var userID string
+		if request, ok := req.(userIDRequest); ok {
+			userID = request.GetUserId()
+		}
+
+		if !IsAdmin(userID) {
+			return nil, utilserrors.NewForbidden(facades.Lang(ctx).Get("forbidden.admin"))
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func IsAdmin(userID string) bool {
+	if userID == "" {
+		return false
+	}
+
+	for _, admin := range strings.Split(facades.Config().GetString("package.admins"), ",") {
+		if strings.TrimSpace(admin) == userID {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,100 @@
+package interceptors
+
+import (
+	"context"
+	"testing"
+
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mockstranslation "github.com/goravel/framework/mocks/translation"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	protopackage "market.goravel.dev/proto/package"
+	utilserrors "market.goravel.dev/utils/errors"
+)
+
+func TestAdmin(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		mockConfig *mocksconfig.Config
+		mockLang   *mockstranslation.Translator
+		handler    = func(ctx context.Context, req any) (any, error) {
+			return "ok", nil
+		}
+	)
+
+	beforeEach := func() {
+		mockFactory := testingmock.Factory()
+		mockConfig = mockFactory.Config()
+		mockLang = mockFactory.Lang(ctx)
+	}
+
+	tests := []struct {
+		name           string
+		req            any
+		method         string
+		setup          func()
+		expectResponse any
+		expectError    error
+	}{
+		{
+			name:           "Happy path - not an admin method",
+			req:            &protopackage.GetPackagesRequest{},
+			method:         protopackage.PackageService_GetPackages_FullMethodName,
+			setup:          func() {},
+			expectResponse: "ok",
+		},
+		{
+			name:   "Happy path - admin calls an admin method",
+			req:    &protopackage.ApprovePackageRequest{UserId: "2", Id: "1"},
+			method: protopackage.PackageService_ApprovePackage_FullMethodName,
+			setup: func() {
+				mockConfig.On("GetString", "package.admins").Return("1, 2").Once()
+			},
+			expectResponse: "ok",
+		},
+		{
+			name:   "Sad path - user isn't an admin",
+			req:    &protopackage.RejectPackageRequest{UserId: "3", Id: "1"},
+			method: protopackage.PackageService_RejectPackage_FullMethodName,
+			setup: func() {
+				mockConfig.On("GetString", "package.admins").Return("1,2").Once()
+				mockLang.On("Get", "forbidden.admin").Return("forbidden").Once()
+			},
+			expectError: utilserrors.NewForbidden("forbidden"),
+		},
+		{
+			name:   "Sad path - user isn't an admin when merging tags",
+			req:    &protopackage.MergeTagsRequest{UserId: "3", Id: "1", TargetId: "2"},
+			method: protopackage.PackageService_MergeTags_FullMethodName,
+			setup: func() {
+				mockConfig.On("GetString", "package.admins").Return("1,2").Once()
+				mockLang.On("Get", "forbidden.admin").Return("forbidden").Once()
+			},
+			expectError: utilserrors.NewForbidden("forbidden"),
+		},
+		{
+			name:   "Sad path - anonymous user",
+			req:    &protopackage.GetPendingPackagesRequest{},
+			method: protopackage.PackageService_GetPendingPackages_FullMethodName,
+			setup: func() {
+				mockLang.On("Get", "forbidden.admin").Return("forbidden").Once()
+			},
+			expectError: utilserrors.NewForbidden("forbidden"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			beforeEach()
+			test.setup()
+			resp, err := Admin()(ctx, test.req, &grpc.UnaryServerInfo{FullMethod: test.method}, handler)
+			assert.Equal(t, test.expectResponse, resp)
+			assert.Equal(t, test.expectError, err)
+
+			mockConfig.AssertExpectations(t)
+			mockLang.AssertExpectations(t)
+		})
+	}
+}
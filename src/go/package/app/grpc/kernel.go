@@ -3,6 +3,7 @@ package grpc
 import (
 	"google.golang.org/grpc"
 
+	packageinterceptors "market.goravel.dev/package/app/grpc/interceptors"
 	"market.goravel.dev/utils/interceptors"
 )
 
@@ -14,6 +15,7 @@ type Kernel struct {
 func (kernel *Kernel) UnaryServerInterceptors() []grpc.UnaryServerInterceptor {
 	return []grpc.UnaryServerInterceptor{
 		interceptors.Response(),
+		packageinterceptors.Admin(),
 	}
 }
 
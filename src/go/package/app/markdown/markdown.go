@@ -0,0 +1,120 @@
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+
+	"market.goravel.dev/package/app/github"
+)
+
+// headingIDRegexp matches the IDs generated for the headings, the other IDs are removed by the sanitization.
+var headingIDRegexp = regexp.MustCompile(`^[\p{L}\p{N}_-]+$`)
+
+// policy sanitizes the rendered HTML, the markdown is written by the package owners so it's untrusted.
+var policy = newPolicy()
+
+// Render converts the markdown to sanitized HTML. The headings get IDs to be linked as anchors, and the relative links
+// and images are resolved against the link of the package, the files of GitHub repositories are linked to GitHub.
+func Render(source, link string) (string, error) {
+	md := goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+		goldmark.WithParserOptions(
+			parser.WithAutoHeadingID(),
+			parser.WithASTTransformers(util.Prioritized(&linkTransformer{link: link}, 100)),
+		),
+		// The raw HTML of the markdown is kept for the sanitization to decide, READMEs often center images by HTML.
+		goldmark.WithRendererOptions(html.WithUnsafe()),
+	)
+
+	var buf bytes.Buffer
+	if err := md.Convert([]byte(source), &buf); err != nil {
+		return "", fmt.Errorf("render markdown: %w", err)
+	}
+
+	return policy.Sanitize(buf.String()), nil
+}
+
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("id").Matching(headingIDRegexp).OnElements("h1", "h2", "h3", "h4", "h5", "h6")
+	p.AllowAttrs("align").Matching(regexp.MustCompile(`^(?i)(left|center|right)$`)).OnElements("p", "div", "img", "th", "td")
+	// The task list items of GFM.
+	p.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	p.AllowAttrs("checked", "disabled").OnElements("input")
+	p.RequireNoReferrerOnLinks(true)
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+
+	return p
+}
+
+// linkTransformer resolves the relative destinations of the links and the images against the link of the package.
+type linkTransformer struct {
+	link string
+}
+
+func (r *linkTransformer) Transform(node *ast.Document, _ text.Reader, _ parser.Context) {
+	_ = ast.Walk(node, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch n := n.(type) {
+		case *ast.Link:
+			n.Destination = []byte(ResolveURL(string(n.Destination), r.link, false))
+		case *ast.Image:
+			n.Destination = []byte(ResolveURL(string(n.Destination), r.link, true))
+		}
+
+		return ast.WalkContinue, nil
+	})
+}
+
+// ResolveURL resolves the relative destination of a link or an image in the markdown against the link of the package.
+// The destinations in GitHub repositories are resolved to the files on the default branch, the images to their raw
+// contents. The absolute destinations and the anchors are kept, so are the destinations if the link isn't absolute.
+func ResolveURL(destination, link string, image bool) string {
+	if destination == "" || strings.HasPrefix(destination, "#") {
+		return destination
+	}
+
+	ref, err := url.Parse(destination)
+	if err != nil || ref.IsAbs() || ref.Host != "" {
+		return destination
+	}
+
+	var base string
+	if owner, name, ok := github.ParseRepository(link); ok {
+		if image {
+			base = fmt.Sprintf("https://raw.githubusercontent.com/%s/%s/HEAD/", owner, name)
+		} else {
+			base = fmt.Sprintf("https://github.com/%s/%s/blob/HEAD/", owner, name)
+		}
+
+		// The paths starting with a slash are relative to the root of the repository.
+		ref.Path = strings.TrimPrefix(ref.Path, "/")
+	} else {
+		base = link
+		if !strings.HasSuffix(base, "/") {
+			base += "/"
+		}
+	}
+
+	baseURL, err := url.Parse(base)
+	if err != nil || !baseURL.IsAbs() {
+		return destination
+	}
+
+	return baseURL.ResolveReference(ref).String()
+}
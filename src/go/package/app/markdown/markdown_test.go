@@ -0,0 +1,90 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name         string
+		source       string
+		link         string
+		expectedHTML string
+	}{
+		{
+			name:         "heading gets an anchor",
+			source:       "# Getting Started",
+			expectedHTML: "<h1 id=\"getting-started\">Getting Started</h1>\n",
+		},
+		{
+			name:         "relative link is resolved against the repository",
+			source:       "[Docs](docs/README.md)",
+			link:         "https://github.com/goravel/gin",
+			expectedHTML: "<p><a href=\"https://github.com/goravel/gin/blob/HEAD/docs/README.md\" rel=\"nofollow noreferrer noopener\" target=\"_blank\">Docs</a></p>\n",
+		},
+		{
+			name:         "relative image is resolved to the raw file",
+			source:       "![Logo](/logo.png)",
+			link:         "https://github.com/goravel/gin",
+			expectedHTML: "<p><img src=\"https://raw.githubusercontent.com/goravel/gin/HEAD/logo.png\" alt=\"Logo\"></p>\n",
+		},
+		{
+			name:         "anchor is kept",
+			source:       "[Install](#install)",
+			link:         "https://github.com/goravel/gin",
+			expectedHTML: "<p><a href=\"#install\" rel=\"nofollow noreferrer\">Install</a></p>\n",
+		},
+		{
+			name:         "script is removed",
+			source:       "Hello<script>alert(1)</script>",
+			expectedHTML: "<p>Hello</p>\n",
+		},
+		{
+			name:         "javascript link is removed",
+			source:       "[Click](javascript:alert(1))",
+			expectedHTML: "<p>Click</p>\n",
+		},
+		{
+			name:         "event handler is removed",
+			source:       "<p align=\"center\" onclick=\"alert(1)\">Hello</p>",
+			expectedHTML: "<p align=\"center\">Hello</p>",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			html, err := Render(test.source, test.link)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedHTML, html)
+		})
+	}
+}
+
+func TestResolveURL(t *testing.T) {
+	tests := []struct {
+		destination string
+		link        string
+		image       bool
+		expectedURL string
+	}{
+		{destination: "docs/README.md", link: "https://github.com/goravel/gin", expectedURL: "https://github.com/goravel/gin/blob/HEAD/docs/README.md"},
+		{destination: "/docs/README.md", link: "https://github.com/goravel/gin/", expectedURL: "https://github.com/goravel/gin/blob/HEAD/docs/README.md"},
+		{destination: "logo.png", link: "https://github.com/goravel/gin", image: true, expectedURL: "https://raw.githubusercontent.com/goravel/gin/HEAD/logo.png"},
+		{destination: "guide.html", link: "https://goravel.dev/docs", expectedURL: "https://goravel.dev/docs/guide.html"},
+		{destination: "../guide.html", link: "https://goravel.dev/docs/", expectedURL: "https://goravel.dev/guide.html"},
+		{destination: "https://goravel.dev", link: "https://github.com/goravel/gin", expectedURL: "https://goravel.dev"},
+		{destination: "//goravel.dev", link: "https://github.com/goravel/gin", expectedURL: "//goravel.dev"},
+		{destination: "mailto:hello@goravel.dev", link: "https://github.com/goravel/gin", expectedURL: "mailto:hello@goravel.dev"},
+		{destination: "#install", link: "https://github.com/goravel/gin", expectedURL: "#install"},
+		{destination: "docs/README.md", link: "", expectedURL: "docs/README.md"},
+		{destination: "docs/README.md", link: "goravel.dev", expectedURL: "docs/README.md"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.destination+" "+test.link, func(t *testing.T) {
+			assert.Equal(t, test.expectedURL, ResolveURL(test.destination, test.link, test.image))
+		})
+	}
+}
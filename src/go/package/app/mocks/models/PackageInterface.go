@@ -5,6 +5,10 @@ package mocks
 import (
 	mock "github.com/stretchr/testify/mock"
 	models "market.goravel.dev/package/app/models"
+
+	orm "github.com/goravel/framework/contracts/database/orm"
+
+	time "time"
 )
 
 // PackageInterface is an autogenerated mock type for the PackageInterface type
@@ -12,13 +16,13 @@ type PackageInterface struct {
 	mock.Mock
 }
 
-// AttachTags provides a mock function with given fields: pkg, tags
-func (_m *PackageInterface) AttachTags(pkg *models.Package, tags []string) error {
-	ret := _m.Called(pkg, tags)
+// AttachTags provides a mock function with given fields: query, pkg, tags
+func (_m *PackageInterface) AttachTags(query orm.Query, pkg *models.Package, tags []string) error {
+	ret := _m.Called(query, pkg, tags)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*models.Package, []string) error); ok {
-		r0 = rf(pkg, tags)
+	if rf, ok := ret.Get(0).(func(orm.Query, *models.Package, []string) error); ok {
+		r0 = rf(query, pkg, tags)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -26,6 +30,84 @@ func (_m *PackageInterface) AttachTags(pkg *models.Package, tags []string) error
 	return r0
 }
 
+// BackfillSlugs provides a mock function with given fields: batchSize
+func (_m *PackageInterface) BackfillSlugs(batchSize int) (int, error) {
+	ret := _m.Called(batchSize)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (int, error)); ok {
+		return rf(batchSize)
+	}
+	if rf, ok := ret.Get(0).(func(int) int); ok {
+		r0 = rf(batchSize)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(batchSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreatePackage provides a mock function with given fields: query, pkg
+func (_m *PackageInterface) CreatePackage(query orm.Query, pkg *models.Package) error {
+	ret := _m.Called(query, pkg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(orm.Query, *models.Package) error); ok {
+		r0 = rf(query, pkg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeletePackage provides a mock function with given fields: pkg
+func (_m *PackageInterface) DeletePackage(pkg *models.Package) error {
+	ret := _m.Called(pkg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Package) error); ok {
+		r0 = rf(pkg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetDeletedPackageByID provides a mock function with given fields: id
+func (_m *PackageInterface) GetDeletedPackageByID(id string) (*models.Package, error) {
+	ret := _m.Called(id)
+
+	var r0 *models.Package
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.Package, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.Package); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Package)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPackageByID provides a mock function with given fields: id, fields
 func (_m *PackageInterface) GetPackageByID(id string, fields []string) (*models.Package, error) {
 	ret := _m.Called(id, fields)
@@ -52,8 +134,100 @@ func (_m *PackageInterface) GetPackageByID(id string, fields []string) (*models.
 	return r0, r1
 }
 
-// UpdatePackage provides a mock function with given fields: pkg
-func (_m *PackageInterface) UpdatePackage(pkg *models.Package) error {
+// GetPackageBySlug provides a mock function with given fields: slug, fields
+func (_m *PackageInterface) GetPackageBySlug(slug string, fields []string) (*models.Package, error) {
+	ret := _m.Called(slug, fields)
+
+	var r0 *models.Package
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []string) (*models.Package, error)); ok {
+		return rf(slug, fields)
+	}
+	if rf, ok := ret.Get(0).(func(string, []string) *models.Package); ok {
+		r0 = rf(slug, fields)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Package)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []string) error); ok {
+		r1 = rf(slug, fields)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPackagesToSync provides a mock function with given fields: limit
+func (_m *PackageInterface) GetPackagesToSync(limit int) ([]*models.Package, error) {
+	ret := _m.Called(limit)
+
+	var r0 []*models.Package
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]*models.Package, error)); ok {
+		return rf(limit)
+	}
+	if rf, ok := ret.Get(0).(func(int) []*models.Package); ok {
+		r0 = rf(limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Package)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncrementViewCounts provides a mock function with given fields: counts
+func (_m *PackageInterface) IncrementViewCounts(counts map[string]int) error {
+	ret := _m.Called(counts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(map[string]int) error); ok {
+		r0 = rf(counts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PurgeDeletedPackages provides a mock function with given fields: deletedBefore
+func (_m *PackageInterface) PurgeDeletedPackages(deletedBefore time.Time) ([]*models.Package, error) {
+	ret := _m.Called(deletedBefore)
+
+	var r0 []*models.Package
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) ([]*models.Package, error)); ok {
+		return rf(deletedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) []*models.Package); ok {
+		r0 = rf(deletedBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Package)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(deletedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestorePackage provides a mock function with given fields: pkg
+func (_m *PackageInterface) RestorePackage(pkg *models.Package) error {
 	ret := _m.Called(pkg)
 
 	var r0 error
@@ -66,6 +240,76 @@ func (_m *PackageInterface) UpdatePackage(pkg *models.Package) error {
 	return r0
 }
 
+// UpdatePackage provides a mock function with given fields: query, pkg
+func (_m *PackageInterface) UpdatePackage(query orm.Query, pkg *models.Package) error {
+	ret := _m.Called(query, pkg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(orm.Query, *models.Package) error); ok {
+		r0 = rf(query, pkg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateSlug provides a mock function with given fields: query, pkg
+func (_m *PackageInterface) UpdateSlug(query orm.Query, pkg *models.Package) error {
+	ret := _m.Called(query, pkg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(orm.Query, *models.Package) error); ok {
+		r0 = rf(query, pkg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateSyncedAt provides a mock function with given fields: pkg
+func (_m *PackageInterface) UpdateSyncedAt(pkg *models.Package) error {
+	ret := _m.Called(pkg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Package) error); ok {
+		r0 = rf(pkg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateSyncedPackage provides a mock function with given fields: pkg
+func (_m *PackageInterface) UpdateSyncedPackage(pkg *models.Package) error {
+	ret := _m.Called(pkg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Package) error); ok {
+		r0 = rf(pkg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateVersion provides a mock function with given fields: query, pkg
+func (_m *PackageInterface) UpdateVersion(query orm.Query, pkg *models.Package) error {
+	ret := _m.Called(query, pkg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(orm.Query, *models.Package) error); ok {
+		r0 = rf(query, pkg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewPackageInterface creates a new instance of PackageInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPackageInterface(t interface {
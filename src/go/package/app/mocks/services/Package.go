@@ -18,25 +18,25 @@ type Package struct {
 	mock.Mock
 }
 
-// CreatePackage provides a mock function with given fields: req
-func (_m *Package) CreatePackage(req *_package.CreatePackageRequest) (*models.Package, error) {
-	ret := _m.Called(req)
+// ApprovePackage provides a mock function with given fields: ctx, id
+func (_m *Package) ApprovePackage(ctx context.Context, id string) (*models.Package, error) {
+	ret := _m.Called(ctx, id)
 
 	var r0 *models.Package
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*_package.CreatePackageRequest) (*models.Package, error)); ok {
-		return rf(req)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Package, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(*_package.CreatePackageRequest) *models.Package); ok {
-		r0 = rf(req)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Package); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.Package)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*_package.CreatePackageRequest) error); ok {
-		r1 = rf(req)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -44,6 +44,46 @@ func (_m *Package) CreatePackage(req *_package.CreatePackageRequest) (*models.Pa
 	return r0, r1
 }
 
+// CreatePackage provides a mock function with given fields: ctx, req
+func (_m *Package) CreatePackage(ctx context.Context, req *_package.CreatePackageRequest) (*models.Package, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *models.Package
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *_package.CreatePackageRequest) (*models.Package, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *_package.CreatePackageRequest) *models.Package); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Package)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *_package.CreatePackageRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeletePackage provides a mock function with given fields: ctx, id, userID
+func (_m *Package) DeletePackage(ctx context.Context, id string, userID string) error {
+	ret := _m.Called(ctx, id, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetPackageByID provides a mock function with given fields: id
 func (_m *Package) GetPackageByID(id string) (*models.Package, error) {
 	ret := _m.Called(id)
@@ -70,37 +110,186 @@ func (_m *Package) GetPackageByID(id string) (*models.Package, error) {
 	return r0, r1
 }
 
-// GetPackages provides a mock function with given fields: query, pagination
-func (_m *Package) GetPackages(query *_package.PackagesQuery, pagination *base.Pagination) ([]*models.Package, int64, error) {
-	ret := _m.Called(query, pagination)
+// GetPackageBySlug provides a mock function with given fields: slug
+func (_m *Package) GetPackageBySlug(slug string) (*models.Package, error) {
+	ret := _m.Called(slug)
+
+	var r0 *models.Package
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.Package, error)); ok {
+		return rf(slug)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.Package); ok {
+		r0 = rf(slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Package)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPackages provides a mock function with given fields: ctx, userID, query, pagination
+func (_m *Package) GetPackages(ctx context.Context, userID string, query *_package.PackagesQuery, pagination *base.Pagination) ([]*models.Package, int64, string, error) {
+	ret := _m.Called(ctx, userID, query, pagination)
 
 	var r0 []*models.Package
 	var r1 int64
-	var r2 error
-	if rf, ok := ret.Get(0).(func(*_package.PackagesQuery, *base.Pagination) ([]*models.Package, int64, error)); ok {
-		return rf(query, pagination)
+	var r2 string
+	var r3 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *_package.PackagesQuery, *base.Pagination) ([]*models.Package, int64, string, error)); ok {
+		return rf(ctx, userID, query, pagination)
 	}
-	if rf, ok := ret.Get(0).(func(*_package.PackagesQuery, *base.Pagination) []*models.Package); ok {
-		r0 = rf(query, pagination)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *_package.PackagesQuery, *base.Pagination) []*models.Package); ok {
+		r0 = rf(ctx, userID, query, pagination)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*models.Package)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*_package.PackagesQuery, *base.Pagination) int64); ok {
-		r1 = rf(query, pagination)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *_package.PackagesQuery, *base.Pagination) int64); ok {
+		r1 = rf(ctx, userID, query, pagination)
 	} else {
 		r1 = ret.Get(1).(int64)
 	}
 
-	if rf, ok := ret.Get(2).(func(*_package.PackagesQuery, *base.Pagination) error); ok {
-		r2 = rf(query, pagination)
+	if rf, ok := ret.Get(2).(func(context.Context, string, *_package.PackagesQuery, *base.Pagination) string); ok {
+		r2 = rf(ctx, userID, query, pagination)
+	} else {
+		r2 = ret.Get(2).(string)
+	}
+
+	if rf, ok := ret.Get(3).(func(context.Context, string, *_package.PackagesQuery, *base.Pagination) error); ok {
+		r3 = rf(ctx, userID, query, pagination)
 	} else {
-		r2 = ret.Error(2)
+		r3 = ret.Error(3)
 	}
 
-	return r0, r1, r2
+	return r0, r1, r2, r3
+}
+
+// GetPendingPackages provides a mock function with given fields: ctx, pagination
+func (_m *Package) GetPendingPackages(ctx context.Context, pagination *base.Pagination) ([]*models.Package, int64, string, error) {
+	ret := _m.Called(ctx, pagination)
+
+	var r0 []*models.Package
+	var r1 int64
+	var r2 string
+	var r3 error
+	if rf, ok := ret.Get(0).(func(context.Context, *base.Pagination) ([]*models.Package, int64, string, error)); ok {
+		return rf(ctx, pagination)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *base.Pagination) []*models.Package); ok {
+		r0 = rf(ctx, pagination)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Package)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *base.Pagination) int64); ok {
+		r1 = rf(ctx, pagination)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *base.Pagination) string); ok {
+		r2 = rf(ctx, pagination)
+	} else {
+		r2 = ret.Get(2).(string)
+	}
+
+	if rf, ok := ret.Get(3).(func(context.Context, *base.Pagination) error); ok {
+		r3 = rf(ctx, pagination)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// IsPackageVisible provides a mock function with given fields: ctx, pkg, userID
+func (_m *Package) IsPackageVisible(ctx context.Context, pkg *models.Package, userID string) (bool, error) {
+	ret := _m.Called(ctx, pkg, userID)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Package, string) (bool, error)); ok {
+		return rf(ctx, pkg, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Package, string) bool); ok {
+		r0 = rf(ctx, pkg, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Package, string) error); ok {
+		r1 = rf(ctx, pkg, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RejectPackage provides a mock function with given fields: ctx, id, reason
+func (_m *Package) RejectPackage(ctx context.Context, id string, reason string) (*models.Package, error) {
+	ret := _m.Called(ctx, id, reason)
+
+	var r0 *models.Package
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.Package, error)); ok {
+		return rf(ctx, id, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.Package); ok {
+		r0 = rf(ctx, id, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Package)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestorePackage provides a mock function with given fields: ctx, id, userID
+func (_m *Package) RestorePackage(ctx context.Context, id string, userID string) (*models.Package, error) {
+	ret := _m.Called(ctx, id, userID)
+
+	var r0 *models.Package
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.Package, error)); ok {
+		return rf(ctx, id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.Package); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Package)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // UpdatePackage provides a mock function with given fields: ctx, req
@@ -3,8 +3,12 @@
 package mocks
 
 import (
-	mock "github.com/stretchr/testify/mock"
 	base "market.goravel.dev/proto/base"
+	_package "market.goravel.dev/proto/package"
+
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
 
 	models "market.goravel.dev/package/app/models"
 )
@@ -14,37 +18,162 @@ type Tag struct {
 	mock.Mock
 }
 
-// GetTags provides a mock function with given fields: packageID, name, pagination
-func (_m *Tag) GetTags(packageID string, name string, pagination *base.Pagination) ([]*models.Tag, int64, error) {
-	ret := _m.Called(packageID, name, pagination)
+// CreateTag provides a mock function with given fields: ctx, req
+func (_m *Tag) CreateTag(ctx context.Context, req *_package.CreateTagRequest) (*models.Tag, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *_package.CreateTagRequest) (*models.Tag, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *_package.CreateTagRequest) *models.Tag); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *_package.CreateTagRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateTagAlias provides a mock function with given fields: ctx, req
+func (_m *Tag) CreateTagAlias(ctx context.Context, req *_package.CreateTagAliasRequest) (*models.TagAlias, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *models.TagAlias
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *_package.CreateTagAliasRequest) (*models.TagAlias, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *_package.CreateTagAliasRequest) *models.TagAlias); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.TagAlias)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *_package.CreateTagAliasRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteTagAlias provides a mock function with given fields: ctx, tagID, name
+func (_m *Tag) DeleteTagAlias(ctx context.Context, tagID string, name string) error {
+	ret := _m.Called(ctx, tagID, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, tagID, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetTags provides a mock function with given fields: ctx, userID, packageID, name, sort, pagination
+func (_m *Tag) GetTags(ctx context.Context, userID string, packageID string, name string, sort string, pagination *base.Pagination) ([]*models.Tag, int64, string, error) {
+	ret := _m.Called(ctx, userID, packageID, name, sort, pagination)
 
 	var r0 []*models.Tag
 	var r1 int64
-	var r2 error
-	if rf, ok := ret.Get(0).(func(string, string, *base.Pagination) ([]*models.Tag, int64, error)); ok {
-		return rf(packageID, name, pagination)
+	var r2 string
+	var r3 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, *base.Pagination) ([]*models.Tag, int64, string, error)); ok {
+		return rf(ctx, userID, packageID, name, sort, pagination)
 	}
-	if rf, ok := ret.Get(0).(func(string, string, *base.Pagination) []*models.Tag); ok {
-		r0 = rf(packageID, name, pagination)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, *base.Pagination) []*models.Tag); ok {
+		r0 = rf(ctx, userID, packageID, name, sort, pagination)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*models.Tag)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string, *base.Pagination) int64); ok {
-		r1 = rf(packageID, name, pagination)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, *base.Pagination) int64); ok {
+		r1 = rf(ctx, userID, packageID, name, sort, pagination)
 	} else {
 		r1 = ret.Get(1).(int64)
 	}
 
-	if rf, ok := ret.Get(2).(func(string, string, *base.Pagination) error); ok {
-		r2 = rf(packageID, name, pagination)
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, string, string, *base.Pagination) string); ok {
+		r2 = rf(ctx, userID, packageID, name, sort, pagination)
+	} else {
+		r2 = ret.Get(2).(string)
+	}
+
+	if rf, ok := ret.Get(3).(func(context.Context, string, string, string, string, *base.Pagination) error); ok {
+		r3 = rf(ctx, userID, packageID, name, sort, pagination)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// MergeTags provides a mock function with given fields: ctx, id, targetID
+func (_m *Tag) MergeTags(ctx context.Context, id string, targetID string) (*models.Tag, error) {
+	ret := _m.Called(ctx, id, targetID)
+
+	var r0 *models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.Tag, error)); ok {
+		return rf(ctx, id, targetID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.Tag); ok {
+		r0 = rf(ctx, id, targetID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, targetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateTag provides a mock function with given fields: ctx, req
+func (_m *Tag) UpdateTag(ctx context.Context, req *_package.UpdateTagRequest) (*models.Tag, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *_package.UpdateTagRequest) (*models.Tag, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *_package.UpdateTagRequest) *models.Tag); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *_package.UpdateTagRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
 
-	return r0, r1, r2
+	return r0, r1
 }
 
 // NewTag creates a new instance of Tag. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -15,6 +15,32 @@ type User struct {
 	mock.Mock
 }
 
+// GetMember provides a mock function with given fields: ctx, organizationID, userID
+func (_m *User) GetMember(ctx context.Context, organizationID uint64, userID uint64) (*user.OrganizationMember, error) {
+	ret := _m.Called(ctx, organizationID, userID)
+
+	var r0 *user.OrganizationMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (*user.OrganizationMember, error)); ok {
+		return rf(ctx, organizationID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) *user.OrganizationMember); ok {
+		r0 = rf(ctx, organizationID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*user.OrganizationMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, organizationID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetUser provides a mock function with given fields: ctx, userID
 func (_m *User) GetUser(ctx context.Context, userID uint64) (*user.User, error) {
 	ret := _m.Called(ctx, userID)
@@ -67,6 +93,32 @@ func (_m *User) GetUsers(ctx context.Context, userIDs []string) ([]*user.User, e
 	return r0, r1
 }
 
+// ListUserOrganizations provides a mock function with given fields: ctx, userID
+func (_m *User) ListUserOrganizations(ctx context.Context, userID uint64) ([]uint64, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]uint64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []uint64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewUser creates a new instance of User. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUser(t interface {
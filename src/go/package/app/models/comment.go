@@ -0,0 +1,184 @@
+package models
+
+import (
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/database/orm"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/support/carbon"
+	"github.com/spf13/cast"
+
+	protopackage "market.goravel.dev/proto/package"
+	protouser "market.goravel.dev/proto/user"
+	"market.goravel.dev/utils/errors"
+)
+
+type CommentInterface interface {
+	CreateComment(comment *Comment) error
+	DeleteComment(comment *Comment) error
+	GetCommentByID(id string) (*Comment, error)
+	GetReplies(parentIDs []uint64, limit int) ([]*Comment, error)
+	UpdateComment(comment *Comment) error
+}
+
+// Comment is a question or a reply on a package page, it's stored in the package_comments table. Threads have two
+// levels: the top-level comments, whose ParentID is 0, and their replies. The comment count of the package is updated
+// in the same statement as its comments.
+type Comment struct {
+	UUIDModel
+	PackageID uint64
+	UserID    uint64
+	ParentID  uint64
+	Content   string
+	// The sanitized HTML rendered from the content by the services.
+	ContentHTML string `gorm:"-"`
+	EditedAt    carbon.DateTime
+	// Whether the commenter is the author of the package.
+	IsPackageAuthor bool       `gorm:"-"`
+	Replies         []*Comment `gorm:"-"`
+	// Whether the comment has more replies than the listed Replies.
+	HasMoreReplies bool            `gorm:"-"`
+	User           *protouser.User `gorm:"-"`
+	orm.SoftDeletes
+}
+
+func NewComment() *Comment {
+	return &Comment{}
+}
+
+func (r *Comment) TableName() string {
+	return "package_comments"
+}
+
+// CreateComment creates the comment and increments the comment count of the package.
+func (r *Comment) CreateComment(comment *Comment) error {
+	comment.ID = comment.GetID()
+	now := carbon.DateTime{Carbon: carbon.Now()}
+	if _, err := facades.Orm().Query().Exec(`WITH comments AS (
+  INSERT INTO package_comments (id, package_id, user_id, parent_id, content, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?) RETURNING package_id
+)
+UPDATE packages SET comment_count = packages.comment_count + 1 FROM comments WHERE packages.id = comments.package_id`,
+		comment.ID, comment.PackageID, comment.UserID, comment.ParentID, comment.Content, now, now); err != nil {
+		return errors.NewInternalServerError(err)
+	}
+
+	comment.CreatedAt = now
+	comment.UpdatedAt = now
+
+	return nil
+}
+
+// DeleteComment soft deletes the comment and decrements the comment count of the package, the replies of the
+// comment are kept.
+func (r *Comment) DeleteComment(comment *Comment) error {
+	now := carbon.DateTime{Carbon: carbon.Now()}
+	if _, err := facades.Orm().Query().Exec(`WITH comments AS (
+  UPDATE package_comments SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL RETURNING package_id
+)
+UPDATE packages SET comment_count = GREATEST(packages.comment_count - 1, 0) FROM comments WHERE packages.id = comments.package_id`, now, comment.ID); err != nil {
+		return errors.NewInternalServerError(err)
+	}
+
+	return nil
+}
+
+// GetCommentByID gets the comment, the ID of the returned comment is 0 if it doesn't exist or is deleted.
+func (r *Comment) GetCommentByID(id string) (*Comment, error) {
+	var comment Comment
+	if err := facades.Orm().Query().Where("id = ?", id).First(&comment); err != nil {
+		return nil, errors.NewInternalServerError(err)
+	}
+
+	return &comment, nil
+}
+
+// GetReplies gets the replies of the comments, the oldest first, at most the oldest limit replies of each comment.
+func (r *Comment) GetReplies(parentIDs []uint64, limit int) ([]*Comment, error) {
+	replies := make([]*Comment, 0)
+	if len(parentIDs) == 0 {
+		return replies, nil
+	}
+
+	if err := facades.Orm().Query().Where("parent_id IN ?", parentIDs).
+		Where("(SELECT COUNT(*) FROM package_comments AS earlier WHERE earlier.parent_id = package_comments.parent_id AND earlier.id < package_comments.id AND earlier.deleted_at IS NULL) < ?", limit).
+		Order("id ASC").Find(&replies); err != nil {
+		return nil, errors.NewInternalServerError(err)
+	}
+
+	return replies, nil
+}
+
+func (r *Comment) UpdateComment(comment *Comment) error {
+	now := carbon.DateTime{Carbon: carbon.Now()}
+	if _, err := facades.Orm().Query().Model(&Comment{}).Where("id = ?", comment.ID).Update(map[string]any{
+		"content":    comment.Content,
+		"edited_at":  now,
+		"updated_at": now,
+	}); err != nil {
+		return errors.NewInternalServerError(err)
+	}
+
+	comment.EditedAt = now
+	comment.UpdatedAt = now
+
+	return nil
+}
+
+// IsDeleted reports whether the comment is soft deleted, deleted comments are only listed as placeholders.
+func (r *Comment) IsDeleted() bool {
+	return r.DeletedAt.Valid
+}
+
+func (r *Comment) ToProto() *protopackage.Comment {
+	repliesProto := make([]*protopackage.Comment, 0, len(r.Replies))
+	for _, reply := range r.Replies {
+		repliesProto = append(repliesProto, reply.ToProto())
+	}
+
+	commentProto := &protopackage.Comment{
+		Id:              cast.ToString(r.ID),
+		PackageId:       cast.ToString(r.PackageID),
+		UserId:          cast.ToString(r.UserID),
+		Content:         r.Content,
+		ContentHtml:     r.ContentHTML,
+		CreatedAt:       r.CreatedAt.ToString(),
+		UpdatedAt:       r.UpdatedAt.ToString(),
+		EditedAt:        r.EditedAt.ToString(),
+		IsPackageAuthor: r.IsPackageAuthor,
+		User:            r.User,
+		Replies:         repliesProto,
+		HasMoreReplies:  r.HasMoreReplies,
+	}
+	if r.ParentID != 0 {
+		commentProto.ParentId = cast.ToString(r.ParentID)
+	}
+
+	// The placeholder of a deleted comment keeps the position in the thread without revealing the content or the
+	// commenter.
+	if r.IsDeleted() {
+		commentProto.UserId = ""
+		commentProto.Content = ""
+		commentProto.ContentHtml = ""
+		commentProto.EditedAt = ""
+		commentProto.IsDeleted = true
+		commentProto.IsPackageAuthor = false
+		commentProto.User = nil
+	}
+
+	return commentProto
+}
+
+// ListableReplies limits the query to the replies of the top-level comment of the package.
+func ListableReplies(packageID, parentID string) func(contractsorm.Query) contractsorm.Query {
+	return func(query contractsorm.Query) contractsorm.Query {
+		return query.Where("package_id = ? AND parent_id = ?", packageID, parentID)
+	}
+}
+
+// ListableComments limits the query to the top-level comments of the package, the deleted ones are kept as
+// placeholders if they have replies that aren't deleted. The query must be made with WithTrashed.
+func ListableComments(packageID string) func(contractsorm.Query) contractsorm.Query {
+	return func(query contractsorm.Query) contractsorm.Query {
+		return query.Where("package_id = ? AND parent_id = 0", packageID).
+			Where("(deleted_at IS NULL OR EXISTS (SELECT 1 FROM package_comments AS replies WHERE replies.parent_id = package_comments.id AND replies.deleted_at IS NULL))")
+	}
+}
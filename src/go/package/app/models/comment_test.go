@@ -0,0 +1,336 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/contracts/http"
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	"github.com/goravel/framework/support/carbon"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	protopackage "market.goravel.dev/proto/package"
+	protouser "market.goravel.dev/proto/user"
+	utilserrors "market.goravel.dev/utils/errors"
+)
+
+type CommentSuite struct {
+	suite.Suite
+	comment      *Comment
+	mockOrm      *mocksorm.Orm
+	mockOrmQuery *mocksorm.Query
+}
+
+func TestCommentSuite(t *testing.T) {
+	suite.Run(t, new(CommentSuite))
+}
+
+func (s *CommentSuite) SetupTest() {
+	s.comment = NewComment()
+}
+
+func (s *CommentSuite) beforeSetup() {
+	mockFactory := testingmock.Factory()
+	s.mockOrm = mockFactory.Orm()
+	s.mockOrmQuery = mockFactory.OrmQuery()
+	mockFactory.Log()
+	s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+}
+
+func (s *CommentSuite) TestCreateComment() {
+	isInsert := mock.MatchedBy(func(sql string) bool {
+		return strings.Contains(sql, "INSERT INTO package_comments") && strings.Contains(sql, "comment_count = packages.comment_count + 1")
+	})
+
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", isInsert, mock.AnythingOfType("uint64"), uint64(1), uint64(2), uint64(3), "Question", mock.Anything, mock.Anything).
+					Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+			},
+		},
+		{
+			name: "Sad path - Exec returns error",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", isInsert, mock.AnythingOfType("uint64"), uint64(1), uint64(2), uint64(3), "Question", mock.Anything, mock.Anything).
+					Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.beforeSetup()
+			test.setup()
+
+			comment := &Comment{PackageID: 1, UserID: 2, ParentID: 3, Content: "Question"}
+			s.Equal(test.expectedErr, s.comment.CreateComment(comment))
+			s.NotZero(comment.ID)
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *CommentSuite) TestDeleteComment() {
+	isDelete := mock.MatchedBy(func(sql string) bool {
+		return strings.Contains(sql, "UPDATE package_comments SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL") &&
+			strings.Contains(sql, "comment_count = GREATEST(packages.comment_count - 1, 0)")
+	})
+
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", isDelete, mock.Anything, uint64(1)).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+			},
+		},
+		{
+			name: "Sad path - Exec returns error",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", isDelete, mock.Anything, uint64(1)).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.beforeSetup()
+			test.setup()
+
+			s.Equal(test.expectedErr, s.comment.DeleteComment(&Comment{UUIDModel: UUIDModel{ID: 1}}))
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *CommentSuite) TestGetCommentByID() {
+	tests := []struct {
+		name          string
+		setup         func()
+		expectComment *Comment
+		expectedErr   error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrmQuery.On("Where", "id = ?", "1").Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("First", mock.AnythingOfType("*models.Comment")).Run(func(args mock.Arguments) {
+					*args.Get(0).(*Comment) = Comment{UUIDModel: UUIDModel{ID: 1}, Content: "Question"}
+				}).Return(nil).Once()
+			},
+			expectComment: &Comment{UUIDModel: UUIDModel{ID: 1}, Content: "Question"},
+		},
+		{
+			name: "Sad path - First returns error",
+			setup: func() {
+				s.mockOrmQuery.On("Where", "id = ?", "1").Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("First", mock.AnythingOfType("*models.Comment")).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.beforeSetup()
+			test.setup()
+
+			comment, err := s.comment.GetCommentByID("1")
+			s.Equal(test.expectComment, comment)
+			s.Equal(test.expectedErr, err)
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *CommentSuite) TestGetReplies() {
+	s.Run("Happy path", func() {
+		s.beforeSetup()
+		s.mockOrmQuery.On("Where", "parent_id IN ?", []uint64{1, 2}).Return(s.mockOrmQuery).Once()
+		s.mockOrmQuery.On("Where", "(SELECT COUNT(*) FROM package_comments AS earlier WHERE earlier.parent_id = package_comments.parent_id AND earlier.id < package_comments.id AND earlier.deleted_at IS NULL) < ?", 4).Return(s.mockOrmQuery).Once()
+		s.mockOrmQuery.On("Order", "id ASC").Return(s.mockOrmQuery).Once()
+		s.mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Comment")).Run(func(args mock.Arguments) {
+			*args.Get(0).(*[]*Comment) = []*Comment{{UUIDModel: UUIDModel{ID: 3}, ParentID: 1}}
+		}).Return(nil).Once()
+
+		replies, err := s.comment.GetReplies([]uint64{1, 2}, 4)
+		s.Nil(err)
+		s.Equal([]*Comment{{UUIDModel: UUIDModel{ID: 3}, ParentID: 1}}, replies)
+
+		s.mockOrm.AssertExpectations(s.T())
+		s.mockOrmQuery.AssertExpectations(s.T())
+	})
+
+	s.Run("Happy path - no comments", func() {
+		replies, err := s.comment.GetReplies(nil, 4)
+		s.Nil(err)
+		s.Empty(replies)
+	})
+
+	s.Run("Sad path - Find returns error", func() {
+		s.beforeSetup()
+		s.mockOrmQuery.On("Where", "parent_id IN ?", []uint64{1}).Return(s.mockOrmQuery).Once()
+		s.mockOrmQuery.On("Where", "(SELECT COUNT(*) FROM package_comments AS earlier WHERE earlier.parent_id = package_comments.parent_id AND earlier.id < package_comments.id AND earlier.deleted_at IS NULL) < ?", 4).Return(s.mockOrmQuery).Once()
+		s.mockOrmQuery.On("Order", "id ASC").Return(s.mockOrmQuery).Once()
+		s.mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Comment")).Return(errors.New("error")).Once()
+
+		replies, err := s.comment.GetReplies([]uint64{1}, 4)
+		s.Nil(replies)
+		s.Equal(utilserrors.New(http.StatusInternalServerError, "error"), err)
+
+		s.mockOrm.AssertExpectations(s.T())
+		s.mockOrmQuery.AssertExpectations(s.T())
+	})
+}
+
+func (s *CommentSuite) TestUpdateComment() {
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrmQuery.On("Model", &Comment{}).Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("Where", "id = ?", uint64(1)).Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("Update", mock.MatchedBy(func(values map[string]any) bool {
+					return values["content"] == "Edited" && values["edited_at"] != nil
+				})).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+			},
+		},
+		{
+			name: "Sad path - Update returns error",
+			setup: func() {
+				s.mockOrmQuery.On("Model", &Comment{}).Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("Where", "id = ?", uint64(1)).Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("Update", mock.Anything).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.beforeSetup()
+			test.setup()
+
+			comment := &Comment{UUIDModel: UUIDModel{ID: 1}, Content: "Edited"}
+			err := s.comment.UpdateComment(comment)
+			s.Equal(test.expectedErr, err)
+			s.Equal(err == nil, !comment.EditedAt.IsZero())
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *CommentSuite) TestToProto() {
+	var (
+		user     = &protouser.User{Id: "2", Name: "goravel"}
+		editedAt = carbon.NewDateTime(carbon.FromDateTime(2024, 1, 2, 3, 4, 5))
+	)
+
+	s.Run("Thread with replies", func() {
+		comment := &Comment{
+			UUIDModel:       UUIDModel{ID: 1},
+			PackageID:       10,
+			UserID:          2,
+			Content:         "Question",
+			EditedAt:        editedAt,
+			IsPackageAuthor: true,
+			User:            user,
+			Replies:         []*Comment{{UUIDModel: UUIDModel{ID: 3}, PackageID: 10, UserID: 4, ParentID: 1, Content: "Answer"}},
+			HasMoreReplies:  true,
+		}
+
+		s.Equal(&protopackage.Comment{
+			Id:              "1",
+			PackageId:       "10",
+			UserId:          "2",
+			Content:         "Question",
+			CreatedAt:       comment.CreatedAt.ToString(),
+			UpdatedAt:       comment.UpdatedAt.ToString(),
+			EditedAt:        editedAt.ToString(),
+			IsPackageAuthor: true,
+			User:            user,
+			Replies: []*protopackage.Comment{
+				{
+					Id:        "3",
+					PackageId: "10",
+					UserId:    "4",
+					ParentId:  "1",
+					Content:   "Answer",
+					CreatedAt: comment.CreatedAt.ToString(),
+					UpdatedAt: comment.UpdatedAt.ToString(),
+					EditedAt:  carbon.DateTime{}.ToString(),
+					Replies:   []*protopackage.Comment{},
+				},
+			},
+			HasMoreReplies: true,
+		}, comment.ToProto())
+	})
+
+	s.Run("Deleted comment", func() {
+		comment := &Comment{
+			UUIDModel:       UUIDModel{ID: 1},
+			PackageID:       10,
+			UserID:          2,
+			Content:         "Question",
+			EditedAt:        editedAt,
+			IsPackageAuthor: true,
+			User:            user,
+		}
+		comment.DeletedAt.Valid = true
+
+		s.Equal(&protopackage.Comment{
+			Id:        "1",
+			PackageId: "10",
+			CreatedAt: comment.CreatedAt.ToString(),
+			UpdatedAt: comment.UpdatedAt.ToString(),
+			IsDeleted: true,
+			Replies:   []*protopackage.Comment{},
+		}, comment.ToProto())
+	})
+}
+
+func (s *CommentSuite) TestListableComments() {
+	mockOrmQuery := &mocksorm.Query{}
+	mockOrmQuery.On("Where", "package_id = ? AND parent_id = 0", "1").Return(mockOrmQuery).Once()
+	mockOrmQuery.On("Where", mock.MatchedBy(func(sql string) bool {
+		return strings.HasPrefix(sql, "(deleted_at IS NULL OR EXISTS")
+	})).Return(mockOrmQuery).Once()
+
+	s.Equal(mockOrmQuery, ListableComments("1")(mockOrmQuery))
+	mockOrmQuery.AssertExpectations(s.T())
+}
+
+func (s *CommentSuite) TestListableReplies() {
+	mockOrmQuery := &mocksorm.Query{}
+	mockOrmQuery.On("Where", "package_id = ? AND parent_id = ?", "1", "10").Return(mockOrmQuery).Once()
+
+	s.Equal(mockOrmQuery, ListableReplies("1", "10")(mockOrmQuery))
+	mockOrmQuery.AssertExpectations(s.T())
+}
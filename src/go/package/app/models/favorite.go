@@ -0,0 +1,77 @@
+package models
+
+import (
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/support/carbon"
+
+	"market.goravel.dev/utils/errors"
+)
+
+type FavoriteInterface interface {
+	CreateFavorite(packageID, userID uint64) (bool, error)
+	DeleteFavorite(packageID, userID uint64) (bool, error)
+	GetFavoritedPackageIDs(userID uint64, packageIDs []uint64) (map[uint64]bool, error)
+}
+
+// Favorite is a package bookmarked by a user, it's stored in the package_favorites table.
+type Favorite struct {
+	UUIDModel
+	PackageID uint64
+	UserID    uint64
+}
+
+func NewFavorite() *Favorite {
+	return &Favorite{}
+}
+
+func (r *Favorite) TableName() string {
+	return "package_favorites"
+}
+
+// CreateFavorite favorites the package for the user and increments the favorite count of the package in one
+// statement, it reports whether the favorite is created, it's false if the user has favorited the package already.
+func (r *Favorite) CreateFavorite(packageID, userID uint64) (bool, error) {
+	now := carbon.DateTime{Carbon: carbon.Now()}
+	result, err := facades.Orm().Query().Exec(`WITH favorites AS (
+  INSERT INTO package_favorites (id, package_id, user_id, created_at, updated_at) VALUES (?, ?, ?, ?, ?) ON CONFLICT (package_id, user_id) DO NOTHING RETURNING package_id
+)
+UPDATE packages SET favorite_count = packages.favorite_count + 1 FROM favorites WHERE packages.id = favorites.package_id`, r.GetID(), packageID, userID, now, now)
+	if err != nil {
+		return false, errors.NewInternalServerError(err)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// DeleteFavorite unfavorites the package for the user and decrements the favorite count of the package in one
+// statement, it reports whether the favorite is deleted, it's false if the user hasn't favorited the package.
+func (r *Favorite) DeleteFavorite(packageID, userID uint64) (bool, error) {
+	result, err := facades.Orm().Query().Exec(`WITH favorites AS (
+  DELETE FROM package_favorites WHERE package_id = ? AND user_id = ? RETURNING package_id
+)
+UPDATE packages SET favorite_count = GREATEST(packages.favorite_count - 1, 0) FROM favorites WHERE packages.id = favorites.package_id`, packageID, userID)
+	if err != nil {
+		return false, errors.NewInternalServerError(err)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// GetFavoritedPackageIDs gets which of the packages are favorited by the user.
+func (r *Favorite) GetFavoritedPackageIDs(userID uint64, packageIDs []uint64) (map[uint64]bool, error) {
+	favorited := make(map[uint64]bool)
+	if userID == 0 || len(packageIDs) == 0 {
+		return favorited, nil
+	}
+
+	var favoritedIDs []uint64
+	if err := facades.Orm().Query().Model(&Favorite{}).Where("user_id = ? AND package_id IN ?", userID, packageIDs).Pluck("package_id", &favoritedIDs); err != nil {
+		return nil, errors.NewInternalServerError(err)
+	}
+
+	for _, id := range favoritedIDs {
+		favorited[id] = true
+	}
+
+	return favorited, nil
+}
@@ -0,0 +1,197 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/contracts/http"
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	utilserrors "market.goravel.dev/utils/errors"
+)
+
+type FavoriteSuite struct {
+	suite.Suite
+	favorite     *Favorite
+	mockOrm      *mocksorm.Orm
+	mockOrmQuery *mocksorm.Query
+}
+
+func TestFavoriteSuite(t *testing.T) {
+	suite.Run(t, new(FavoriteSuite))
+}
+
+func (s *FavoriteSuite) SetupTest() {
+	s.favorite = NewFavorite()
+}
+
+func (s *FavoriteSuite) beforeSetup() {
+	mockFactory := testingmock.Factory()
+	s.mockOrm = mockFactory.Orm()
+	s.mockOrmQuery = mockFactory.OrmQuery()
+	mockFactory.Log()
+	s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+}
+
+func (s *FavoriteSuite) TestCreateFavorite() {
+	isInsert := mock.MatchedBy(func(sql string) bool {
+		return strings.Contains(sql, "INSERT INTO package_favorites") && strings.Contains(sql, "favorite_count = packages.favorite_count + 1")
+	})
+
+	tests := []struct {
+		name          string
+		setup         func()
+		expectCreated bool
+		expectedErr   error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", isInsert, mock.AnythingOfType("uint64"), uint64(1), uint64(2), mock.Anything, mock.Anything).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+			},
+			expectCreated: true,
+		},
+		{
+			name: "Happy path - favorited already",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", isInsert, mock.AnythingOfType("uint64"), uint64(1), uint64(2), mock.Anything, mock.Anything).Return(&contractsorm.Result{RowsAffected: 0}, nil).Once()
+			},
+		},
+		{
+			name: "Sad path - Exec returns error",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", isInsert, mock.AnythingOfType("uint64"), uint64(1), uint64(2), mock.Anything, mock.Anything).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.beforeSetup()
+			test.setup()
+
+			created, err := s.favorite.CreateFavorite(1, 2)
+			s.Equal(test.expectCreated, created)
+			s.Equal(test.expectedErr, err)
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *FavoriteSuite) TestDeleteFavorite() {
+	isDelete := mock.MatchedBy(func(sql string) bool {
+		return strings.Contains(sql, "DELETE FROM package_favorites") && strings.Contains(sql, "favorite_count = GREATEST(packages.favorite_count - 1, 0)")
+	})
+
+	tests := []struct {
+		name          string
+		setup         func()
+		expectDeleted bool
+		expectedErr   error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", isDelete, uint64(1), uint64(2)).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+			},
+			expectDeleted: true,
+		},
+		{
+			name: "Happy path - not favorited",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", isDelete, uint64(1), uint64(2)).Return(&contractsorm.Result{RowsAffected: 0}, nil).Once()
+			},
+		},
+		{
+			name: "Sad path - Exec returns error",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", isDelete, uint64(1), uint64(2)).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.beforeSetup()
+			test.setup()
+
+			deleted, err := s.favorite.DeleteFavorite(1, 2)
+			s.Equal(test.expectDeleted, deleted)
+			s.Equal(test.expectedErr, err)
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *FavoriteSuite) TestGetFavoritedPackageIDs() {
+	packageIDs := []uint64{1, 2, 3}
+
+	tests := []struct {
+		name            string
+		userID          uint64
+		packageIDs      []uint64
+		setup           func()
+		expectFavorited map[uint64]bool
+		expectedErr     error
+	}{
+		{
+			name:       "Happy path",
+			userID:     1,
+			packageIDs: packageIDs,
+			setup: func() {
+				s.beforeSetup()
+				s.mockOrmQuery.On("Model", &Favorite{}).Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("Where", "user_id = ? AND package_id IN ?", uint64(1), packageIDs).Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("Pluck", "package_id", mock.Anything).Run(func(args mock.Arguments) {
+					*args.Get(1).(*[]uint64) = []uint64{1, 3}
+				}).Return(nil).Once()
+			},
+			expectFavorited: map[uint64]bool{1: true, 3: true},
+		},
+		{
+			name:            "Happy path - anonymous user",
+			packageIDs:      packageIDs,
+			setup:           func() {},
+			expectFavorited: map[uint64]bool{},
+		},
+		{
+			name:       "Sad path - Pluck returns error",
+			userID:     1,
+			packageIDs: packageIDs,
+			setup: func() {
+				s.beforeSetup()
+				s.mockOrmQuery.On("Model", &Favorite{}).Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("Where", "user_id = ? AND package_id IN ?", uint64(1), packageIDs).Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("Pluck", "package_id", mock.Anything).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.mockOrm = nil
+			test.setup()
+
+			favorited, err := s.favorite.GetFavoritedPackageIDs(test.userID, test.packageIDs)
+			s.Equal(test.expectFavorited, favorited)
+			s.Equal(test.expectedErr, err)
+
+			if s.mockOrm != nil {
+				s.mockOrm.AssertExpectations(s.T())
+				s.mockOrmQuery.AssertExpectations(s.T())
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package models
+
+import (
+	stderrors "errors"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/support/carbon"
+	"github.com/spf13/cast"
+
+	protopackage "market.goravel.dev/proto/package"
+	protouser "market.goravel.dev/proto/user"
+	"market.goravel.dev/utils/errors"
+)
+
+const (
+	MaintainerRoleOwner      = "owner"
+	MaintainerRoleMaintainer = "maintainer"
+)
+
+// ErrDuplicateMaintainer is returned when the user maintains the package already.
+var ErrDuplicateMaintainer = stderrors.New("duplicate maintainer")
+
+type MaintainerInterface interface {
+	AddMaintainer(maintainer *Maintainer) error
+	GetMaintainer(packageID, userID uint64) (*Maintainer, error)
+	GetMaintainers(packageID uint64) ([]*Maintainer, error)
+	RemoveMaintainer(packageID, userID uint64) (bool, error)
+	TransferOwnership(query contractsorm.Query, packageID, previousOwnerID, ownerID uint64) error
+}
+
+// Maintainer is a user who can manage a package, it's stored in the package_maintainers table. The owner of a package
+// is the maintainer with the owner role, it's kept in line with the user ID of the package.
+type Maintainer struct {
+	UUIDModel
+	PackageID uint64
+	UserID    uint64
+	Role      string
+	User      *protouser.User `gorm:"-"`
+}
+
+func NewMaintainer() *Maintainer {
+	return &Maintainer{}
+}
+
+func (r *Maintainer) TableName() string {
+	return "package_maintainers"
+}
+
+// AddMaintainer adds the maintainer to the package, ErrDuplicateMaintainer is returned if the user maintains the package
+// already.
+func (r *Maintainer) AddMaintainer(maintainer *Maintainer) error {
+	maintainer.ID = maintainer.GetID()
+	if err := facades.Orm().Query().Create(maintainer); err != nil {
+		if isUniqueViolation(err) {
+			return ErrDuplicateMaintainer
+		}
+
+		return errors.NewInternalServerError(err)
+	}
+
+	return nil
+}
+
+// GetMaintainer gets the user in the maintainers of the package, the ID of the returned maintainer is 0 if the user
+// doesn't maintain the package.
+func (r *Maintainer) GetMaintainer(packageID, userID uint64) (*Maintainer, error) {
+	var maintainer Maintainer
+	if err := facades.Orm().Query().Where("package_id = ? AND user_id = ?", packageID, userID).First(&maintainer); err != nil {
+		return nil, errors.NewInternalServerError(err)
+	}
+
+	return &maintainer, nil
+}
+
+// GetMaintainers gets the maintainers of the package, the owner first and the others in the order they were added.
+func (r *Maintainer) GetMaintainers(packageID uint64) ([]*Maintainer, error) {
+	var maintainers []*Maintainer
+	if err := facades.Orm().Query().Where("package_id = ?", packageID).Order("role = 'owner' DESC").Order("id ASC").Find(&maintainers); err != nil {
+		return nil, errors.NewInternalServerError(err)
+	}
+
+	return maintainers, nil
+}
+
+// RemoveMaintainer removes the user from the maintainers of the package, the owner can't be removed. It reports whether
+// the maintainer is removed, it's false if the user isn't a maintainer of the package.
+func (r *Maintainer) RemoveMaintainer(packageID, userID uint64) (bool, error) {
+	result, err := facades.Orm().Query().Exec("DELETE FROM package_maintainers WHERE package_id = ? AND user_id = ? AND role = ?", packageID, userID, MaintainerRoleMaintainer)
+	if err != nil {
+		return false, errors.NewInternalServerError(err)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (r *Maintainer) ToProto() *protopackage.Maintainer {
+	return &protopackage.Maintainer{
+		PackageId: cast.ToString(r.PackageID),
+		UserId:    cast.ToString(r.UserID),
+		Role:      r.Role,
+		CreatedAt: r.CreatedAt.ToString(),
+		User:      r.User,
+	}
+}
+
+// TransferOwnership makes the user the owner in the maintainers of the package on the given query, so it can be a part
+// of the transaction updating the package. The previous owner stays a maintainer.
+func (r *Maintainer) TransferOwnership(query contractsorm.Query, packageID, previousOwnerID, ownerID uint64) error {
+	now := carbon.DateTime{Carbon: carbon.Now()}
+	if _, err := query.Exec("UPDATE package_maintainers SET role = ?, updated_at = ? WHERE package_id = ? AND user_id = ?",
+		MaintainerRoleMaintainer, now, packageID, previousOwnerID); err != nil {
+		return errors.NewInternalServerError(err)
+	}
+
+	if _, err := query.Exec("INSERT INTO package_maintainers (id, package_id, user_id, role, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?) ON CONFLICT (package_id, user_id) DO UPDATE SET role = EXCLUDED.role, updated_at = EXCLUDED.updated_at",
+		r.GetID(), packageID, ownerID, MaintainerRoleOwner, now, now); err != nil {
+		return errors.NewInternalServerError(err)
+	}
+
+	return nil
+}
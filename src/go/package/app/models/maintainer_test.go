@@ -0,0 +1,227 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/contracts/http"
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	utilserrors "market.goravel.dev/utils/errors"
+)
+
+type MaintainerSuite struct {
+	suite.Suite
+	maintainer   *Maintainer
+	mockOrm      *mocksorm.Orm
+	mockOrmQuery *mocksorm.Query
+}
+
+func TestMaintainerSuite(t *testing.T) {
+	suite.Run(t, new(MaintainerSuite))
+}
+
+func (s *MaintainerSuite) SetupTest() {
+	s.maintainer = NewMaintainer()
+}
+
+func (s *MaintainerSuite) beforeSetup() {
+	mockFactory := testingmock.Factory()
+	s.mockOrm = mockFactory.Orm()
+	s.mockOrmQuery = mockFactory.OrmQuery()
+	mockFactory.Log()
+	s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+}
+
+func (s *MaintainerSuite) TestAddMaintainer() {
+	maintainer := &Maintainer{PackageID: 1, UserID: 2, Role: MaintainerRoleMaintainer}
+
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrmQuery.On("Create", mock.MatchedBy(func(maintainer *Maintainer) bool {
+					return maintainer.ID > 0 && maintainer.PackageID == 1 && maintainer.UserID == 2
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name: "Sad path - user maintains the package already",
+			setup: func() {
+				s.mockOrmQuery.On("Create", maintainer).Return(sqlStateError("23505")).Once()
+			},
+			expectedErr: ErrDuplicateMaintainer,
+		},
+		{
+			name: "Sad path - Create returns error",
+			setup: func() {
+				s.mockOrmQuery.On("Create", maintainer).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.beforeSetup()
+			test.setup()
+
+			s.Equal(test.expectedErr, s.maintainer.AddMaintainer(maintainer))
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *MaintainerSuite) TestGetMaintainer() {
+	tests := []struct {
+		name             string
+		setup            func()
+		expectMaintainer *Maintainer
+		expectedErr      error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrmQuery.On("Where", "package_id = ? AND user_id = ?", uint64(1), uint64(2)).Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("First", mock.AnythingOfType("*models.Maintainer")).Run(func(args mock.Arguments) {
+					maintainer := args.Get(0).(*Maintainer)
+					maintainer.ID = 1
+					maintainer.PackageID = 1
+					maintainer.UserID = 2
+					maintainer.Role = MaintainerRoleMaintainer
+				}).Return(nil).Once()
+			},
+			expectMaintainer: &Maintainer{UUIDModel: UUIDModel{ID: 1}, PackageID: 1, UserID: 2, Role: MaintainerRoleMaintainer},
+		},
+		{
+			name: "Sad path - First returns error",
+			setup: func() {
+				s.mockOrmQuery.On("Where", "package_id = ? AND user_id = ?", uint64(1), uint64(2)).Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("First", mock.AnythingOfType("*models.Maintainer")).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.beforeSetup()
+			test.setup()
+
+			maintainer, err := s.maintainer.GetMaintainer(1, 2)
+			s.Equal(test.expectMaintainer, maintainer)
+			s.Equal(test.expectedErr, err)
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *MaintainerSuite) TestRemoveMaintainer() {
+	deleteSQL := "DELETE FROM package_maintainers WHERE package_id = ? AND user_id = ? AND role = ?"
+
+	tests := []struct {
+		name          string
+		setup         func()
+		expectRemoved bool
+		expectedErr   error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", deleteSQL, uint64(1), uint64(2), MaintainerRoleMaintainer).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+			},
+			expectRemoved: true,
+		},
+		{
+			name: "Happy path - user isn't a maintainer",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", deleteSQL, uint64(1), uint64(2), MaintainerRoleMaintainer).Return(&contractsorm.Result{RowsAffected: 0}, nil).Once()
+			},
+		},
+		{
+			name: "Sad path - Exec returns error",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", deleteSQL, uint64(1), uint64(2), MaintainerRoleMaintainer).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.beforeSetup()
+			test.setup()
+
+			removed, err := s.maintainer.RemoveMaintainer(1, 2)
+			s.Equal(test.expectRemoved, removed)
+			s.Equal(test.expectedErr, err)
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *MaintainerSuite) TestTransferOwnership() {
+	isDemote := mock.MatchedBy(func(sql string) bool {
+		return strings.HasPrefix(sql, "UPDATE package_maintainers SET role = ?")
+	})
+	isPromote := mock.MatchedBy(func(sql string) bool {
+		return strings.HasPrefix(sql, "INSERT INTO package_maintainers") && strings.Contains(sql, "ON CONFLICT (package_id, user_id) DO UPDATE")
+	})
+
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", isDemote, MaintainerRoleMaintainer, mock.Anything, uint64(1), uint64(2)).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+				s.mockOrmQuery.On("Exec", isPromote, mock.AnythingOfType("uint64"), uint64(1), uint64(3), MaintainerRoleOwner, mock.Anything, mock.Anything).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+			},
+		},
+		{
+			name: "Sad path - demoting the previous owner returns error",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", isDemote, MaintainerRoleMaintainer, mock.Anything, uint64(1), uint64(2)).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+		{
+			name: "Sad path - promoting the owner returns error",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", isDemote, MaintainerRoleMaintainer, mock.Anything, uint64(1), uint64(2)).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+				s.mockOrmQuery.On("Exec", isPromote, mock.AnythingOfType("uint64"), uint64(1), uint64(3), MaintainerRoleOwner, mock.Anything, mock.Anything).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			mockFactory := testingmock.Factory()
+			s.mockOrmQuery = mockFactory.OrmQuery()
+			mockFactory.Log()
+			test.setup()
+
+			s.Equal(test.expectedErr, s.maintainer.TransferOwnership(s.mockOrmQuery, 1, 2, 3))
+
+			s.mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
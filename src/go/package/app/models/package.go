@@ -1,38 +1,125 @@
 package models
 
 import (
+	stderrors "errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
 	contractsorm "github.com/goravel/framework/contracts/database/orm"
 	"github.com/goravel/framework/database/orm"
 	"github.com/goravel/framework/facades"
 	"github.com/goravel/framework/support/carbon"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/spf13/cast"
 
+	"market.goravel.dev/package/app/github"
 	protopackage "market.goravel.dev/proto/package"
 	protouser "market.goravel.dev/proto/user"
 	"market.goravel.dev/utils/errors"
 )
 
+const (
+	PackageApproved    int32 = 1
+	PackageNotApproved int32 = 2
+	PackageRejected    int32 = 3
+
+	PackagePublic  int32 = 1
+	PackagePrivate int32 = 2
+
+	PackageSortViews     = "views"
+	PackageSortFavorites = "favorites"
+	PackageSortCreated   = "created"
+	PackageSortUpdated   = "updated"
+	PackageSortName      = "name"
+
+	TagMatchAny = "any"
+	TagMatchAll = "all"
+)
+
+// ErrDuplicatePackageName is returned when the user has another package with the same name, the names are unique per
+// user among the packages that aren't deleted.
+var ErrDuplicatePackageName = stderrors.New("duplicate package name")
+
+// ErrDuplicatePackageSlug is returned when a concurrent package took the generated slug before the package was saved,
+// generating the slug again in a new transaction resolves it.
+var ErrDuplicatePackageSlug = stderrors.New("duplicate package slug")
+
+// The unique indexes of the packages table, the unique violations are told apart by them.
+const (
+	packagesSlugUnique       = "packages_slug_unique"
+	packagesUserIDNameUnique = "packages_user_id_name_unique"
+)
+
+// ErrPackageChanged is returned when the package was updated by someone else after it was read, the revision of the
+// package differs.
+var ErrPackageChanged = stderrors.New("package changed")
+
+// PackageSortColumns are the ordered columns of the sort fields of the package listings, they can't be NULL to be
+// paginated by keyset. The packages are created in the order of their snowflake IDs, so created is ordered by ID only.
+var PackageSortColumns = map[string]string{
+	PackageSortViews:     "COALESCE(view_count, 0)",
+	PackageSortFavorites: "favorite_count",
+	PackageSortCreated:   "",
+	PackageSortUpdated:   "COALESCE(last_updated_at, created_at)",
+	PackageSortName:      "name",
+}
+
 type PackageInterface interface {
-	AttachTags(pkg *Package, tags []string) error
+	AttachTags(query contractsorm.Query, pkg *Package, tags []string) error
+	BackfillSlugs(batchSize int) (int, error)
+	CreatePackage(query contractsorm.Query, pkg *Package) error
+	DeletePackage(pkg *Package) error
+	GetDeletedPackageByID(id string) (*Package, error)
 	GetPackageByID(id string, fields []string) (*Package, error)
-	UpdatePackage(pkg *Package) error
+	GetPackageBySlug(slug string, fields []string) (*Package, error)
+	GetPackagesToSync(limit int) ([]*Package, error)
+	IncrementViewCounts(counts map[string]int) error
+	PurgeDeletedPackages(deletedBefore time.Time) ([]*Package, error)
+	RestorePackage(pkg *Package) error
+	UpdatePackage(query contractsorm.Query, pkg *Package) error
+	UpdateSlug(query contractsorm.Query, pkg *Package) error
+	UpdateSyncedAt(pkg *Package) error
+	UpdateSyncedPackage(pkg *Package) error
+	UpdateVersion(query contractsorm.Query, pkg *Package) error
 }
 
 type Package struct {
 	UUIDModel
-	UserID        uint64
-	Name          string
-	Summary       string
-	Description   string
-	Link          string
-	Cover         string
-	Version       string
-	ViewCount     uint32
-	IsPublic      int32
-	IsApproved    int32
-	LastUpdatedAt carbon.DateTime
-	Tags          []*Tag          `gorm:"many2many:package_tags;"`
-	User          *protouser.User `gorm:"-"`
+	UserID          uint64
+	Name            string
+	Slug            string
+	Summary         string
+	Description     string
+	DescriptionHTML string `gorm:"-"` // Only set on the package pages.
+	Link            string
+	Cover           string
+	CoverThumbnail  string
+	Version         string
+	ViewCount       uint32 `gorm:"<-:create"` // Maintained by IncrementViewCounts, the same as FavoriteCount.
+	IsPublic        int32
+	IsApproved      int32
+	RejectReason    string
+	LastUpdatedAt   carbon.DateTime
+	Stars           uint32
+	License         string
+	Readme          string
+	SyncedAt        carbon.DateTime
+	Highlight       string          `gorm:"->"`        // The snippet of the matched text, only set in search results.
+	FavoriteCount   uint32          `gorm:"<-:create"` // Maintained by the favorite statements, saving a package must not overwrite it.
+	IsFavorited     bool            `gorm:"-"`
+	FavoriteID      uint64          `gorm:"->"` // The favorite of the user, only set in the favorite packages of the user.
+	FavoritedAt     carbon.DateTime `gorm:"->"`
+	RatingCount     uint32          `gorm:"<-:create"` // Maintained by the review statements, the same as FavoriteCount.
+	RatingSum       uint32          `gorm:"<-:create"`
+	CommentCount    uint32          `gorm:"<-:create"` // Maintained by the comment statements.
+	Revision        uint64          // Increased by UpdatePackage, guards the package against the concurrent updates.
+	PendingOwnerID  uint64          // The user the package is being transferred to, 0 without a pending transfer.
+	OrganizationID  uint64          // The organization publishing the package, 0 if it's published by the owner.
+	Tags            []*Tag          `gorm:"many2many:package_tags;"`
+	User            *protouser.User `gorm:"-"`
 	orm.SoftDeletes
 }
 
@@ -40,47 +127,172 @@ func NewPackage() *Package {
 	return &Package{}
 }
 
-func (r *Package) AttachTags(pkg *Package, tags []string) error {
+// AttachTags replaces the tags of the package. The names are normalized by NormalizeTags, the names matching an alias
+// are resolved to the canonical tag, the other names are matched case-insensitively and the missing tags are created.
+// The statements run on the given query, so they can be a part of a transaction.
+func (r *Package) AttachTags(query contractsorm.Query, pkg *Package, tags []string) error {
+	tags = NormalizeTags(tags)
 	tagsAny := make([]any, len(tags))
 	for i, tag := range tags {
 		tagsAny[i] = tag
 	}
+
+	aliases := make([]*TagAlias, 0)
+	if err := query.WhereIn("name", tagsAny).Find(&aliases); err != nil {
+		return errors.NewInternalServerError(err)
+	}
+	aliasMap := make(map[string]uint64, len(aliases))
+	aliasTagIDs := make([]any, 0, len(aliases))
+	for _, alias := range aliases {
+		aliasMap[alias.Name] = alias.TagID
+		aliasTagIDs = append(aliasTagIDs, alias.TagID)
+	}
+
+	var tagQuery contractsorm.Query
+	if len(aliasTagIDs) > 0 {
+		tagQuery = query.Where("LOWER(name) IN ? OR id IN ?", tagsAny, aliasTagIDs)
+	} else {
+		tagQuery = query.Where("LOWER(name) IN ?", tagsAny)
+	}
 	existTags := make([]*Tag, 0, len(tags))
-	if err := facades.Orm().Query().WhereIn("name", tagsAny).Find(&existTags); err != nil {
+	if err := tagQuery.Find(&existTags); err != nil {
 		return errors.NewInternalServerError(err)
 	}
-	existTagMap := make(map[string]bool, len(existTags))
+	tagIDMap := make(map[uint64]*Tag, len(existTags))
+	tagNameMap := make(map[string]*Tag, len(existTags))
 	for _, tag := range existTags {
-		existTagMap[tag.Name] = true
+		tagIDMap[tag.ID] = tag
+		// The tags created before the names were normalized may differ in case only.
+		tagNameMap[strings.ToLower(tag.Name)] = tag
 	}
 
+	// An alias and the name of its tag may resolve to the same tag, each tag is attached once.
+	attachTags := make([]*Tag, 0, len(tags))
+	attached := make(map[uint64]bool, len(tags))
 	newTags := make([]*Tag, 0, len(tags))
-	for _, tag := range tags {
-		if !existTagMap[tag] {
-			newTag := Tag{
-				Name:   tag,
+	for _, name := range tags {
+		tag, ok := tagIDMap[aliasMap[name]]
+		if !ok {
+			tag, ok = tagNameMap[name]
+		}
+		if !ok {
+			tag = &Tag{
+				Name:   name,
 				UserID: pkg.UserID,
-				IsShow: 1,
+				IsShow: TagShown,
 			}
-			newTag.ID = newTag.GetID()
-			newTags = append(newTags, &newTag)
+			tag.ID = tag.GetID()
+			newTags = append(newTags, tag)
+		}
+
+		if !attached[tag.ID] {
+			attached[tag.ID] = true
+			attachTags = append(attachTags, tag)
 		}
 	}
 
 	if len(newTags) > 0 {
-		if err := facades.Orm().Query().Create(newTags); err != nil {
+		if err := query.Create(newTags); err != nil {
+			return errors.NewInternalServerError(err)
+		}
+	}
+
+	// The counts of the tags only include the approved public packages, so they are kept for the other packages.
+	if pkg.IsApproved != PackageApproved || pkg.IsPublic != PackagePublic {
+		if err := query.Model(pkg).Association("Tags").Replace(attachTags); err != nil {
 			return errors.NewInternalServerError(err)
 		}
-		existTags = append(existTags, newTags...)
+
+		return nil
+	}
+
+	var tagIDs []any
+	if err := query.Table("package_tags").Where("package_id = ?", pkg.ID).Pluck("tag_id", &tagIDs); err != nil {
+		return errors.NewInternalServerError(err)
+	}
+	if err := query.Model(pkg).Association("Tags").Replace(attachTags); err != nil {
+		return errors.NewInternalServerError(err)
+	}
+	for _, tag := range attachTags {
+		tagIDs = append(tagIDs, tag.ID)
+	}
+
+	// Both the detached and the attached tags are recounted.
+	return refreshTagPackageCounts(query, "id IN ?", tagIDs)
+}
+
+// BackfillSlugs generates the slugs of the packages created before the packages had slugs, batchSize packages are
+// loaded at a time. It returns the number of the updated packages.
+func (r *Package) BackfillSlugs(batchSize int) (int, error) {
+	var count int
+	for {
+		var packages []*Package
+		if err := facades.Orm().Query().WithTrashed().Select([]string{"id", "name"}).Where("slug = ?", "").Order("id").Limit(batchSize).Find(&packages); err != nil {
+			return count, errors.NewInternalServerError(err)
+		}
+
+		for _, pkg := range packages {
+			slug, err := generateSlug(facades.Orm().Query(), pkg.Name, pkg.ID)
+			if err != nil {
+				return count, err
+			}
+			if _, err := facades.Orm().Query().Exec("UPDATE packages SET slug = ? WHERE id = ?", slug, pkg.ID); err != nil {
+				return count, errors.NewInternalServerError(err)
+			}
+
+			count++
+		}
+
+		if len(packages) < batchSize {
+			return count, nil
+		}
 	}
+}
 
-	if err := facades.Orm().Query().Model(pkg).Association("Tags").Replace(existTags); err != nil {
+// CreatePackage creates the package with a slug generated from the name on the given query, so it can be a part of a
+// transaction, the user is added to its maintainers as the owner. ErrDuplicatePackageName is returned if the user has
+// another package with the same name, ErrDuplicatePackageSlug if a concurrent package took the slug.
+func (r *Package) CreatePackage(query contractsorm.Query, pkg *Package) error {
+	pkg.ID = pkg.GetID()
+	slug, err := generateSlug(query, pkg.Name, pkg.ID)
+	if err != nil {
+		return err
+	}
+
+	pkg.Slug = slug
+	if err := query.Create(pkg); err != nil {
+		return packageSaveError(err)
+	}
+
+	owner := &Maintainer{PackageID: pkg.ID, UserID: pkg.UserID, Role: MaintainerRoleOwner}
+	owner.ID = owner.GetID()
+	if err := query.Create(owner); err != nil {
 		return errors.NewInternalServerError(err)
 	}
 
 	return nil
 }
 
+func (r *Package) DeletePackage(pkg *Package) error {
+	if _, err := facades.Orm().Query().Delete(pkg); err != nil {
+		return errors.NewInternalServerError(err)
+	}
+
+	return refreshPackageTagCounts(facades.Orm().Query(), pkg)
+}
+
+// GetDeletedPackageByID gets a soft deleted package, the ID of the returned package is 0 if it doesn't exist or
+// isn't deleted.
+func (r *Package) GetDeletedPackageByID(id string) (*Package, error) {
+	var packageModel Package
+
+	if err := facades.Orm().Query().WithTrashed().Where("id", id).Where("deleted_at IS NOT NULL").First(&packageModel); err != nil {
+		return nil, errors.NewInternalServerError(err)
+	}
+
+	return &packageModel, nil
+}
+
 func (r *Package) GetPackageByID(id string, fields []string) (*Package, error) {
 	var packageModel Package
 
@@ -99,40 +311,381 @@ func (r *Package) GetPackageByID(id string, fields []string) (*Package, error) {
 	return &packageModel, nil
 }
 
+// GetPackageBySlug gets the package by its current slug or by a previous slug of the renamed package, the ID of the
+// returned package is 0 if it doesn't exist. The callers compare the slug of the package to redirect to the current one.
+func (r *Package) GetPackageBySlug(slug string, fields []string) (*Package, error) {
+	var packageModel Package
+	if err := facades.Orm().Query().Where("slug = ?", slug).Select([]string{"id"}).First(&packageModel); err != nil {
+		return nil, errors.NewInternalServerError(err)
+	}
+
+	if packageModel.ID == 0 {
+		var packageSlug PackageSlug
+		if err := facades.Orm().Query().Where("slug = ?", slug).First(&packageSlug); err != nil {
+			return nil, errors.NewInternalServerError(err)
+		}
+		if packageSlug.ID == 0 {
+			return &packageModel, nil
+		}
+
+		packageModel.ID = packageSlug.PackageID
+	}
+
+	return r.GetPackageByID(cast.ToString(packageModel.ID), fields)
+}
+
+// GetPackagesToSync gets the packages linked to a GitHub repository, the ones that haven't been synced for the longest
+// time come first. Only the links that github.ParseRepository accepts are matched, the others would always fail.
+func (r *Package) GetPackagesToSync(limit int) ([]*Package, error) {
+	var packages []*Package
+	if err := facades.Orm().Query().Where("link ~ ?", github.RepositoryPattern).Order("synced_at ASC NULLS FIRST").Limit(limit).Find(&packages); err != nil {
+		return nil, errors.NewInternalServerError(err)
+	}
+
+	return packages, nil
+}
+
+// IncrementViewCounts adds the given view counts (keyed by package ID) to the packages in one statement.
+func (r *Package) IncrementViewCounts(counts map[string]int) error {
+	if len(counts) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(counts))
+	for id := range counts {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	values := make([]string, 0, len(ids))
+	args := make([]any, 0, len(ids)*2)
+	for _, id := range ids {
+		values = append(values, "(?::bigint, ?::bigint)")
+		args = append(args, id, counts[id])
+	}
+
+	sql := fmt.Sprintf("UPDATE packages SET view_count = packages.view_count + views.count FROM (VALUES %s) AS views(id, count) WHERE packages.id = views.id", strings.Join(values, ", "))
+	if _, err := facades.Orm().Query().Exec(sql, args...); err != nil {
+		return errors.NewInternalServerError(err)
+	}
+
+	return nil
+}
+
+// IsVisibleTo reports whether the package can be read by the given user, unapproved or private packages are only
+// visible to their owner and maintainers. The members of the organization publishing the package can read it as well,
+// but the memberships live in the user service, so they are checked by the services. It must be kept in line with
+// VisiblePackages.
+func (r *Package) IsVisibleTo(userID string) (bool, error) {
+	if r.IsApproved == PackageApproved && r.IsPublic == PackagePublic {
+		return true, nil
+	}
+	if userID == "" {
+		return false, nil
+	}
+	if cast.ToString(r.UserID) == userID {
+		return true, nil
+	}
+
+	maintainer, err := NewMaintainer().GetMaintainer(r.ID, cast.ToUint64(userID))
+	if err != nil {
+		return false, err
+	}
+
+	return maintainer.ID != 0, nil
+}
+
+// PurgeDeletedPackages permanently deletes the packages that were soft deleted before the given time, together with
+// their tag relations, favorites, reviews, comments, previous slugs, maintainers and releases in a transaction, and
+// returns the purged packages with their covers, so the stored cover files can be deleted once they are purged.
+func (r *Package) PurgeDeletedPackages(deletedBefore time.Time) ([]*Package, error) {
+	var packages []*Package
+	if err := facades.Orm().Query().WithTrashed().Select([]string{"id", "cover", "cover_thumbnail"}).Where("deleted_at < ?", deletedBefore).Find(&packages); err != nil {
+		return nil, errors.NewInternalServerError(err)
+	}
+
+	if len(packages) == 0 {
+		return nil, nil
+	}
+
+	packageIDs := make([]any, len(packages))
+	for i, pkg := range packages {
+		packageIDs[i] = pkg.ID
+	}
+
+	if err := facades.Orm().Transaction(func(tx contractsorm.Transaction) error {
+		if _, err := tx.Exec("DELETE FROM package_tags WHERE package_id IN ?", packageIDs); err != nil {
+			return errors.NewInternalServerError(err)
+		}
+		if _, err := tx.Exec("DELETE FROM package_favorites WHERE package_id IN ?", packageIDs); err != nil {
+			return errors.NewInternalServerError(err)
+		}
+		if _, err := tx.Exec("DELETE FROM package_reviews WHERE package_id IN ?", packageIDs); err != nil {
+			return errors.NewInternalServerError(err)
+		}
+		if _, err := tx.Exec("DELETE FROM package_comments WHERE package_id IN ?", packageIDs); err != nil {
+			return errors.NewInternalServerError(err)
+		}
+		if _, err := tx.Exec("DELETE FROM package_slugs WHERE package_id IN ?", packageIDs); err != nil {
+			return errors.NewInternalServerError(err)
+		}
+		if _, err := tx.Exec("DELETE FROM package_maintainers WHERE package_id IN ?", packageIDs); err != nil {
+			return errors.NewInternalServerError(err)
+		}
+		if _, err := tx.Exec("DELETE FROM package_versions WHERE package_id IN ?", packageIDs); err != nil {
+			return errors.NewInternalServerError(err)
+		}
+		if _, err := tx.WhereIn("id", packageIDs).ForceDelete(&Package{}); err != nil {
+			return errors.NewInternalServerError(err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return packages, nil
+}
+
+// RatingAverage gets the average rating of the reviews rounded to two decimals, it's 0 if there are no reviews.
+func (r *Package) RatingAverage() float64 {
+	if r.RatingCount == 0 {
+		return 0
+	}
+
+	return math.Round(float64(r.RatingSum)/float64(r.RatingCount)*100) / 100
+}
+
+func (r *Package) RestorePackage(pkg *Package) error {
+	if _, err := facades.Orm().Query().WithTrashed().Model(pkg).Update("deleted_at", nil); err != nil {
+		// Another package of the user may have taken the name after the package was deleted.
+		if isUniqueConstraintViolation(err, packagesUserIDNameUnique) {
+			return ErrDuplicatePackageName
+		}
+
+		return errors.NewInternalServerError(err)
+	}
+
+	pkg.SoftDeletes = orm.SoftDeletes{}
+
+	return refreshPackageTagCounts(facades.Orm().Query(), pkg)
+}
+
+// SortValue gets the value of the ordered column of the sort field, it's saved in the cursors of the package listings.
+func (r *Package) SortValue(sort string) any {
+	switch sort {
+	case PackageSortViews:
+		return int64(r.ViewCount)
+	case PackageSortFavorites:
+		return int64(r.FavoriteCount)
+	case PackageSortUpdated:
+		if r.LastUpdatedAt.IsZero() {
+			return r.CreatedAt.ToDateTimeMicroString()
+		}
+
+		return r.LastUpdatedAt.ToDateTimeMicroString()
+	case PackageSortName:
+		return r.Name
+	default:
+		return nil
+	}
+}
+
 func (r *Package) ToProto() *protopackage.Package {
+	var pendingOwnerID, organizationID string
+	if r.PendingOwnerID != 0 {
+		pendingOwnerID = cast.ToString(r.PendingOwnerID)
+	}
+	if r.OrganizationID != 0 {
+		organizationID = cast.ToString(r.OrganizationID)
+	}
+
 	tagsProto := make([]*protopackage.Tag, 0)
 	for _, tag := range r.Tags {
 		tagsProto = append(tagsProto, tag.ToProto())
 	}
 
-	var isPublic bool
-	if r.IsPublic == 2 {
-		isPublic = true
+	return &protopackage.Package{
+		Id:              cast.ToString(r.ID),
+		UserId:          cast.ToString(r.UserID),
+		Name:            r.Name,
+		Slug:            r.Slug,
+		Summary:         r.Summary,
+		Description:     r.Description,
+		DescriptionHtml: r.DescriptionHTML,
+		Link:            r.Link,
+		Version:         r.Version,
+		ViewCount:       r.ViewCount,
+		LastUpdatedAt:   r.LastUpdatedAt.ToString(),
+		CreatedAt:       r.CreatedAt.ToString(),
+		UpdatedAt:       r.UpdatedAt.ToString(),
+		Tags:            tagsProto,
+		User:            r.User,
+		IsPublic:        r.IsPublic == PackagePublic,
+		Cover:           r.Cover,
+		CoverThumbnail:  r.CoverThumbnail,
+		IsApproved:      r.IsApproved == PackageApproved,
+		RejectReason:    r.RejectReason,
+		Stars:           r.Stars,
+		License:         r.License,
+		Readme:          r.Readme,
+		SyncedAt:        r.SyncedAt.ToString(),
+		Highlight:       r.Highlight,
+		FavoriteCount:   r.FavoriteCount,
+		IsFavorited:     r.IsFavorited,
+		RatingAverage:   r.RatingAverage(),
+		RatingCount:     r.RatingCount,
+		CommentCount:    r.CommentCount,
+		Revision:        r.Revision,
+		PendingOwnerId:  pendingOwnerID,
+		OrganizationId:  organizationID,
 	}
+}
 
-	return &protopackage.Package{
-		Id:            cast.ToString(r.ID),
-		UserId:        cast.ToString(r.UserID),
-		Name:          r.Name,
-		Summary:       r.Summary,
-		Description:   r.Description,
-		Link:          r.Link,
-		Version:       r.Version,
-		ViewCount:     r.ViewCount,
-		LastUpdatedAt: r.LastUpdatedAt.ToString(),
-		CreatedAt:     r.CreatedAt.ToString(),
-		UpdatedAt:     r.UpdatedAt.ToString(),
-		Tags:          tagsProto,
-		User:          r.User,
-		IsPublic:      isPublic,
-		Cover:         r.Cover,
-	}
-}
-
-func (r *Package) UpdatePackage(pkg *Package) error {
-	if err := facades.Orm().Query().Save(pkg); err != nil {
+// UpdatePackage saves the package on the given query, so it can be a part of a transaction. The counts of its tags
+// are recounted since the approval or the visibility may have changed.
+//
+// The revision of the package is increased only if it's still the one the package was read with, otherwise
+// ErrPackageChanged is returned and nothing is saved. The increased row is locked until the transaction ends, so the
+// concurrent updates in transactions can't interleave with the save.
+func (r *Package) UpdatePackage(query contractsorm.Query, pkg *Package) error {
+	result, err := query.Exec("UPDATE packages SET revision = revision + 1 WHERE id = ? AND revision = ?", pkg.ID, pkg.Revision)
+	if err != nil {
+		return errors.NewInternalServerError(err)
+	}
+	if result.RowsAffected == 0 {
+		return ErrPackageChanged
+	}
+	pkg.Revision++
+
+	if err := query.Save(pkg); err != nil {
+		return packageSaveError(err)
+	}
+
+	return refreshPackageTagCounts(query, pkg)
+}
+
+// UpdateSlug regenerates the slug of the renamed package on the given query, the previous slug is kept in package_slugs
+// to redirect to the package. The slug is saved together with the package by UpdatePackage.
+func (r *Package) UpdateSlug(query contractsorm.Query, pkg *Package) error {
+	slug, err := generateSlug(query, pkg.Name, pkg.ID)
+	if err != nil {
+		return err
+	}
+	if slug == pkg.Slug {
+		return nil
+	}
+
+	if pkg.Slug != "" {
+		now := carbon.DateTime{Carbon: carbon.Now()}
+		if _, err := query.Exec("INSERT INTO package_slugs (id, package_id, slug, created_at, updated_at) VALUES (?, ?, ?, ?, ?) ON CONFLICT (slug) DO NOTHING",
+			(&PackageSlug{}).GetID(), pkg.ID, pkg.Slug, now, now); err != nil {
+			return errors.NewInternalServerError(err)
+		}
+	}
+
+	// The package may get a previous slug back, it's the current slug again.
+	if _, err := query.Exec("DELETE FROM package_slugs WHERE package_id = ? AND slug = ?", pkg.ID, slug); err != nil {
+		return errors.NewInternalServerError(err)
+	}
+
+	pkg.Slug = slug
+
+	return nil
+}
+
+// UpdateSyncedAt only sets the synced time of the package to now, so a package that fails to sync is moved to the end
+// of the sync queue instead of being retried first forever.
+func (r *Package) UpdateSyncedAt(pkg *Package) error {
+	pkg.SyncedAt = carbon.DateTime{Carbon: carbon.Now()}
+	if _, err := facades.Orm().Query().Exec("UPDATE packages SET synced_at = ? WHERE id = ?", pkg.SyncedAt, pkg.ID); err != nil {
+		return errors.NewInternalServerError(err)
+	}
+
+	return nil
+}
+
+// UpdateSyncedPackage only saves the columns synced from GitHub. The sync isn't an edit of the package, so the
+// revision is kept and the maintainers editing the package at the same time don't get a conflict.
+func (r *Package) UpdateSyncedPackage(pkg *Package) error {
+	if _, err := facades.Orm().Query().Exec("UPDATE packages SET stars = ?, license = ?, readme = ?, version = ?, last_updated_at = ?, synced_at = ? WHERE id = ?",
+		pkg.Stars, pkg.License, pkg.Readme, pkg.Version, pkg.LastUpdatedAt, pkg.SyncedAt, pkg.ID); err != nil {
+		return errors.NewInternalServerError(err)
+	}
+
+	return nil
+}
+
+// UpdateVersion only saves the version and the last updated time of the package on the given query, they follow the
+// releases. Publishing a release isn't an edit of the package, so the revision is kept.
+func (r *Package) UpdateVersion(query contractsorm.Query, pkg *Package) error {
+	if _, err := query.Exec("UPDATE packages SET version = ?, last_updated_at = ? WHERE id = ?", pkg.Version, pkg.LastUpdatedAt, pkg.ID); err != nil {
 		return errors.NewInternalServerError(err)
 	}
 
 	return nil
 }
+
+// packageSaveError converts the unique violations of saving a package to ErrDuplicatePackageName and
+// ErrDuplicatePackageSlug, the other errors are internal errors.
+func packageSaveError(err error) error {
+	if isUniqueConstraintViolation(err, packagesUserIDNameUnique) {
+		return ErrDuplicatePackageName
+	}
+	if isUniqueConstraintViolation(err, packagesSlugUnique) {
+		return ErrDuplicatePackageSlug
+	}
+
+	return errors.NewInternalServerError(err)
+}
+
+// refreshPackageTagCounts recounts the packages of the tags of the package.
+func refreshPackageTagCounts(query contractsorm.Query, pkg *Package) error {
+	return refreshTagPackageCounts(query, "id IN (SELECT tag_id FROM package_tags WHERE package_id = ?)", pkg.ID)
+}
+
+// VisiblePackages is a query scope that filters the packages the given user can read, anonymous users (empty userID)
+// can only read approved public packages, the signed-in users can read the packages they own or maintain, and the
+// packages of the organizations they are members of, as well. The memberships live in the user service, so they are
+// passed as the IDs of the organizations. The columns are qualified, so it can be used in joins with the packages
+// table.
+func VisiblePackages(userID string, organizationIDs []uint64) func(contractsorm.Query) contractsorm.Query {
+	return func(query contractsorm.Query) contractsorm.Query {
+		if userID == "" {
+			return query.Where("packages.is_approved = ? AND packages.is_public = ?", PackageApproved, PackagePublic)
+		}
+
+		if len(organizationIDs) > 0 {
+			return query.Where("((packages.is_approved = ? AND packages.is_public = ?) OR packages.user_id = ? OR EXISTS (SELECT 1 FROM package_maintainers WHERE package_maintainers.package_id = packages.id AND package_maintainers.user_id = ?) OR packages.organization_id IN ?)",
+				PackageApproved, PackagePublic, userID, userID, organizationIDs)
+		}
+
+		return query.Where("((packages.is_approved = ? AND packages.is_public = ?) OR packages.user_id = ? OR EXISTS (SELECT 1 FROM package_maintainers WHERE package_maintainers.package_id = packages.id AND package_maintainers.user_id = ?))",
+			PackageApproved, PackagePublic, userID, userID)
+	}
+}
+
+// isUniqueConstraintViolation reports whether the error is a unique violation of the given constraint or unique index
+// reported by Postgres.
+func isUniqueConstraintViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+
+	return stderrors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == constraint
+}
+
+// isUniqueViolation reports whether the error is a unique violation (SQLSTATE 23505) reported by the database driver.
+func isUniqueViolation(err error) bool {
+	var sqlStateErr interface{ SQLState() string }
+
+	return stderrors.As(err, &sqlStateErr) && sqlStateErr.SQLState() == "23505"
+}
+
+// NormalizeIsPublic converts the is_public value of requests to the value stored in the database, packages are
+// private unless they are marked as public explicitly.
+func NormalizeIsPublic(isPublic int32) int32 {
+	if isPublic == PackagePublic {
+		return PackagePublic
+	}
+
+	return PackagePrivate
+}
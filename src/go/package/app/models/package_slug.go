@@ -0,0 +1,65 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+
+	"market.goravel.dev/utils/errors"
+)
+
+// takenSlugsSQL gets the slugs starting with the base slug that are taken by the other packages, either as their
+// current slugs or as the previous slugs of the renamed packages.
+const takenSlugsSQL = `SELECT slug FROM packages WHERE (slug = ? OR slug LIKE ?) AND id <> ?
+UNION SELECT slug FROM package_slugs WHERE (slug = ? OR slug LIKE ?) AND package_id <> ?`
+
+// slugSeparatorRegexp matches the runs of characters that aren't allowed in slugs.
+var slugSeparatorRegexp = regexp.MustCompile(`[^a-z0-9]+`)
+
+// PackageSlug is a previous slug of a renamed package, the lookups by the slug are redirected to the package, so the
+// shared URLs keep working.
+type PackageSlug struct {
+	UUIDModel
+	PackageID uint64
+	Slug      string
+}
+
+func (r *PackageSlug) TableName() string {
+	return "package_slugs"
+}
+
+// Slugify converts the package name to the base of its slug: the name is lower-cased and the runs of other characters
+// than letters and digits are replaced by single hyphens, e.g. "goravel/Gin" is "goravel-gin".
+func Slugify(name string) string {
+	slug := strings.Trim(slugSeparatorRegexp.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if slug == "" {
+		return "package"
+	}
+
+	return slug
+}
+
+// generateSlug generates the slug of the package from the name, a number suffix is appended if the slug is taken by
+// another package, e.g. "goravel-gin-2".
+func generateSlug(query contractsorm.Query, name string, packageID uint64) (string, error) {
+	base := Slugify(name)
+
+	var slugs []string
+	if err := query.Raw(takenSlugsSQL, base, base+"-%", packageID, base, base+"-%", packageID).Scan(&slugs); err != nil {
+		return "", errors.NewInternalServerError(err)
+	}
+
+	taken := make(map[string]bool, len(slugs))
+	for _, slug := range slugs {
+		taken[slug] = true
+	}
+
+	slug := base
+	for i := 2; taken[slug]; i++ {
+		slug = fmt.Sprintf("%s-%d", base, i)
+	}
+
+	return slug, nil
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	utilserrors "market.goravel.dev/utils/errors"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name   string
+		expect string
+	}{
+		{name: "gin", expect: "gin"},
+		{name: "goravel/Gin", expect: "goravel-gin"},
+		{name: " Goravel  Fiber ", expect: "goravel-fiber"},
+		{name: "--Vue.js--", expect: "vue-js"},
+		{name: "数据库", expect: "package"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expect, Slugify(test.name), test.name)
+	}
+}
+
+func TestGenerateSlug(t *testing.T) {
+	var mockOrmQuery *mocksorm.Query
+
+	beforeEach := func() {
+		mockFactory := testingmock.Factory()
+		mockOrmQuery = mockFactory.OrmQuery()
+		mockFactory.Log()
+	}
+
+	tests := []struct {
+		name        string
+		setup       func()
+		expectSlug  string
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				expectTakenSlugs(mockOrmQuery, "goravel-gin")
+			},
+			expectSlug: "goravel-gin",
+		},
+		{
+			name: "Happy path - slug is taken",
+			setup: func() {
+				expectTakenSlugs(mockOrmQuery, "goravel-gin", "goravel-gin", "goravel-gin-2", "goravel-gin-docs")
+			},
+			expectSlug: "goravel-gin-3",
+		},
+		{
+			name: "Sad path - Scan returns error",
+			setup: func() {
+				mockOrmQuery.On("Raw", takenSlugsSQL, "goravel-gin", "goravel-gin-%", uint64(1), "goravel-gin", "goravel-gin-%", uint64(1)).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Scan", mock.Anything).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			beforeEach()
+			test.setup()
+
+			slug, err := generateSlug(mockOrmQuery, "goravel/Gin", 1)
+			assert.Equal(t, test.expectSlug, slug)
+			assert.Equal(t, test.expectedErr, err)
+
+			mockOrmQuery.AssertExpectations(t)
+		})
+	}
+}
+
+// expectTakenSlugs expects the query of the slugs taken by the other packages for the base slug.
+func expectTakenSlugs(mockOrmQuery *mocksorm.Query, base string, taken ...string) {
+	mockOrmQuery.On("Raw", takenSlugsSQL, base, base+"-%", mock.Anything, base, base+"-%", mock.Anything).Return(mockOrmQuery).Once()
+	mockOrmQuery.On("Scan", mock.AnythingOfType("*[]string")).Run(func(args mock.Arguments) {
+		*args.Get(0).(*[]string) = taken
+	}).Return(nil).Once()
+}
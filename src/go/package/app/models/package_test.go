@@ -2,16 +2,21 @@ package models
 
 import (
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
 	"github.com/goravel/framework/contracts/http"
 	"github.com/goravel/framework/database/orm"
 	mocksorm "github.com/goravel/framework/mocks/database/orm"
 	"github.com/goravel/framework/support/carbon"
 	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"market.goravel.dev/package/app/github"
 	protopackage "market.goravel.dev/proto/package"
 	utilserrors "market.goravel.dev/utils/errors"
 )
@@ -31,7 +36,6 @@ func (s *PackageSuite) SetupTest() {
 
 func (s *PackageSuite) TestAttachTags() {
 	var (
-		tags   = []string{"goravel"}
 		name   = "goravel"
 		url    = "https://goravel.dev"
 		userID = uint64(1)
@@ -54,30 +58,39 @@ func (s *PackageSuite) TestAttachTags() {
 				UserID: userID,
 			},
 		}
-		mockOrm            *mocksorm.Orm
 		mockOrmQuery       *mocksorm.Query
 		mockOrmAssociation *mocksorm.Association
 	)
 
 	beforeSetup := func() {
 		mockFactory := testingmock.Factory()
-		mockOrm = mockFactory.Orm()
 		mockOrmQuery = mockFactory.OrmQuery()
 		mockFactory.Log()
 		mockOrmAssociation = mockFactory.OrmAssociation()
-		mockOrm.On("Query").Return(mockOrmQuery).Once()
+	}
+
+	findAliases := func(names []any, aliases []*TagAlias) {
+		mockOrmQuery.On("WhereIn", "name", names).Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.TagAlias")).
+			Return(nil).
+			Run(func(args mock.Arguments) {
+				*args.Get(0).(*[]*TagAlias) = aliases
+			}).Once()
 	}
 
 	tests := []struct {
 		name        string
+		tags        []string
+		visible     bool
 		setup       func()
 		expectedErr error
 	}{
 		{
 			name: "Happy path - Create package with tags",
+			tags: []string{"goravel"},
 			setup: func() {
-				mockOrm.On("Query").Return(mockOrmQuery).Once()
-				mockOrmQuery.On("WhereIn", "name", []any{"goravel"}).Return(mockOrmQuery).Once()
+				findAliases([]any{"goravel"}, nil)
+				mockOrmQuery.On("Where", "LOWER(name) IN ?", []any{"goravel"}).Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Tag")).
 					Return(nil).
 					Run(func(args mock.Arguments) {
@@ -97,10 +110,91 @@ func (s *PackageSuite) TestAttachTags() {
 			},
 		},
 		{
-			name: "Sad path - Tags association error",
+			name: "Happy path - aliases resolve to the canonical tag",
+			tags: []string{"ORM", "Database ORM", "orm "},
+			setup: func() {
+				findAliases([]any{"orm", "database-orm"}, []*TagAlias{{TagID: 2, Name: "orm"}, {TagID: 2, Name: "database-orm"}})
+				mockOrmQuery.On("Where", "LOWER(name) IN ? OR id IN ?", []any{"orm", "database-orm"}, []any{uint64(2), uint64(2)}).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Tag")).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						tagPtr := args.Get(0).(*[]*Tag)
+						*tagPtr = []*Tag{{UUIDModel: UUIDModel{ID: 2}, Name: "Orm"}}
+					}).Once()
+
+				mockOrmQuery.On("Model", mock.AnythingOfType("*models.Package")).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Association", "Tags").Return(mockOrmAssociation).Once()
+				mockOrmAssociation.On("Replace", mock.MatchedBy(func(tags []*Tag) bool {
+					return len(tags) == 1 && tags[0].ID == 2 && tags[0].Name == "Orm"
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name: "Happy path - names are normalized and matched case-insensitively",
+			tags: []string{"Cache", "cache ", "Redis Cache"},
+			setup: func() {
+				findAliases([]any{"cache", "redis-cache"}, nil)
+				mockOrmQuery.On("Where", "LOWER(name) IN ?", []any{"cache", "redis-cache"}).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Tag")).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						*args.Get(0).(*[]*Tag) = []*Tag{{UUIDModel: UUIDModel{ID: 3}, Name: "Cache"}}
+					}).Once()
+				mockOrmQuery.On("Create", mock.MatchedBy(func(tags []*Tag) bool {
+					return len(tags) == 1 && tags[0].Name == "redis-cache"
+				})).Return(nil).Once()
+
+				mockOrmQuery.On("Model", mock.AnythingOfType("*models.Package")).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Association", "Tags").Return(mockOrmAssociation).Once()
+				mockOrmAssociation.On("Replace", mock.MatchedBy(func(tags []*Tag) bool {
+					return len(tags) == 2 && tags[0].ID == 3 && tags[1].Name == "redis-cache"
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name:    "Happy path - the tags of an approved public package are recounted",
+			tags:    []string{"goravel"},
+			visible: true,
+			setup: func() {
+				findAliases([]any{"goravel"}, nil)
+				mockOrmQuery.On("Where", "LOWER(name) IN ?", []any{"goravel"}).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Tag")).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						*args.Get(0).(*[]*Tag) = []*Tag{{UUIDModel: UUIDModel{ID: 1}, Name: "goravel"}}
+					}).Once()
+
+				mockOrmQuery.On("Table", "package_tags").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Where", "package_id = ?", uint64(1)).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Pluck", "tag_id", mock.AnythingOfType("*[]interface {}")).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						*args.Get(1).(*[]any) = []any{uint64(5)}
+					}).Once()
+				mockOrmQuery.On("Model", mock.AnythingOfType("*models.Package")).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Association", "Tags").Return(mockOrmAssociation).Once()
+				mockOrmAssociation.On("Replace", mock.MatchedBy(func(tags []*Tag) bool {
+					return len(tags) == 1 && tags[0].ID == 1
+				})).Return(nil).Once()
+				mockOrmQuery.On("Exec", fmt.Sprintf(refreshTagPackageCountsSQL, "id IN ?"), PackageApproved, PackagePublic, []any{uint64(5), uint64(1)}).
+					Return(&contractsorm.Result{}, nil).Once()
+			},
+		},
+		{
+			name: "Sad path - Find alias error",
+			tags: []string{"goravel"},
 			setup: func() {
-				mockOrm.On("Query").Return(mockOrmQuery).Once()
 				mockOrmQuery.On("WhereIn", "name", []any{"goravel"}).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.TagAlias")).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+		{
+			name: "Sad path - Tags association error",
+			tags: []string{"goravel"},
+			setup: func() {
+				findAliases([]any{"goravel"}, nil)
+				mockOrmQuery.On("Where", "LOWER(name) IN ?", []any{"goravel"}).Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Tag")).
 					Return(nil).
 					Run(func(args mock.Arguments) {
@@ -122,8 +216,10 @@ func (s *PackageSuite) TestAttachTags() {
 		},
 		{
 			name: "Sad path - Find tag error",
+			tags: []string{"goravel"},
 			setup: func() {
-				mockOrmQuery.On("WhereIn", "name", []any{"goravel"}).Return(mockOrmQuery).Once()
+				findAliases([]any{"goravel"}, nil)
+				mockOrmQuery.On("Where", "LOWER(name) IN ?", []any{"goravel"}).Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Tag")).
 					Return(errors.New("error")).Once()
 			},
@@ -131,19 +227,16 @@ func (s *PackageSuite) TestAttachTags() {
 		},
 		{
 			name: "Happy path - Tags do not exist",
+			tags: []string{"goravel"},
 			setup: func() {
-				mockOrm.On("Query").Return(mockOrmQuery).Twice()
-				mockOrmQuery.On("WhereIn", "name", []any{"goravel"}).Return(mockOrmQuery).Once()
+				findAliases([]any{"goravel"}, nil)
+				mockOrmQuery.On("Where", "LOWER(name) IN ?", []any{"goravel"}).Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Tag")).
 					Return(nil).Once()
 
 				mockOrmQuery.On("Create", mock.MatchedBy(func(tags []*Tag) bool {
-					return len(tags) == 1 && tags[0].Name == "goravel" && tags[0].UserID == userID && tags[0].IsShow == 1
-				})).Return(nil).
-					Run(func(args mock.Arguments) {
-						tagPtr := args.Get(0).([]*Tag)
-						tagPtr[0] = &Tag{UUIDModel: UUIDModel{ID: 1}, Name: "goravel", UserID: userID}
-					}).Once()
+					return len(tags) == 1 && tags[0].Name == "goravel" && tags[0].UserID == userID && tags[0].IsShow == TagShown
+				})).Return(nil).Once()
 
 				mockOrmQuery.On("Model", mock.AnythingOfType("*models.Package")).Return(mockOrmQuery).
 					Run(func(args mock.Arguments) {
@@ -152,20 +245,21 @@ func (s *PackageSuite) TestAttachTags() {
 					}).Once()
 				mockOrmQuery.On("Association", "Tags").Return(mockOrmAssociation).Once()
 				mockOrmAssociation.On("Replace", mock.MatchedBy(func(tags []*Tag) bool {
-					return len(tags) == 1 && tags[0].Name == "goravel"
+					return len(tags) == 1 && tags[0].Name == "goravel" && tags[0].ID != 0
 				})).Return(nil).Once()
 			},
 		},
 		{
 			name: "Sad path - Create Tag error",
+			tags: []string{"goravel"},
 			setup: func() {
-				mockOrm.On("Query").Return(mockOrmQuery).Once()
-				mockOrmQuery.On("WhereIn", "name", []any{"goravel"}).Return(mockOrmQuery).Once()
+				findAliases([]any{"goravel"}, nil)
+				mockOrmQuery.On("Where", "LOWER(name) IN ?", []any{"goravel"}).Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Tag")).
 					Return(nil).Once()
 
 				mockOrmQuery.On("Create", mock.MatchedBy(func(tags []*Tag) bool {
-					return len(tags) == 1 && tags[0].Name == "goravel" && tags[0].UserID == userID && tags[0].IsShow == 1
+					return len(tags) == 1 && tags[0].Name == "goravel" && tags[0].UserID == userID && tags[0].IsShow == TagShown
 				})).Return(errors.New("error")).Once()
 			},
 			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
@@ -176,65 +270,86 @@ func (s *PackageSuite) TestAttachTags() {
 		s.Run(test.name, func() {
 			beforeSetup()
 			test.setup()
-			err := s.pkg.AttachTags(&pkg, tags)
+			attachPackage := pkg
+			if test.visible {
+				attachPackage.IsApproved = PackageApproved
+				attachPackage.IsPublic = PackagePublic
+			}
+			err := s.pkg.AttachTags(mockOrmQuery, &attachPackage, test.tags)
 			s.Equal(test.expectedErr, err)
 
-			mockOrm.AssertExpectations(s.T())
 			mockOrmQuery.AssertExpectations(s.T())
 			mockOrmAssociation.AssertExpectations(s.T())
 		})
 	}
 }
 
-func (s *PackageSuite) TestGetPackageByID() {
+func (s *PackageSuite) TestCreatePackage() {
 	var (
-		id     = "1"
-		fields = []string{"name"}
-
-		mockOrm      *mocksorm.Orm
 		mockOrmQuery *mocksorm.Query
-		pack         Package
 	)
 
 	beforeSetup := func() {
 		mockFactory := testingmock.Factory()
-		mockOrm = mockFactory.Orm()
 		mockOrmQuery = mockFactory.OrmQuery()
 		mockFactory.Log()
-		mockOrm.On("Query").Return(mockOrmQuery).Once()
-		mockOrmQuery.On("Where", "id", id).Return(mockOrmQuery).Once()
-		mockOrmQuery.On("Select", []string{"name"}).Return(mockOrmQuery).Once()
 	}
 
 	tests := []struct {
-		name          string
-		setup         func()
-		expectPackage *Package
-		expectedErr   error
+		name        string
+		setup       func()
+		expectedErr error
 	}{
 		{
 			name: "Happy path",
 			setup: func() {
-				mockOrmQuery.On("With", "Tags", mock.Anything).Return(mockOrmQuery).Once()
-				mockOrmQuery.On("First", &pack).Run(func(args mock.Arguments) {
-					pack := args.Get(0).(*Package)
-					pack.ID = 1
-					pack.Name = "Goravel"
-				}).Return(nil).Once()
+				expectTakenSlugs(mockOrmQuery, "goravel", "goravel")
+				mockOrmQuery.On("Create", mock.MatchedBy(func(pkg *Package) bool {
+					return pkg.ID != 0 && pkg.Name == "goravel" && pkg.Slug == "goravel-2"
+				})).Return(nil).Once()
+				mockOrmQuery.On("Create", mock.MatchedBy(func(maintainer *Maintainer) bool {
+					return maintainer.ID > 0 && maintainer.PackageID != 0 && maintainer.UserID == 1 && maintainer.Role == MaintainerRoleOwner
+				})).Return(nil).Once()
 			},
-			expectPackage: &Package{
-				UUIDModel: UUIDModel{
-					ID: 1,
-				},
-				Name: "Goravel",
+		},
+		{
+			name: "Sad path - get taken slugs error",
+			setup: func() {
+				mockOrmQuery.On("Raw", takenSlugsSQL, "goravel", "goravel-%", mock.Anything, "goravel", "goravel-%", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Scan", mock.Anything).Return(errors.New("error")).Once()
 			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
 		},
 		{
-			name: "Sad path - get package error",
+			name: "Sad path - create package error",
 			setup: func() {
-				var pack Package
-				mockOrmQuery.On("With", "Tags", mock.Anything).Return(mockOrmQuery).Once()
-				mockOrmQuery.On("First", &pack).Return(errors.New("error")).Once()
+				expectTakenSlugs(mockOrmQuery, "goravel")
+				mockOrmQuery.On("Create", mock.AnythingOfType("*models.Package")).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+		{
+			name: "Sad path - package name is duplicate",
+			setup: func() {
+				expectTakenSlugs(mockOrmQuery, "goravel")
+				mockOrmQuery.On("Create", mock.AnythingOfType("*models.Package")).Return(&pgconn.PgError{Code: "23505", ConstraintName: "packages_user_id_name_unique"}).Once()
+			},
+			expectedErr: ErrDuplicatePackageName,
+		},
+		{
+			name: "Sad path - slug is taken by a concurrent package",
+			setup: func() {
+				expectTakenSlugs(mockOrmQuery, "goravel")
+				mockOrmQuery.On("Create", mock.AnythingOfType("*models.Package")).Return(&pgconn.PgError{Code: "23505", ConstraintName: "packages_slug_unique"}).Once()
+			},
+			expectedErr: ErrDuplicatePackageSlug,
+		},
+		{
+			name: "Sad path - create maintainer error",
+			setup: func() {
+				expectTakenSlugs(mockOrmQuery, "goravel")
+				mockOrmQuery.On("Create", mock.AnythingOfType("*models.Package")).Return(nil).Once()
+				mockOrmQuery.On("Create", mock.AnythingOfType("*models.Maintainer")).Return(errors.New("error")).Once()
 			},
 			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
 		},
@@ -244,83 +359,76 @@ func (s *PackageSuite) TestGetPackageByID() {
 		s.Run(test.name, func() {
 			beforeSetup()
 			test.setup()
-			returnedPackage, err := s.pkg.GetPackageByID(id, fields)
 
-			if test.expectedErr != nil {
-				s.Nil(returnedPackage)
-				s.Equal(test.expectedErr, err)
-			} else {
-				s.NotNil(returnedPackage)
-				s.Nil(err)
-				s.Equal(test.expectPackage, returnedPackage)
-			}
+			s.Equal(test.expectedErr, s.pkg.CreatePackage(mockOrmQuery, &Package{Name: "goravel", UserID: 1}))
 
-			mockOrm.AssertExpectations(s.T())
 			mockOrmQuery.AssertExpectations(s.T())
 		})
 	}
 }
 
-func (s *PackageSuite) TestToProto() {
+func (s *PackageSuite) TestDeletePackage() {
 	var (
-		id            = 1
-		userID        = 1
-		name          = "Goravel"
-		summary       = "summary"
-		description   = "description"
-		link          = "https://goravel.dev"
-		version       = "v1.0.0"
-		lastUpdatedAt = carbon.DateTime{}
-		createAt      = carbon.DateTime{}
-		updatedAt     = carbon.DateTime{}
+		pkg = Package{UUIDModel: UUIDModel{ID: 1}}
+
+		mockOrm      *mocksorm.Orm
+		mockOrmQuery *mocksorm.Query
 	)
 
-	pack := Package{
-		UUIDModel: UUIDModel{
-			ID: uint64(id),
-			Timestamps: orm.Timestamps{
-				CreatedAt: createAt,
-				UpdatedAt: updatedAt,
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockOrm = mockFactory.Orm()
+		mockOrmQuery = mockFactory.OrmQuery()
+		mockFactory.Log()
+		mockOrm.On("Query").Return(mockOrmQuery).Once()
+	}
+
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				mockOrmQuery.On("Delete", &pkg).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+				mockOrm.On("Query").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Exec", fmt.Sprintf(refreshTagPackageCountsSQL, "id IN (SELECT tag_id FROM package_tags WHERE package_id = ?)"), PackageApproved, PackagePublic, uint64(1)).Return(&contractsorm.Result{}, nil).Once()
 			},
 		},
-		UserID:        uint64(userID),
-		Name:          name,
-		Summary:       summary,
-		Description:   description,
-		Link:          link,
-		Version:       version,
-		LastUpdatedAt: lastUpdatedAt,
+		{
+			name: "Sad path - refresh tag package counts error",
+			setup: func() {
+				mockOrmQuery.On("Delete", &pkg).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+				mockOrm.On("Query").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Exec", fmt.Sprintf(refreshTagPackageCountsSQL, "id IN (SELECT tag_id FROM package_tags WHERE package_id = ?)"), PackageApproved, PackagePublic, uint64(1)).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+		{
+			name: "Sad path - delete package error",
+			setup: func() {
+				mockOrmQuery.On("Delete", &pkg).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
 	}
 
-	s.Equal(&protopackage.Package{
-		Id:            "1",
-		UserId:        "1",
-		Name:          name,
-		Summary:       summary,
-		Description:   description,
-		Link:          link,
-		Version:       version,
-		LastUpdatedAt: lastUpdatedAt.ToString(),
-		CreatedAt:     createAt.ToString(),
-		UpdatedAt:     updatedAt.ToString(),
-		Tags:          []*protopackage.Tag{},
-	}, pack.ToProto())
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			beforeSetup()
+			test.setup()
+			s.Equal(test.expectedErr, s.pkg.DeletePackage(&pkg))
+
+			mockOrm.AssertExpectations(s.T())
+			mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
 }
 
-func (s *PackageSuite) TestUpdatePackage() {
+func (s *PackageSuite) TestGetDeletedPackageByID() {
 	var (
-		name   = "goravel"
-		url    = "https://goravel.dev"
-		userID = uint64(1)
-
-		pkg = Package{
-			UUIDModel: UUIDModel{
-				ID: 1,
-			},
-			Name:   name,
-			UserID: userID,
-			Link:   url,
-		}
+		id = "1"
 
 		mockOrm      *mocksorm.Orm
 		mockOrmQuery *mocksorm.Query
@@ -332,28 +440,31 @@ func (s *PackageSuite) TestUpdatePackage() {
 		mockOrmQuery = mockFactory.OrmQuery()
 		mockFactory.Log()
 		mockOrm.On("Query").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("WithTrashed").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Where", "id", id).Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Where", "deleted_at IS NOT NULL").Return(mockOrmQuery).Once()
 	}
 
 	tests := []struct {
-		name        string
-		setup       func()
-		expectedErr error
+		name          string
+		setup         func()
+		expectPackage *Package
+		expectedErr   error
 	}{
 		{
 			name: "Happy path",
 			setup: func() {
-				mockOrmQuery.On("Save", mock.MatchedBy(func(pkg *Package) bool {
-					return pkg.Name == name && pkg.Link == url && pkg.UserID == userID
-				})).Return(nil).Once()
+				mockOrmQuery.On("First", mock.AnythingOfType("*models.Package")).Run(func(args mock.Arguments) {
+					pack := args.Get(0).(*Package)
+					pack.ID = 1
+				}).Return(nil).Once()
 			},
-			expectedErr: nil,
+			expectPackage: &Package{UUIDModel: UUIDModel{ID: 1}},
 		},
 		{
-			name: "Sad path - save package error",
+			name: "Sad path - get package error",
 			setup: func() {
-				mockOrmQuery.On("Save", mock.MatchedBy(func(pkg *Package) bool {
-					return pkg.Name == name && pkg.Link == url && pkg.UserID == userID
-				})).Return(errors.New("error")).Once()
+				mockOrmQuery.On("First", mock.AnythingOfType("*models.Package")).Return(errors.New("error")).Once()
 			},
 			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
 		},
@@ -363,8 +474,9 @@ func (s *PackageSuite) TestUpdatePackage() {
 		s.Run(test.name, func() {
 			beforeSetup()
 			test.setup()
-			err := s.pkg.UpdatePackage(&pkg)
+			returnedPackage, err := s.pkg.GetDeletedPackageByID(id)
 
+			s.Equal(test.expectPackage, returnedPackage)
 			s.Equal(test.expectedErr, err)
 
 			mockOrm.AssertExpectations(s.T())
@@ -372,3 +484,1184 @@ func (s *PackageSuite) TestUpdatePackage() {
 		})
 	}
 }
+
+func (s *PackageSuite) TestGetPackageByID() {
+	var (
+		id     = "1"
+		fields = []string{"name"}
+
+		mockOrm      *mocksorm.Orm
+		mockOrmQuery *mocksorm.Query
+		pack         Package
+	)
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockOrm = mockFactory.Orm()
+		mockOrmQuery = mockFactory.OrmQuery()
+		mockFactory.Log()
+		mockOrm.On("Query").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Where", "id", id).Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Select", []string{"name"}).Return(mockOrmQuery).Once()
+	}
+
+	tests := []struct {
+		name          string
+		setup         func()
+		expectPackage *Package
+		expectedErr   error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				mockOrmQuery.On("With", "Tags", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("First", &pack).Run(func(args mock.Arguments) {
+					pack := args.Get(0).(*Package)
+					pack.ID = 1
+					pack.Name = "Goravel"
+				}).Return(nil).Once()
+			},
+			expectPackage: &Package{
+				UUIDModel: UUIDModel{
+					ID: 1,
+				},
+				Name: "Goravel",
+			},
+		},
+		{
+			name: "Sad path - get package error",
+			setup: func() {
+				var pack Package
+				mockOrmQuery.On("With", "Tags", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("First", &pack).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			beforeSetup()
+			test.setup()
+			returnedPackage, err := s.pkg.GetPackageByID(id, fields)
+
+			if test.expectedErr != nil {
+				s.Nil(returnedPackage)
+				s.Equal(test.expectedErr, err)
+			} else {
+				s.NotNil(returnedPackage)
+				s.Nil(err)
+				s.Equal(test.expectPackage, returnedPackage)
+			}
+
+			mockOrm.AssertExpectations(s.T())
+			mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageSuite) TestGetPackageBySlug() {
+	var (
+		slug   = "goravel-gin"
+		fields = []string{"name"}
+
+		mockOrm      *mocksorm.Orm
+		mockOrmQuery *mocksorm.Query
+	)
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockOrm = mockFactory.Orm()
+		mockOrmQuery = mockFactory.OrmQuery()
+		mockFactory.Log()
+	}
+
+	expectGetPackageByID := func() {
+		mockOrm.On("Query").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Where", "id", "1").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("With", "Tags", mock.Anything).Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Select", fields).Return(mockOrmQuery).Once()
+		mockOrmQuery.On("First", mock.AnythingOfType("*models.Package")).Run(func(args mock.Arguments) {
+			pkg := args.Get(0).(*Package)
+			pkg.ID = 1
+			pkg.Name = "goravel/gin"
+			pkg.Slug = slug
+		}).Return(nil).Once()
+	}
+
+	tests := []struct {
+		name          string
+		setup         func()
+		expectPackage *Package
+		expectedErr   error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				mockOrm.On("Query").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Where", "slug = ?", slug).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Select", []string{"id"}).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("First", mock.AnythingOfType("*models.Package")).Run(func(args mock.Arguments) {
+					args.Get(0).(*Package).ID = 1
+				}).Return(nil).Once()
+				expectGetPackageByID()
+			},
+			expectPackage: &Package{UUIDModel: UUIDModel{ID: 1}, Name: "goravel/gin", Slug: slug},
+		},
+		{
+			name: "Happy path - previous slug",
+			setup: func() {
+				mockOrm.On("Query").Return(mockOrmQuery).Twice()
+				mockOrmQuery.On("Where", "slug = ?", slug).Return(mockOrmQuery).Twice()
+				mockOrmQuery.On("Select", []string{"id"}).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("First", mock.AnythingOfType("*models.Package")).Return(nil).Once()
+				mockOrmQuery.On("First", mock.AnythingOfType("*models.PackageSlug")).Run(func(args mock.Arguments) {
+					packageSlug := args.Get(0).(*PackageSlug)
+					packageSlug.ID = 2
+					packageSlug.PackageID = 1
+				}).Return(nil).Once()
+				expectGetPackageByID()
+			},
+			expectPackage: &Package{UUIDModel: UUIDModel{ID: 1}, Name: "goravel/gin", Slug: slug},
+		},
+		{
+			name: "Happy path - slug does not exist",
+			setup: func() {
+				mockOrm.On("Query").Return(mockOrmQuery).Twice()
+				mockOrmQuery.On("Where", "slug = ?", slug).Return(mockOrmQuery).Twice()
+				mockOrmQuery.On("Select", []string{"id"}).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("First", mock.AnythingOfType("*models.Package")).Return(nil).Once()
+				mockOrmQuery.On("First", mock.AnythingOfType("*models.PackageSlug")).Return(nil).Once()
+			},
+			expectPackage: &Package{},
+		},
+		{
+			name: "Sad path - get package error",
+			setup: func() {
+				mockOrm.On("Query").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Where", "slug = ?", slug).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Select", []string{"id"}).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("First", mock.AnythingOfType("*models.Package")).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+		{
+			name: "Sad path - get previous slug error",
+			setup: func() {
+				mockOrm.On("Query").Return(mockOrmQuery).Twice()
+				mockOrmQuery.On("Where", "slug = ?", slug).Return(mockOrmQuery).Twice()
+				mockOrmQuery.On("Select", []string{"id"}).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("First", mock.AnythingOfType("*models.Package")).Return(nil).Once()
+				mockOrmQuery.On("First", mock.AnythingOfType("*models.PackageSlug")).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			beforeSetup()
+			test.setup()
+			pkg, err := s.pkg.GetPackageBySlug(slug, fields)
+
+			s.Equal(test.expectPackage, pkg)
+			s.Equal(test.expectedErr, err)
+
+			mockOrm.AssertExpectations(s.T())
+			mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageSuite) TestGetPackagesToSync() {
+	var (
+		mockOrm      *mocksorm.Orm
+		mockOrmQuery *mocksorm.Query
+	)
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockOrm = mockFactory.Orm()
+		mockOrmQuery = mockFactory.OrmQuery()
+		mockFactory.Log()
+		mockOrm.On("Query").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Where", "link ~ ?", github.RepositoryPattern).Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Order", "synced_at ASC NULLS FIRST").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Limit", 10).Return(mockOrmQuery).Once()
+	}
+
+	tests := []struct {
+		name           string
+		setup          func()
+		expectPackages []*Package
+		expectedErr    error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Package")).Run(func(args mock.Arguments) {
+					packages := args.Get(0).(*[]*Package)
+					*packages = []*Package{{UUIDModel: UUIDModel{ID: 1}}}
+				}).Return(nil).Once()
+			},
+			expectPackages: []*Package{{UUIDModel: UUIDModel{ID: 1}}},
+		},
+		{
+			name: "Sad path - Find returns error",
+			setup: func() {
+				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Package")).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			beforeSetup()
+			test.setup()
+
+			packages, err := s.pkg.GetPackagesToSync(10)
+			s.Equal(test.expectPackages, packages)
+			s.Equal(test.expectedErr, err)
+
+			mockOrm.AssertExpectations(s.T())
+			mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageSuite) TestIncrementViewCounts() {
+	var (
+		sql = "UPDATE packages SET view_count = packages.view_count + views.count FROM (VALUES (?::bigint, ?::bigint), (?::bigint, ?::bigint)) AS views(id, count) WHERE packages.id = views.id"
+
+		mockOrm      *mocksorm.Orm
+		mockOrmQuery *mocksorm.Query
+	)
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockOrm = mockFactory.Orm()
+		mockOrmQuery = mockFactory.OrmQuery()
+		mockFactory.Log()
+	}
+
+	tests := []struct {
+		name        string
+		counts      map[string]int
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name:   "Happy path",
+			counts: map[string]int{"2": 1, "1": 3},
+			setup: func() {
+				mockOrm.On("Query").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Exec", sql, "1", 3, "2", 1).Return(&contractsorm.Result{RowsAffected: 2}, nil).Once()
+			},
+		},
+		{
+			name:   "Happy path - no views",
+			counts: map[string]int{},
+			setup:  func() {},
+		},
+		{
+			name:   "Sad path - exec error",
+			counts: map[string]int{"2": 1, "1": 3},
+			setup: func() {
+				mockOrm.On("Query").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Exec", sql, "1", 3, "2", 1).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			beforeSetup()
+			test.setup()
+			s.Equal(test.expectedErr, s.pkg.IncrementViewCounts(test.counts))
+
+			mockOrm.AssertExpectations(s.T())
+			mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageSuite) TestPurgeDeletedPackages() {
+	var (
+		deletedBefore = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		packages      = []*Package{
+			{UUIDModel: UUIDModel{ID: 1}, Cover: "https://goravel.dev/storage/covers/1/cover.png", CoverThumbnail: "https://goravel.dev/storage/covers/1/cover_thumb.png"},
+			{UUIDModel: UUIDModel{ID: 2}},
+		}
+		packageIDs = []any{uint64(1), uint64(2)}
+		// The statements purging the relations of the packages, in the order they are executed.
+		deleteSQLs = []string{
+			"DELETE FROM package_tags WHERE package_id IN ?",
+			"DELETE FROM package_favorites WHERE package_id IN ?",
+			"DELETE FROM package_reviews WHERE package_id IN ?",
+			"DELETE FROM package_comments WHERE package_id IN ?",
+			"DELETE FROM package_slugs WHERE package_id IN ?",
+			"DELETE FROM package_maintainers WHERE package_id IN ?",
+			"DELETE FROM package_versions WHERE package_id IN ?",
+		}
+
+		mockOrm         *mocksorm.Orm
+		mockOrmQuery    *mocksorm.Query
+		mockTransaction *mocksorm.Transaction
+	)
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockOrm = mockFactory.Orm()
+		mockOrmQuery = mockFactory.OrmQuery()
+		mockTransaction = &mocksorm.Transaction{}
+		mockFactory.Log()
+		mockOrm.On("Query").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("WithTrashed").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Select", []string{"id", "cover", "cover_thumbnail"}).Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Where", "deleted_at < ?", deletedBefore).Return(mockOrmQuery).Once()
+	}
+
+	findPackages := func(packages []*Package) func(args mock.Arguments) {
+		return func(args mock.Arguments) {
+			*args.Get(0).(*[]*Package) = packages
+		}
+	}
+
+	// expectTransaction expects the purge transaction, the first succeeded statements are executed before the failed
+	// one, and the packages are force deleted if all of them succeed.
+	expectTransaction := func(succeeded int, err error) {
+		mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Package")).Run(findPackages(packages)).Return(nil).Once()
+		mockOrm.On("Transaction", mock.Anything).Return(func(txFunc func(contractsorm.Transaction) error) error {
+			return txFunc(mockTransaction)
+		}).Once()
+		for _, sql := range deleteSQLs[:succeeded] {
+			mockTransaction.On("Exec", sql, packageIDs).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+		}
+		if succeeded < len(deleteSQLs) {
+			mockTransaction.On("Exec", deleteSQLs[succeeded], packageIDs).Return(nil, err).Once()
+
+			return
+		}
+
+		mockTransaction.On("WhereIn", "id", packageIDs).Return(mockTransaction).Once()
+		if err != nil {
+			mockTransaction.On("ForceDelete", &Package{}).Return(nil, err).Once()
+		} else {
+			mockTransaction.On("ForceDelete", &Package{}).Return(&contractsorm.Result{RowsAffected: 2}, nil).Once()
+		}
+	}
+
+	tests := []struct {
+		name           string
+		setup          func()
+		expectPackages []*Package
+		expectedErr    error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				expectTransaction(len(deleteSQLs), nil)
+			},
+			expectPackages: packages,
+		},
+		{
+			name: "Happy path - no packages to purge",
+			setup: func() {
+				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Package")).Return(nil).Once()
+			},
+		},
+		{
+			name: "Sad path - Find returns error",
+			setup: func() {
+				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Package")).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+		{
+			name: "Sad path - delete package tags error",
+			setup: func() {
+				expectTransaction(0, errors.New("error"))
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+		{
+			name: "Sad path - delete package comments error",
+			setup: func() {
+				expectTransaction(3, errors.New("error"))
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+		{
+			name: "Sad path - delete package releases error",
+			setup: func() {
+				expectTransaction(6, errors.New("error"))
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+		{
+			name: "Sad path - force delete error",
+			setup: func() {
+				expectTransaction(len(deleteSQLs), errors.New("error"))
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			beforeSetup()
+			test.setup()
+			purgedPackages, err := s.pkg.PurgeDeletedPackages(deletedBefore)
+
+			s.Equal(test.expectPackages, purgedPackages)
+			s.Equal(test.expectedErr, err)
+
+			mockOrm.AssertExpectations(s.T())
+			mockOrmQuery.AssertExpectations(s.T())
+			mockTransaction.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageSuite) TestUpdateSyncedAt() {
+	var (
+		mockOrm      *mocksorm.Orm
+		mockOrmQuery *mocksorm.Query
+	)
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockOrm = mockFactory.Orm()
+		mockOrmQuery = mockFactory.OrmQuery()
+		mockFactory.Log()
+		mockOrm.On("Query").Return(mockOrmQuery).Once()
+	}
+
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				mockOrmQuery.On("Exec", "UPDATE packages SET synced_at = ? WHERE id = ?", mock.AnythingOfType("carbon.DateTime"), uint64(1)).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+			},
+		},
+		{
+			name: "Sad path - Exec returns error",
+			setup: func() {
+				mockOrmQuery.On("Exec", "UPDATE packages SET synced_at = ? WHERE id = ?", mock.AnythingOfType("carbon.DateTime"), uint64(1)).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			beforeSetup()
+			test.setup()
+
+			pkg := &Package{UUIDModel: UUIDModel{ID: 1}}
+			s.Equal(test.expectedErr, s.pkg.UpdateSyncedAt(pkg))
+			s.False(pkg.SyncedAt.IsZero())
+
+			mockOrm.AssertExpectations(s.T())
+			mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageSuite) TestUpdateSyncedPackage() {
+	var (
+		mockOrm      *mocksorm.Orm
+		mockOrmQuery *mocksorm.Query
+
+		pkg = &Package{
+			UUIDModel:     UUIDModel{ID: 1},
+			Stars:         2000,
+			License:       "MIT",
+			Readme:        "# Goravel",
+			Version:       "v1.10.0",
+			LastUpdatedAt: carbon.DateTime{Carbon: carbon.Now()},
+			SyncedAt:      carbon.DateTime{Carbon: carbon.Now()},
+			Revision:      3,
+		}
+	)
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockOrm = mockFactory.Orm()
+		mockOrmQuery = mockFactory.OrmQuery()
+		mockFactory.Log()
+		mockOrm.On("Query").Return(mockOrmQuery).Once()
+	}
+
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path - revision is kept",
+			setup: func() {
+				mockOrmQuery.On("Exec", "UPDATE packages SET stars = ?, license = ?, readme = ?, version = ?, last_updated_at = ?, synced_at = ? WHERE id = ?",
+					pkg.Stars, pkg.License, pkg.Readme, pkg.Version, pkg.LastUpdatedAt, pkg.SyncedAt, pkg.ID).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+			},
+		},
+		{
+			name: "Sad path - Exec returns error",
+			setup: func() {
+				mockOrmQuery.On("Exec", "UPDATE packages SET stars = ?, license = ?, readme = ?, version = ?, last_updated_at = ?, synced_at = ? WHERE id = ?",
+					pkg.Stars, pkg.License, pkg.Readme, pkg.Version, pkg.LastUpdatedAt, pkg.SyncedAt, pkg.ID).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			beforeSetup()
+			test.setup()
+
+			s.Equal(test.expectedErr, s.pkg.UpdateSyncedPackage(pkg))
+			s.Equal(uint64(3), pkg.Revision)
+
+			mockOrm.AssertExpectations(s.T())
+			mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageSuite) TestUpdateVersion() {
+	var (
+		mockOrmQuery *mocksorm.Query
+
+		pkg = &Package{
+			UUIDModel:     UUIDModel{ID: 1},
+			Version:       "v1.1.0",
+			LastUpdatedAt: carbon.DateTime{Carbon: carbon.Now()},
+			Revision:      3,
+		}
+	)
+
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path - revision is kept",
+			setup: func() {
+				mockOrmQuery.On("Exec", "UPDATE packages SET version = ?, last_updated_at = ? WHERE id = ?", pkg.Version, pkg.LastUpdatedAt, pkg.ID).
+					Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+			},
+		},
+		{
+			name: "Sad path - Exec returns error",
+			setup: func() {
+				mockOrmQuery.On("Exec", "UPDATE packages SET version = ?, last_updated_at = ? WHERE id = ?", pkg.Version, pkg.LastUpdatedAt, pkg.ID).
+					Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			mockFactory := testingmock.Factory()
+			mockFactory.Log()
+			mockOrmQuery = mockFactory.OrmQuery()
+			test.setup()
+
+			s.Equal(test.expectedErr, s.pkg.UpdateVersion(mockOrmQuery, pkg))
+			s.Equal(uint64(3), pkg.Revision)
+
+			mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageSuite) TestRestorePackage() {
+	var (
+		mockOrm      *mocksorm.Orm
+		mockOrmQuery *mocksorm.Query
+	)
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockOrm = mockFactory.Orm()
+		mockOrmQuery = mockFactory.OrmQuery()
+		mockFactory.Log()
+		mockOrm.On("Query").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("WithTrashed").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Model", mock.AnythingOfType("*models.Package")).Return(mockOrmQuery).Once()
+	}
+
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				mockOrmQuery.On("Update", "deleted_at", nil).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+				mockOrm.On("Query").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Exec", fmt.Sprintf(refreshTagPackageCountsSQL, "id IN (SELECT tag_id FROM package_tags WHERE package_id = ?)"), PackageApproved, PackagePublic, uint64(1)).Return(&contractsorm.Result{}, nil).Once()
+			},
+		},
+		{
+			name: "Sad path - update package error",
+			setup: func() {
+				mockOrmQuery.On("Update", "deleted_at", nil).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+		{
+			name: "Sad path - package name is taken",
+			setup: func() {
+				mockOrmQuery.On("Update", "deleted_at", nil).Return(nil, &pgconn.PgError{Code: "23505", ConstraintName: "packages_user_id_name_unique"}).Once()
+			},
+			expectedErr: ErrDuplicatePackageName,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			beforeSetup()
+			test.setup()
+
+			pkg := Package{UUIDModel: UUIDModel{ID: 1}}
+			pkg.DeletedAt.Valid = true
+			err := s.pkg.RestorePackage(&pkg)
+
+			s.Equal(test.expectedErr, err)
+			s.Equal(test.expectedErr != nil, pkg.DeletedAt.Valid)
+
+			mockOrm.AssertExpectations(s.T())
+			mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageSuite) TestIsUniqueViolation() {
+	s.True(isUniqueViolation(sqlStateError("23505")))
+	s.True(isUniqueViolation(fmt.Errorf("save package: %w", sqlStateError("23505"))))
+	s.False(isUniqueViolation(sqlStateError("23503")))
+	s.False(isUniqueViolation(errors.New("error")))
+}
+
+func (s *PackageSuite) TestIsUniqueConstraintViolation() {
+	s.True(isUniqueConstraintViolation(&pgconn.PgError{Code: "23505", ConstraintName: "packages_slug_unique"}, "packages_slug_unique"))
+	s.True(isUniqueConstraintViolation(fmt.Errorf("save package: %w", &pgconn.PgError{Code: "23505", ConstraintName: "packages_slug_unique"}), "packages_slug_unique"))
+	s.False(isUniqueConstraintViolation(&pgconn.PgError{Code: "23505", ConstraintName: "packages_user_id_name_unique"}, "packages_slug_unique"))
+	s.False(isUniqueConstraintViolation(&pgconn.PgError{Code: "23503", ConstraintName: "packages_slug_unique"}, "packages_slug_unique"))
+	s.False(isUniqueConstraintViolation(sqlStateError("23505"), "packages_slug_unique"))
+}
+
+func (s *PackageSuite) TestToProto() {
+	var (
+		id            = 1
+		userID        = 1
+		name          = "Goravel"
+		summary       = "summary"
+		description   = "description"
+		link          = "https://goravel.dev"
+		version       = "v1.0.0"
+		lastUpdatedAt = carbon.DateTime{}
+		createAt      = carbon.DateTime{}
+		updatedAt     = carbon.DateTime{}
+	)
+
+	pack := Package{
+		UUIDModel: UUIDModel{
+			ID: uint64(id),
+			Timestamps: orm.Timestamps{
+				CreatedAt: createAt,
+				UpdatedAt: updatedAt,
+			},
+		},
+		UserID:        uint64(userID),
+		Name:          name,
+		Summary:       summary,
+		Description:   description,
+		Link:          link,
+		Version:       version,
+		LastUpdatedAt: lastUpdatedAt,
+	}
+
+	s.Equal(&protopackage.Package{
+		Id:            "1",
+		UserId:        "1",
+		Name:          name,
+		Summary:       summary,
+		Description:   description,
+		Link:          link,
+		Version:       version,
+		LastUpdatedAt: lastUpdatedAt.ToString(),
+		CreatedAt:     createAt.ToString(),
+		UpdatedAt:     updatedAt.ToString(),
+		Tags:          []*protopackage.Tag{},
+	}, pack.ToProto())
+}
+
+func (s *PackageSuite) TestToProtoIsPublic() {
+	tests := []struct {
+		name     string
+		isPublic int32
+		expect   bool
+	}{
+		{
+			name:     "Public",
+			isPublic: PackagePublic,
+			expect:   true,
+		},
+		{
+			name:     "Private",
+			isPublic: PackagePrivate,
+			expect:   false,
+		},
+		{
+			name:     "Unset",
+			isPublic: 0,
+			expect:   false,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			pack := Package{IsPublic: test.isPublic}
+			s.Equal(test.expect, pack.ToProto().GetIsPublic())
+		})
+	}
+}
+
+func (s *PackageSuite) TestUpdatePackage() {
+	var (
+		name   = "goravel"
+		url    = "https://goravel.dev"
+		userID = uint64(1)
+
+		pkg = Package{
+			UUIDModel: UUIDModel{
+				ID: 1,
+			},
+			Name:     name,
+			UserID:   userID,
+			Link:     url,
+			Revision: 2,
+		}
+
+		mockOrmQuery *mocksorm.Query
+	)
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockOrmQuery = mockFactory.OrmQuery()
+		mockFactory.Log()
+	}
+
+	tests := []struct {
+		name           string
+		setup          func()
+		expectedErr    error
+		expectRevision uint64
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				mockOrmQuery.On("Exec", "UPDATE packages SET revision = revision + 1 WHERE id = ? AND revision = ?", uint64(1), uint64(2)).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+				mockOrmQuery.On("Save", mock.MatchedBy(func(pkg *Package) bool {
+					return pkg.Name == name && pkg.Link == url && pkg.UserID == userID && pkg.Revision == 3
+				})).Return(nil).Once()
+				mockOrmQuery.On("Exec", fmt.Sprintf(refreshTagPackageCountsSQL, "id IN (SELECT tag_id FROM package_tags WHERE package_id = ?)"), PackageApproved, PackagePublic, uint64(1)).Return(&contractsorm.Result{}, nil).Once()
+			},
+			expectedErr:    nil,
+			expectRevision: 3,
+		},
+		{
+			name: "Sad path - package changed",
+			setup: func() {
+				mockOrmQuery.On("Exec", "UPDATE packages SET revision = revision + 1 WHERE id = ? AND revision = ?", uint64(1), uint64(2)).Return(&contractsorm.Result{RowsAffected: 0}, nil).Once()
+			},
+			expectedErr:    ErrPackageChanged,
+			expectRevision: 2,
+		},
+		{
+			name: "Sad path - save package error",
+			setup: func() {
+				mockOrmQuery.On("Exec", "UPDATE packages SET revision = revision + 1 WHERE id = ? AND revision = ?", uint64(1), uint64(2)).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+				mockOrmQuery.On("Save", mock.MatchedBy(func(pkg *Package) bool {
+					return pkg.Name == name && pkg.Link == url && pkg.UserID == userID
+				})).Return(errors.New("error")).Once()
+			},
+			expectedErr:    utilserrors.New(http.StatusInternalServerError, "error"),
+			expectRevision: 3,
+		},
+		{
+			name: "Sad path - package name is duplicate",
+			setup: func() {
+				mockOrmQuery.On("Exec", "UPDATE packages SET revision = revision + 1 WHERE id = ? AND revision = ?", uint64(1), uint64(2)).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+				mockOrmQuery.On("Save", mock.AnythingOfType("*models.Package")).Return(&pgconn.PgError{Code: "23505", ConstraintName: "packages_user_id_name_unique"}).Once()
+			},
+			expectedErr:    ErrDuplicatePackageName,
+			expectRevision: 3,
+		},
+		{
+			name: "Sad path - slug is taken by a concurrent package",
+			setup: func() {
+				mockOrmQuery.On("Exec", "UPDATE packages SET revision = revision + 1 WHERE id = ? AND revision = ?", uint64(1), uint64(2)).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+				mockOrmQuery.On("Save", mock.AnythingOfType("*models.Package")).Return(&pgconn.PgError{Code: "23505", ConstraintName: "packages_slug_unique"}).Once()
+			},
+			expectedErr:    ErrDuplicatePackageSlug,
+			expectRevision: 3,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			beforeSetup()
+			test.setup()
+			pkg := pkg
+			err := s.pkg.UpdatePackage(mockOrmQuery, &pkg)
+
+			s.Equal(test.expectedErr, err)
+			s.Equal(test.expectRevision, pkg.Revision)
+
+			mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageSuite) TestUpdateSlug() {
+	var mockOrmQuery *mocksorm.Query
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockOrmQuery = mockFactory.OrmQuery()
+		mockFactory.Log()
+	}
+
+	tests := []struct {
+		name        string
+		pkg         *Package
+		setup       func()
+		expectSlug  string
+		expectedErr error
+	}{
+		{
+			name: "Happy path - previous slug is kept",
+			pkg:  &Package{UUIDModel: UUIDModel{ID: 1}, Name: "goravel/fiber", Slug: "goravel-gin"},
+			setup: func() {
+				expectTakenSlugs(mockOrmQuery, "goravel-fiber")
+				mockOrmQuery.On("Exec", "INSERT INTO package_slugs (id, package_id, slug, created_at, updated_at) VALUES (?, ?, ?, ?, ?) ON CONFLICT (slug) DO NOTHING",
+					mock.Anything, uint64(1), "goravel-gin", mock.Anything, mock.Anything).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+				mockOrmQuery.On("Exec", "DELETE FROM package_slugs WHERE package_id = ? AND slug = ?", uint64(1), "goravel-fiber").Return(&contractsorm.Result{}, nil).Once()
+			},
+			expectSlug: "goravel-fiber",
+		},
+		{
+			name: "Happy path - package without slug",
+			pkg:  &Package{UUIDModel: UUIDModel{ID: 1}, Name: "goravel/gin"},
+			setup: func() {
+				expectTakenSlugs(mockOrmQuery, "goravel-gin")
+				mockOrmQuery.On("Exec", "DELETE FROM package_slugs WHERE package_id = ? AND slug = ?", uint64(1), "goravel-gin").Return(&contractsorm.Result{}, nil).Once()
+			},
+			expectSlug: "goravel-gin",
+		},
+		{
+			name: "Happy path - slug is not changed",
+			pkg:  &Package{UUIDModel: UUIDModel{ID: 1}, Name: "Goravel/Gin", Slug: "goravel-gin"},
+			setup: func() {
+				expectTakenSlugs(mockOrmQuery, "goravel-gin")
+			},
+			expectSlug: "goravel-gin",
+		},
+		{
+			name: "Sad path - keep previous slug error",
+			pkg:  &Package{UUIDModel: UUIDModel{ID: 1}, Name: "goravel/fiber", Slug: "goravel-gin"},
+			setup: func() {
+				expectTakenSlugs(mockOrmQuery, "goravel-fiber")
+				mockOrmQuery.On("Exec", mock.Anything, mock.Anything, uint64(1), "goravel-gin", mock.Anything, mock.Anything).Return(nil, errors.New("error")).Once()
+			},
+			expectSlug:  "goravel-gin",
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			beforeSetup()
+			test.setup()
+
+			s.Equal(test.expectedErr, s.pkg.UpdateSlug(mockOrmQuery, test.pkg))
+			s.Equal(test.expectSlug, test.pkg.Slug)
+
+			mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageSuite) TestBackfillSlugs() {
+	var (
+		mockOrm      *mocksorm.Orm
+		mockOrmQuery *mocksorm.Query
+	)
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockOrm = mockFactory.Orm()
+		mockOrmQuery = mockFactory.OrmQuery()
+		mockFactory.Log()
+		mockOrm.On("Query").Return(mockOrmQuery)
+	}
+
+	expectBatch := func(packages []*Package) {
+		mockOrmQuery.On("WithTrashed").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Select", []string{"id", "name"}).Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Where", "slug = ?", "").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Order", "id").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Limit", 2).Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Package")).Run(func(args mock.Arguments) {
+			*args.Get(0).(*[]*Package) = packages
+		}).Return(nil).Once()
+	}
+
+	tests := []struct {
+		name        string
+		setup       func()
+		expectCount int
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				expectBatch([]*Package{{UUIDModel: UUIDModel{ID: 1}, Name: "goravel/gin"}, {UUIDModel: UUIDModel{ID: 2}, Name: "goravel/fiber"}})
+				expectTakenSlugs(mockOrmQuery, "goravel-gin")
+				mockOrmQuery.On("Exec", "UPDATE packages SET slug = ? WHERE id = ?", "goravel-gin", uint64(1)).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+				expectTakenSlugs(mockOrmQuery, "goravel-fiber")
+				mockOrmQuery.On("Exec", "UPDATE packages SET slug = ? WHERE id = ?", "goravel-fiber", uint64(2)).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+				expectBatch([]*Package{{UUIDModel: UUIDModel{ID: 3}, Name: "goravel/gin"}})
+				expectTakenSlugs(mockOrmQuery, "goravel-gin", "goravel-gin")
+				mockOrmQuery.On("Exec", "UPDATE packages SET slug = ? WHERE id = ?", "goravel-gin-2", uint64(3)).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+			},
+			expectCount: 3,
+		},
+		{
+			name: "Happy path - no packages without slug",
+			setup: func() {
+				expectBatch(nil)
+			},
+		},
+		{
+			name: "Sad path - update slug error",
+			setup: func() {
+				expectBatch([]*Package{{UUIDModel: UUIDModel{ID: 1}, Name: "goravel/gin"}, {UUIDModel: UUIDModel{ID: 2}, Name: "goravel/fiber"}})
+				expectTakenSlugs(mockOrmQuery, "goravel-gin")
+				mockOrmQuery.On("Exec", "UPDATE packages SET slug = ? WHERE id = ?", "goravel-gin", uint64(1)).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+				expectTakenSlugs(mockOrmQuery, "goravel-fiber")
+				mockOrmQuery.On("Exec", "UPDATE packages SET slug = ? WHERE id = ?", "goravel-fiber", uint64(2)).Return(nil, errors.New("error")).Once()
+			},
+			expectCount: 1,
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			beforeSetup()
+			test.setup()
+			count, err := s.pkg.BackfillSlugs(2)
+
+			s.Equal(test.expectCount, count)
+			s.Equal(test.expectedErr, err)
+
+			mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageSuite) TestIsVisibleTo() {
+	var (
+		mockOrm      *mocksorm.Orm
+		mockOrmQuery *mocksorm.Query
+	)
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockOrm = mockFactory.Orm()
+		mockOrmQuery = mockFactory.OrmQuery()
+		mockFactory.Log()
+	}
+
+	tests := []struct {
+		name        string
+		pkg         Package
+		userID      string
+		setup       func()
+		expect      bool
+		expectedErr error
+	}{
+		{
+			name:   "Approved public package is visible to anonymous users",
+			pkg:    Package{UserID: 1, IsApproved: PackageApproved, IsPublic: PackagePublic},
+			expect: true,
+		},
+		{
+			name:   "Approved private package is invisible to anonymous users",
+			pkg:    Package{UserID: 1, IsApproved: PackageApproved, IsPublic: PackagePrivate},
+			expect: false,
+		},
+		{
+			name:   "Approved private package is invisible to other users",
+			pkg:    Package{UUIDModel: UUIDModel{ID: 3}, UserID: 1, IsApproved: PackageApproved, IsPublic: PackagePrivate},
+			userID: "2",
+			setup: func() {
+				mockOrm.On("Query").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Where", "package_id = ? AND user_id = ?", uint64(3), uint64(2)).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("First", mock.AnythingOfType("*models.Maintainer")).Return(nil).Once()
+			},
+			expect: false,
+		},
+		{
+			name:   "Approved private package is visible to the owner",
+			pkg:    Package{UserID: 1, IsApproved: PackageApproved, IsPublic: PackagePrivate},
+			userID: "1",
+			expect: true,
+		},
+		{
+			name:   "Approved private package is visible to the maintainers",
+			pkg:    Package{UUIDModel: UUIDModel{ID: 3}, UserID: 1, IsApproved: PackageApproved, IsPublic: PackagePrivate},
+			userID: "2",
+			setup: func() {
+				mockOrm.On("Query").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Where", "package_id = ? AND user_id = ?", uint64(3), uint64(2)).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("First", mock.AnythingOfType("*models.Maintainer")).Run(func(args mock.Arguments) {
+					args.Get(0).(*Maintainer).ID = 1
+				}).Return(nil).Once()
+			},
+			expect: true,
+		},
+		{
+			name:   "Unapproved package is invisible to anonymous users",
+			pkg:    Package{UserID: 1, IsApproved: PackageNotApproved},
+			expect: false,
+		},
+		{
+			name:   "Rejected package is invisible to other users",
+			pkg:    Package{UUIDModel: UUIDModel{ID: 3}, UserID: 1, IsApproved: PackageRejected},
+			userID: "2",
+			setup: func() {
+				mockOrm.On("Query").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Where", "package_id = ? AND user_id = ?", uint64(3), uint64(2)).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("First", mock.AnythingOfType("*models.Maintainer")).Return(nil).Once()
+			},
+			expect: false,
+		},
+		{
+			name:   "Unapproved package is visible to the owner",
+			pkg:    Package{UserID: 1, IsApproved: PackageNotApproved},
+			userID: "1",
+			expect: true,
+		},
+		{
+			name:   "Sad path - First returns error",
+			pkg:    Package{UUIDModel: UUIDModel{ID: 3}, UserID: 1, IsApproved: PackageNotApproved},
+			userID: "2",
+			setup: func() {
+				mockOrm.On("Query").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Where", "package_id = ? AND user_id = ?", uint64(3), uint64(2)).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("First", mock.AnythingOfType("*models.Maintainer")).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			beforeSetup()
+			if test.setup != nil {
+				test.setup()
+			}
+
+			visible, err := test.pkg.IsVisibleTo(test.userID)
+			s.Equal(test.expect, visible)
+			s.Equal(test.expectedErr, err)
+
+			mockOrm.AssertExpectations(s.T())
+			mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageSuite) TestSortValue() {
+	createdAt := carbon.NewDateTime(carbon.FromDateTime(2024, 1, 2, 3, 4, 5))
+	lastUpdatedAt := carbon.NewDateTime(carbon.FromDateTime(2024, 2, 3, 4, 5, 6))
+	pkg := &Package{Name: "goravel/gin", ViewCount: 10, FavoriteCount: 3, LastUpdatedAt: lastUpdatedAt}
+	pkg.CreatedAt = createdAt
+
+	s.Equal(int64(10), pkg.SortValue(PackageSortViews))
+	s.Equal(int64(3), pkg.SortValue(PackageSortFavorites))
+	s.Equal("2024-02-03 04:05:06", pkg.SortValue(PackageSortUpdated))
+	s.Equal("goravel/gin", pkg.SortValue(PackageSortName))
+	s.Nil(pkg.SortValue(PackageSortCreated))
+	s.Nil(pkg.SortValue(""))
+
+	// The packages that have never been updated are ordered by their creation time.
+	pkg.LastUpdatedAt = carbon.DateTime{}
+	s.Equal("2024-01-02 03:04:05", pkg.SortValue(PackageSortUpdated))
+}
+
+func (s *PackageSuite) TestRatingAverage() {
+	s.Equal(float64(0), (&Package{}).RatingAverage())
+	s.Equal(float64(4), (&Package{RatingCount: 2, RatingSum: 8}).RatingAverage())
+	s.Equal(4.33, (&Package{RatingCount: 3, RatingSum: 13}).RatingAverage())
+}
+
+func (s *PackageSuite) TestVisiblePackages() {
+	s.Run("Anonymous user", func() {
+		mockOrmQuery := &mocksorm.Query{}
+		mockOrmQuery.On("Where", "packages.is_approved = ? AND packages.is_public = ?", PackageApproved, PackagePublic).Return(mockOrmQuery).Once()
+
+		s.Equal(mockOrmQuery, VisiblePackages("", nil)(mockOrmQuery))
+		mockOrmQuery.AssertExpectations(s.T())
+	})
+
+	s.Run("Signed-in user", func() {
+		mockOrmQuery := &mocksorm.Query{}
+		mockOrmQuery.On("Where", "((packages.is_approved = ? AND packages.is_public = ?) OR packages.user_id = ? OR EXISTS (SELECT 1 FROM package_maintainers WHERE package_maintainers.package_id = packages.id AND package_maintainers.user_id = ?))",
+			PackageApproved, PackagePublic, "1", "1").Return(mockOrmQuery).Once()
+
+		s.Equal(mockOrmQuery, VisiblePackages("1", nil)(mockOrmQuery))
+		mockOrmQuery.AssertExpectations(s.T())
+	})
+
+	s.Run("Signed-in organization member", func() {
+		mockOrmQuery := &mocksorm.Query{}
+		mockOrmQuery.On("Where", "((packages.is_approved = ? AND packages.is_public = ?) OR packages.user_id = ? OR EXISTS (SELECT 1 FROM package_maintainers WHERE package_maintainers.package_id = packages.id AND package_maintainers.user_id = ?) OR packages.organization_id IN ?)",
+			PackageApproved, PackagePublic, "1", "1", []uint64{2, 3}).Return(mockOrmQuery).Once()
+
+		s.Equal(mockOrmQuery, VisiblePackages("1", []uint64{2, 3})(mockOrmQuery))
+		mockOrmQuery.AssertExpectations(s.T())
+	})
+}
+
+func (s *PackageSuite) TestNormalizeIsPublic() {
+	s.Equal(PackagePublic, NormalizeIsPublic(PackagePublic))
+	s.Equal(PackagePrivate, NormalizeIsPublic(PackagePrivate))
+	s.Equal(PackagePrivate, NormalizeIsPublic(0))
+}
+
+// sqlStateError is a database error carrying the SQLSTATE code like the errors of the postgres driver.
+type sqlStateError string
+
+func (r sqlStateError) Error() string {
+	return "sqlstate " + string(r)
+}
+
+func (r sqlStateError) SQLState() string {
+	return string(r)
+}
@@ -0,0 +1,142 @@
+package models
+
+import (
+	stderrors "errors"
+	"sort"
+	"strings"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/support/carbon"
+	"github.com/spf13/cast"
+	"golang.org/x/mod/semver"
+
+	protopackage "market.goravel.dev/proto/package"
+	"market.goravel.dev/utils/errors"
+)
+
+// ErrDuplicateRelease is returned when the package has a release of the version already.
+var ErrDuplicateRelease = stderrors.New("duplicate release")
+
+type ReleaseInterface interface {
+	CreateRelease(query contractsorm.Query, release *Release) error
+	GetRelease(packageID, version string) (*Release, error)
+	GetReleases(packageID string) ([]*Release, error)
+}
+
+// Release is a version of a package, it's stored in the package_versions table.
+type Release struct {
+	UUIDModel
+	PackageID uint64
+	UserID    uint64
+	Version   string
+	Changelog string
+	// Comma separated, e.g. "v1.13,v1.14.3".
+	FrameworkVersions string
+	PublishedAt       carbon.DateTime
+}
+
+func NewRelease() *Release {
+	return &Release{}
+}
+
+func (r *Release) TableName() string {
+	return "package_versions"
+}
+
+// CreateRelease creates the release on the given query, so it can be a part of a transaction. ErrDuplicateRelease is
+// returned if the package has a release of the version already.
+func (r *Release) CreateRelease(query contractsorm.Query, release *Release) error {
+	release.ID = release.GetID()
+	if err := query.Create(release); err != nil {
+		if isUniqueViolation(err) {
+			return ErrDuplicateRelease
+		}
+
+		return errors.NewInternalServerError(err)
+	}
+
+	return nil
+}
+
+// GetRelease gets a release of the package, the ID of the returned release is 0 if it doesn't exist.
+func (r *Release) GetRelease(packageID, version string) (*Release, error) {
+	var release Release
+	if err := facades.Orm().Query().Where("package_id = ? AND version = ?", packageID, version).First(&release); err != nil {
+		return nil, errors.NewInternalServerError(err)
+	}
+
+	return &release, nil
+}
+
+// GetReleases gets all the releases of the package, sorted by version in descending order.
+func (r *Release) GetReleases(packageID string) ([]*Release, error) {
+	var releases []*Release
+	if err := facades.Orm().Query().Where("package_id = ?", packageID).Find(&releases); err != nil {
+		return nil, errors.NewInternalServerError(err)
+	}
+
+	SortReleases(releases)
+
+	return releases, nil
+}
+
+// GetFrameworkVersions gets the supported Goravel versions.
+func (r *Release) GetFrameworkVersions() []string {
+	if r.FrameworkVersions == "" {
+		return []string{}
+	}
+
+	return strings.Split(r.FrameworkVersions, ",")
+}
+
+// SupportsFramework reports whether the release supports the given Goravel version, a minor version (v1.14) in the
+// release supports all the patch versions of it.
+func (r *Release) SupportsFramework(version string) bool {
+	version = NormalizeVersion(version)
+	if !semver.IsValid(version) {
+		return false
+	}
+
+	for _, frameworkVersion := range r.GetFrameworkVersions() {
+		if strings.Count(frameworkVersion, ".") < 2 {
+			if semver.MajorMinor(frameworkVersion) == semver.MajorMinor(version) {
+				return true
+			}
+		} else if semver.Compare(frameworkVersion, version) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Release) ToProto() *protopackage.Release {
+	return &protopackage.Release{
+		Id:                cast.ToString(r.ID),
+		PackageId:         cast.ToString(r.PackageID),
+		UserId:            cast.ToString(r.UserID),
+		Version:           r.Version,
+		Changelog:         r.Changelog,
+		FrameworkVersions: r.GetFrameworkVersions(),
+		PublishedAt:       r.PublishedAt.ToString(),
+		CreatedAt:         r.CreatedAt.ToString(),
+		UpdatedAt:         r.UpdatedAt.ToString(),
+	}
+}
+
+// NormalizeVersion adds the "v" prefix to the version if it's missing, e.g. 1.0.0 -> v1.0.0.
+func NormalizeVersion(version string) string {
+	if version == "" || strings.HasPrefix(version, "v") {
+		return version
+	}
+
+	return "v" + version
+}
+
+// SortReleases sorts the releases by version in descending order, so the latest release is the first one.
+func SortReleases(releases []*Release) {
+	sort.SliceStable(releases, func(i, j int) bool {
+		return semver.Compare(releases[i].Version, releases[j].Version) > 0
+	})
+}
@@ -0,0 +1,276 @@
+package models
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/database/orm"
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	"github.com/goravel/framework/support/carbon"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	protopackage "market.goravel.dev/proto/package"
+	utilserrors "market.goravel.dev/utils/errors"
+)
+
+type ReleaseSuite struct {
+	suite.Suite
+	release      *Release
+	mockOrm      *mocksorm.Orm
+	mockOrmQuery *mocksorm.Query
+}
+
+func TestReleaseSuite(t *testing.T) {
+	suite.Run(t, new(ReleaseSuite))
+}
+
+func (s *ReleaseSuite) SetupTest() {
+	s.release = NewRelease()
+}
+
+func (s *ReleaseSuite) beforeSetup() {
+	mockFactory := testingmock.Factory()
+	s.mockOrm = mockFactory.Orm()
+	s.mockOrmQuery = mockFactory.OrmQuery()
+	mockFactory.Log()
+	s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+}
+
+func (s *ReleaseSuite) TestCreateRelease() {
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrmQuery.On("Create", mock.MatchedBy(func(release *Release) bool {
+					return release.ID > 0 && release.Version == "v1.0.0"
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name: "Sad path - release of the version exists",
+			setup: func() {
+				s.mockOrmQuery.On("Create", mock.AnythingOfType("*models.Release")).Return(sqlStateError("23505")).Once()
+			},
+			expectedErr: ErrDuplicateRelease,
+		},
+		{
+			name: "Sad path - Create returns error",
+			setup: func() {
+				s.mockOrmQuery.On("Create", mock.AnythingOfType("*models.Release")).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			mockFactory := testingmock.Factory()
+			s.mockOrmQuery = mockFactory.OrmQuery()
+			mockFactory.Log()
+			test.setup()
+
+			s.Equal(test.expectedErr, s.release.CreateRelease(s.mockOrmQuery, &Release{Version: "v1.0.0"}))
+
+			s.mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *ReleaseSuite) TestGetRelease() {
+	var (
+		packageID = "1"
+		version   = "v1.0.0"
+	)
+
+	tests := []struct {
+		name          string
+		setup         func()
+		expectRelease *Release
+		expectedErr   error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrmQuery.On("First", mock.AnythingOfType("*models.Release")).Run(func(args mock.Arguments) {
+					release := args.Get(0).(*Release)
+					release.ID = 1
+					release.Version = version
+				}).Return(nil).Once()
+			},
+			expectRelease: &Release{UUIDModel: UUIDModel{ID: 1}, Version: version},
+		},
+		{
+			name: "Sad path - First returns error",
+			setup: func() {
+				s.mockOrmQuery.On("First", mock.AnythingOfType("*models.Release")).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.beforeSetup()
+			s.mockOrmQuery.On("Where", "package_id = ? AND version = ?", packageID, version).Return(s.mockOrmQuery).Once()
+			test.setup()
+
+			release, err := s.release.GetRelease(packageID, version)
+			s.Equal(test.expectRelease, release)
+			s.Equal(test.expectedErr, err)
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *ReleaseSuite) TestGetReleases() {
+	packageID := "1"
+
+	tests := []struct {
+		name           string
+		setup          func()
+		expectReleases []*Release
+		expectedErr    error
+	}{
+		{
+			name: "Happy path - sorted by semantic version",
+			setup: func() {
+				s.mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Release")).Run(func(args mock.Arguments) {
+					releases := args.Get(0).(*[]*Release)
+					*releases = []*Release{{Version: "v1.9.0"}, {Version: "v1.10.0"}, {Version: "v1.10.0-beta.1"}}
+				}).Return(nil).Once()
+			},
+			expectReleases: []*Release{{Version: "v1.10.0"}, {Version: "v1.10.0-beta.1"}, {Version: "v1.9.0"}},
+		},
+		{
+			name: "Sad path - Find returns error",
+			setup: func() {
+				s.mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Release")).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.beforeSetup()
+			s.mockOrmQuery.On("Where", "package_id = ?", packageID).Return(s.mockOrmQuery).Once()
+			test.setup()
+
+			releases, err := s.release.GetReleases(packageID)
+			s.Equal(test.expectReleases, releases)
+			s.Equal(test.expectedErr, err)
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *ReleaseSuite) TestSupportsFramework() {
+	tests := []struct {
+		name              string
+		frameworkVersions string
+		version           string
+		expect            bool
+	}{
+		{
+			name:              "Minor version supports its patches",
+			frameworkVersions: "v1.13,v1.14",
+			version:           "1.14.3",
+			expect:            true,
+		},
+		{
+			name:              "Patch version matches exactly",
+			frameworkVersions: "v1.14.3",
+			version:           "v1.14.3",
+			expect:            true,
+		},
+		{
+			name:              "Patch version doesn't support other patches",
+			frameworkVersions: "v1.14.3",
+			version:           "v1.14.2",
+			expect:            false,
+		},
+		{
+			name:              "Minor version is requested",
+			frameworkVersions: "v1.14",
+			version:           "v1.14",
+			expect:            true,
+		},
+		{
+			name:              "Unsupported version",
+			frameworkVersions: "v1.13",
+			version:           "v1.14.0",
+			expect:            false,
+		},
+		{
+			name:              "Invalid version",
+			frameworkVersions: "v1.14",
+			version:           "latest",
+			expect:            false,
+		},
+		{
+			name:    "No framework versions",
+			version: "v1.14.0",
+			expect:  false,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			release := Release{FrameworkVersions: test.frameworkVersions}
+			s.Equal(test.expect, release.SupportsFramework(test.version))
+		})
+	}
+}
+
+func (s *ReleaseSuite) TestToProto() {
+	var (
+		publishedAt = carbon.DateTime{Carbon: carbon.Parse("2024-01-01 00:00:00")}
+		createdAt   = carbon.DateTime{}
+		updatedAt   = carbon.DateTime{}
+	)
+
+	release := Release{
+		UUIDModel: UUIDModel{
+			ID: 1,
+			Timestamps: orm.Timestamps{
+				CreatedAt: createdAt,
+				UpdatedAt: updatedAt,
+			},
+		},
+		PackageID:         2,
+		UserID:            3,
+		Version:           "v1.0.0",
+		Changelog:         "changelog",
+		FrameworkVersions: "v1.13,v1.14.3",
+		PublishedAt:       publishedAt,
+	}
+
+	s.Equal(&protopackage.Release{
+		Id:                "1",
+		PackageId:         "2",
+		UserId:            "3",
+		Version:           "v1.0.0",
+		Changelog:         "changelog",
+		FrameworkVersions: []string{"v1.13", "v1.14.3"},
+		PublishedAt:       publishedAt.ToString(),
+		CreatedAt:         createdAt.ToString(),
+		UpdatedAt:         updatedAt.ToString(),
+	}, release.ToProto())
+}
+
+func (s *ReleaseSuite) TestNormalizeVersion() {
+	s.Equal("", NormalizeVersion(""))
+	s.Equal("v1.0.0", NormalizeVersion("1.0.0"))
+	s.Equal("v1.0.0", NormalizeVersion("v1.0.0"))
+}
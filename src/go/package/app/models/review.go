@@ -0,0 +1,120 @@
+package models
+
+import (
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/support/carbon"
+	"github.com/spf13/cast"
+
+	protopackage "market.goravel.dev/proto/package"
+	protouser "market.goravel.dev/proto/user"
+	"market.goravel.dev/utils/errors"
+)
+
+const (
+	ReviewMinRating uint32 = 1
+	ReviewMaxRating uint32 = 5
+)
+
+type ReviewInterface interface {
+	CreateReview(review *Review) (bool, error)
+	DeleteReview(review *Review) error
+	GetReviewByID(id string) (*Review, error)
+	UpdateReview(review *Review) error
+}
+
+// Review is the rating of a package given by a user, it's stored in the package_reviews table. The rating count and
+// the rating sum of the package are updated in the same statement as its reviews.
+type Review struct {
+	UUIDModel
+	PackageID uint64
+	UserID    uint64
+	Rating    uint32
+	Content   string
+	User      *protouser.User `gorm:"-"`
+}
+
+func NewReview() *Review {
+	return &Review{}
+}
+
+func (r *Review) TableName() string {
+	return "package_reviews"
+}
+
+// CreateReview creates the review and adds its rating to the package, it reports whether the review is created, it's
+// false if the user has reviewed the package already.
+func (r *Review) CreateReview(review *Review) (bool, error) {
+	review.ID = review.GetID()
+	now := carbon.DateTime{Carbon: carbon.Now()}
+	result, err := facades.Orm().Query().Exec(`WITH reviews AS (
+  INSERT INTO package_reviews (id, package_id, user_id, rating, content, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?) ON CONFLICT (package_id, user_id) DO NOTHING RETURNING package_id, rating
+)
+UPDATE packages SET rating_count = packages.rating_count + 1, rating_sum = packages.rating_sum + reviews.rating FROM reviews WHERE packages.id = reviews.package_id`,
+		review.ID, review.PackageID, review.UserID, review.Rating, review.Content, now, now)
+	if err != nil {
+		return false, errors.NewInternalServerError(err)
+	}
+
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	review.CreatedAt = now
+	review.UpdatedAt = now
+
+	return true, nil
+}
+
+// DeleteReview deletes the review and removes its rating from the package.
+func (r *Review) DeleteReview(review *Review) error {
+	if _, err := facades.Orm().Query().Exec(`WITH reviews AS (
+  DELETE FROM package_reviews WHERE id = ? RETURNING package_id, rating
+)
+UPDATE packages SET rating_count = GREATEST(packages.rating_count - 1, 0), rating_sum = GREATEST(packages.rating_sum - reviews.rating, 0) FROM reviews WHERE packages.id = reviews.package_id`, review.ID); err != nil {
+		return errors.NewInternalServerError(err)
+	}
+
+	return nil
+}
+
+// GetReviewByID gets the review, the ID of the returned review is 0 if it doesn't exist.
+func (r *Review) GetReviewByID(id string) (*Review, error) {
+	var review Review
+	if err := facades.Orm().Query().Where("id = ?", id).First(&review); err != nil {
+		return nil, errors.NewInternalServerError(err)
+	}
+
+	return &review, nil
+}
+
+// UpdateReview updates the rating and the content of the review, the difference between the new rating and the
+// stored one is applied to the rating sum of the package.
+func (r *Review) UpdateReview(review *Review) error {
+	now := carbon.DateTime{Carbon: carbon.Now()}
+	if _, err := facades.Orm().Query().Exec(`WITH reviews AS (
+  UPDATE package_reviews SET rating = ?, content = ?, updated_at = ? FROM package_reviews AS old_reviews
+  WHERE package_reviews.id = ? AND old_reviews.id = package_reviews.id
+  RETURNING package_reviews.package_id, package_reviews.rating - old_reviews.rating AS rating_diff
+)
+UPDATE packages SET rating_sum = GREATEST(packages.rating_sum + reviews.rating_diff, 0) FROM reviews WHERE packages.id = reviews.package_id`,
+		review.Rating, review.Content, now, review.ID); err != nil {
+		return errors.NewInternalServerError(err)
+	}
+
+	review.UpdatedAt = now
+
+	return nil
+}
+
+func (r *Review) ToProto() *protopackage.Review {
+	return &protopackage.Review{
+		Id:        cast.ToString(r.ID),
+		PackageId: cast.ToString(r.PackageID),
+		UserId:    cast.ToString(r.UserID),
+		Rating:    r.Rating,
+		Content:   r.Content,
+		CreatedAt: r.CreatedAt.ToString(),
+		UpdatedAt: r.UpdatedAt.ToString(),
+		User:      r.User,
+	}
+}
@@ -0,0 +1,231 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/contracts/http"
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	protopackage "market.goravel.dev/proto/package"
+	protouser "market.goravel.dev/proto/user"
+	utilserrors "market.goravel.dev/utils/errors"
+)
+
+type ReviewSuite struct {
+	suite.Suite
+	review       *Review
+	mockOrm      *mocksorm.Orm
+	mockOrmQuery *mocksorm.Query
+}
+
+func TestReviewSuite(t *testing.T) {
+	suite.Run(t, new(ReviewSuite))
+}
+
+func (s *ReviewSuite) SetupTest() {
+	s.review = NewReview()
+}
+
+func (s *ReviewSuite) beforeSetup() {
+	mockFactory := testingmock.Factory()
+	s.mockOrm = mockFactory.Orm()
+	s.mockOrmQuery = mockFactory.OrmQuery()
+	mockFactory.Log()
+	s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+}
+
+func (s *ReviewSuite) TestCreateReview() {
+	isInsert := mock.MatchedBy(func(sql string) bool {
+		return strings.Contains(sql, "INSERT INTO package_reviews") && strings.Contains(sql, "ON CONFLICT (package_id, user_id) DO NOTHING") &&
+			strings.Contains(sql, "rating_sum = packages.rating_sum + reviews.rating")
+	})
+
+	tests := []struct {
+		name          string
+		setup         func()
+		expectCreated bool
+		expectedErr   error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", isInsert, mock.AnythingOfType("uint64"), uint64(1), uint64(2), uint32(5), "Great", mock.Anything, mock.Anything).
+					Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+			},
+			expectCreated: true,
+		},
+		{
+			name: "Happy path - reviewed already",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", isInsert, mock.AnythingOfType("uint64"), uint64(1), uint64(2), uint32(5), "Great", mock.Anything, mock.Anything).
+					Return(&contractsorm.Result{RowsAffected: 0}, nil).Once()
+			},
+		},
+		{
+			name: "Sad path - Exec returns error",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", isInsert, mock.AnythingOfType("uint64"), uint64(1), uint64(2), uint32(5), "Great", mock.Anything, mock.Anything).
+					Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.beforeSetup()
+			test.setup()
+
+			review := &Review{PackageID: 1, UserID: 2, Rating: 5, Content: "Great"}
+			created, err := s.review.CreateReview(review)
+			s.Equal(test.expectCreated, created)
+			s.Equal(test.expectedErr, err)
+			s.NotZero(review.ID)
+			s.Equal(test.expectCreated, !review.CreatedAt.IsZero())
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *ReviewSuite) TestDeleteReview() {
+	isDelete := mock.MatchedBy(func(sql string) bool {
+		return strings.Contains(sql, "DELETE FROM package_reviews") && strings.Contains(sql, "rating_count = GREATEST(packages.rating_count - 1, 0)")
+	})
+
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", isDelete, uint64(1)).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+			},
+		},
+		{
+			name: "Sad path - Exec returns error",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", isDelete, uint64(1)).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.beforeSetup()
+			test.setup()
+
+			s.Equal(test.expectedErr, s.review.DeleteReview(&Review{UUIDModel: UUIDModel{ID: 1}}))
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *ReviewSuite) TestGetReviewByID() {
+	tests := []struct {
+		name         string
+		setup        func()
+		expectReview *Review
+		expectedErr  error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrmQuery.On("Where", "id = ?", "1").Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("First", mock.AnythingOfType("*models.Review")).Run(func(args mock.Arguments) {
+					*args.Get(0).(*Review) = Review{UUIDModel: UUIDModel{ID: 1}, Rating: 4}
+				}).Return(nil).Once()
+			},
+			expectReview: &Review{UUIDModel: UUIDModel{ID: 1}, Rating: 4},
+		},
+		{
+			name: "Sad path - First returns error",
+			setup: func() {
+				s.mockOrmQuery.On("Where", "id = ?", "1").Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("First", mock.AnythingOfType("*models.Review")).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.beforeSetup()
+			test.setup()
+
+			review, err := s.review.GetReviewByID("1")
+			s.Equal(test.expectReview, review)
+			s.Equal(test.expectedErr, err)
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *ReviewSuite) TestUpdateReview() {
+	isUpdate := mock.MatchedBy(func(sql string) bool {
+		return strings.Contains(sql, "UPDATE package_reviews SET rating = ?, content = ?") &&
+			strings.Contains(sql, "rating_sum = GREATEST(packages.rating_sum + reviews.rating_diff, 0)")
+	})
+
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", isUpdate, uint32(3), "Good", mock.Anything, uint64(1)).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+			},
+		},
+		{
+			name: "Sad path - Exec returns error",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", isUpdate, uint32(3), "Good", mock.Anything, uint64(1)).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.beforeSetup()
+			test.setup()
+
+			s.Equal(test.expectedErr, s.review.UpdateReview(&Review{UUIDModel: UUIDModel{ID: 1}, Rating: 3, Content: "Good"}))
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *ReviewSuite) TestToProto() {
+	user := &protouser.User{Id: "2", Name: "goravel"}
+	review := &Review{UUIDModel: UUIDModel{ID: 3}, PackageID: 1, UserID: 2, Rating: 5, Content: "Great", User: user}
+
+	s.Equal(&protopackage.Review{
+		Id:        "3",
+		PackageId: "1",
+		UserId:    "2",
+		Rating:    5,
+		Content:   "Great",
+		CreatedAt: review.CreatedAt.ToString(),
+		UpdatedAt: review.UpdatedAt.ToString(),
+		User:      user,
+	}, review.ToProto())
+}
@@ -1,17 +1,51 @@
 package models
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
 	"github.com/goravel/framework/database/orm"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/support/carbon"
 	"github.com/spf13/cast"
 
 	protopackage "market.goravel.dev/proto/package"
+	"market.goravel.dev/utils/errors"
 )
 
+const (
+	TagShown  uint = 1
+	TagHidden uint = 2
+
+	TagSortPopular = "popular"
+)
+
+// refreshTagPackageCountsSQL recounts the package_count of the tags matching the condition, only the approved public
+// packages that aren't deleted are counted. It must be kept in line with VisiblePackages for anonymous users.
+const refreshTagPackageCountsSQL = `UPDATE tags SET package_count = (
+SELECT COUNT(*) FROM package_tags JOIN packages ON packages.id = package_tags.package_id
+WHERE package_tags.tag_id = tags.id AND packages.is_approved = ? AND packages.is_public = ? AND packages.deleted_at IS NULL
+) WHERE %s`
+
+// tagSeparatorRegexp matches the runs of spaces, underscores and hyphens that separate the words of tag names.
+var tagSeparatorRegexp = regexp.MustCompile(`[\s_-]+`)
+
+type TagInterface interface {
+	CreateTag(tag *Tag) error
+	GetTagByID(id string) (*Tag, error)
+	GetTagByName(name string) (*Tag, error)
+	MergeTags(tag, target *Tag) error
+	UpdateTag(tag *Tag) error
+}
+
 type Tag struct {
 	UUIDModel
-	UserID uint64
-	Name   string
-	IsShow uint
+	UserID       uint64
+	Name         string
+	IsShow       uint
+	PackageCount uint32 `gorm:"<-:create"` // Maintained by refreshTagPackageCounts, saving a tag must not overwrite it.
 	orm.SoftDeletes
 }
 
@@ -19,6 +53,71 @@ func NewTag() *Tag {
 	return &Tag{}
 }
 
+func (r *Tag) CreateTag(tag *Tag) error {
+	tag.ID = tag.GetID()
+	if err := facades.Orm().Query().Create(tag); err != nil {
+		return errors.NewInternalServerError(err)
+	}
+
+	return nil
+}
+
+// GetTagByID gets the tag, the ID of the returned tag is 0 if it doesn't exist.
+func (r *Tag) GetTagByID(id string) (*Tag, error) {
+	var tag Tag
+	if err := facades.Orm().Query().Where("id = ?", id).First(&tag); err != nil {
+		return nil, errors.NewInternalServerError(err)
+	}
+
+	return &tag, nil
+}
+
+// GetTagByName gets the tag by name case-insensitively, the ID of the returned tag is 0 if it doesn't exist.
+func (r *Tag) GetTagByName(name string) (*Tag, error) {
+	var tag Tag
+	if err := facades.Orm().Query().Where("LOWER(name) = ?", strings.ToLower(name)).First(&tag); err != nil {
+		return nil, errors.NewInternalServerError(err)
+	}
+
+	return &tag, nil
+}
+
+// MergeTags moves the packages and the aliases of the tag to the target tag in a transaction, then deletes the tag and
+// keeps its name as an alias of the target tag, so the packages created later with the name get the target tag.
+func (r *Tag) MergeTags(tag, target *Tag) error {
+	now := carbon.DateTime{Carbon: carbon.Now()}
+
+	return facades.Orm().Transaction(func(tx contractsorm.Transaction) error {
+		// The packages carrying both tags keep the row of the target tag only.
+		if _, err := tx.Exec("INSERT INTO package_tags (package_id, tag_id) SELECT package_id, ? FROM package_tags WHERE tag_id = ? ON CONFLICT (package_id, tag_id) DO NOTHING", target.ID, tag.ID); err != nil {
+			return errors.NewInternalServerError(err)
+		}
+		if _, err := tx.Exec("DELETE FROM package_tags WHERE tag_id = ?", tag.ID); err != nil {
+			return errors.NewInternalServerError(err)
+		}
+		if _, err := tx.Exec("UPDATE tag_aliases SET tag_id = ?, updated_at = ? WHERE tag_id = ?", target.ID, now, tag.ID); err != nil {
+			return errors.NewInternalServerError(err)
+		}
+		if _, err := tx.Exec("INSERT INTO tag_aliases (id, tag_id, name, created_at, updated_at) VALUES (?, ?, ?, ?, ?) ON CONFLICT (name) DO UPDATE SET tag_id = EXCLUDED.tag_id, updated_at = EXCLUDED.updated_at",
+			NewTagAlias().GetID(), target.ID, NormalizeTagName(tag.Name), now, now); err != nil {
+			return errors.NewInternalServerError(err)
+		}
+		if _, err := tx.Exec("UPDATE tags SET deleted_at = ? WHERE id = ?", now, tag.ID); err != nil {
+			return errors.NewInternalServerError(err)
+		}
+
+		return refreshTagPackageCounts(tx, "id = ?", target.ID)
+	})
+}
+
+func (r *Tag) UpdateTag(tag *Tag) error {
+	if err := facades.Orm().Query().Save(tag); err != nil {
+		return errors.NewInternalServerError(err)
+	}
+
+	return nil
+}
+
 func (r *Tag) ToProto() *protopackage.Tag {
 	var userID string
 	if r.UserID != 0 {
@@ -26,8 +125,46 @@ func (r *Tag) ToProto() *protopackage.Tag {
 	}
 
 	return &protopackage.Tag{
-		Id:     cast.ToString(r.ID),
-		UserId: userID,
-		Name:   r.Name,
+		Id:           cast.ToString(r.ID),
+		UserId:       userID,
+		Name:         r.Name,
+		IsShow:       cast.ToInt32(r.IsShow),
+		PackageCount: r.PackageCount,
+	}
+}
+
+// refreshTagPackageCounts recounts the packages of the tags matching the condition. It's called whenever the tags of a
+// package or the visibility of a package changes, so the listings read the counts without counting.
+func refreshTagPackageCounts(query contractsorm.Query, condition string, args ...any) error {
+	args = append([]any{PackageApproved, PackagePublic}, args...)
+	if _, err := query.Exec(fmt.Sprintf(refreshTagPackageCountsSQL, condition), args...); err != nil {
+		return errors.NewInternalServerError(err)
+	}
+
+	return nil
+}
+
+// NormalizeTagName normalizes the tag name so that different spellings of a tag have the same name: the name is
+// trimmed, lower-cased and the words are separated by single hyphens, e.g. " Database_ORM " is "database-orm".
+func NormalizeTagName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	return strings.Trim(tagSeparatorRegexp.ReplaceAllString(name, "-"), "-")
+}
+
+// NormalizeTags normalizes the tag names by NormalizeTagName, the empty and the duplicate names are removed.
+func NormalizeTags(tags []string) []string {
+	names := make([]string, 0, len(tags))
+	exists := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		name := NormalizeTagName(tag)
+		if name == "" || exists[name] {
+			continue
+		}
+
+		exists[name] = true
+		names = append(names, name)
 	}
+
+	return names
 }
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"github.com/goravel/framework/facades"
+	"github.com/spf13/cast"
+
+	protopackage "market.goravel.dev/proto/package"
+	"market.goravel.dev/utils/errors"
+)
+
+type TagAliasInterface interface {
+	CreateTagAlias(alias *TagAlias) error
+	DeleteTagAlias(alias *TagAlias) error
+	GetTagAliasByName(name string) (*TagAlias, error)
+}
+
+// TagAlias resolves another spelling of a tag, e.g. "ORM" or "database-orm", to the canonical tag when tags are attached
+// to packages. The names are stored normalized by NormalizeTagName.
+type TagAlias struct {
+	UUIDModel
+	TagID uint64
+	Name  string
+}
+
+func NewTagAlias() *TagAlias {
+	return &TagAlias{}
+}
+
+func (r *TagAlias) TableName() string {
+	return "tag_aliases"
+}
+
+func (r *TagAlias) CreateTagAlias(alias *TagAlias) error {
+	alias.ID = alias.GetID()
+	alias.Name = NormalizeTagName(alias.Name)
+	if err := facades.Orm().Query().Create(alias); err != nil {
+		return errors.NewInternalServerError(err)
+	}
+
+	return nil
+}
+
+func (r *TagAlias) DeleteTagAlias(alias *TagAlias) error {
+	if _, err := facades.Orm().Query().Exec("DELETE FROM tag_aliases WHERE id = ?", alias.ID); err != nil {
+		return errors.NewInternalServerError(err)
+	}
+
+	return nil
+}
+
+// GetTagAliasByName gets the alias matching the name, the ID of the returned alias is 0 if it doesn't exist.
+func (r *TagAlias) GetTagAliasByName(name string) (*TagAlias, error) {
+	var alias TagAlias
+	if err := facades.Orm().Query().Where("name = ?", NormalizeTagName(name)).First(&alias); err != nil {
+		return nil, errors.NewInternalServerError(err)
+	}
+
+	return &alias, nil
+}
+
+func (r *TagAlias) ToProto() *protopackage.TagAlias {
+	return &protopackage.TagAlias{
+		Id:        cast.ToString(r.ID),
+		TagId:     cast.ToString(r.TagID),
+		Name:      r.Name,
+		CreatedAt: r.CreatedAt.ToString(),
+		UpdatedAt: r.UpdatedAt.ToString(),
+	}
+}
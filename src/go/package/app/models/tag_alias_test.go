@@ -0,0 +1,152 @@
+package models
+
+import (
+	"errors"
+	"testing"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/contracts/http"
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	utilserrors "market.goravel.dev/utils/errors"
+)
+
+type TagAliasSuite struct {
+	suite.Suite
+	tagAlias     *TagAlias
+	mockOrm      *mocksorm.Orm
+	mockOrmQuery *mocksorm.Query
+}
+
+func TestTagAliasSuite(t *testing.T) {
+	suite.Run(t, new(TagAliasSuite))
+}
+
+func (s *TagAliasSuite) SetupTest() {
+	s.tagAlias = NewTagAlias()
+}
+
+func (s *TagAliasSuite) beforeSetup() {
+	mockFactory := testingmock.Factory()
+	s.mockOrm = mockFactory.Orm()
+	s.mockOrmQuery = mockFactory.OrmQuery()
+	mockFactory.Log()
+	s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+}
+
+func (s *TagAliasSuite) TestCreateTagAlias() {
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrmQuery.On("Create", mock.MatchedBy(func(alias *TagAlias) bool {
+					return alias.ID != 0 && alias.TagID == 1 && alias.Name == "database-orm"
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name: "Sad path - Create returns error",
+			setup: func() {
+				s.mockOrmQuery.On("Create", mock.AnythingOfType("*models.TagAlias")).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.beforeSetup()
+			test.setup()
+
+			alias := &TagAlias{TagID: 1, Name: " Database ORM "}
+			s.Equal(test.expectedErr, s.tagAlias.CreateTagAlias(alias))
+			s.Equal("database-orm", alias.Name)
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *TagAliasSuite) TestDeleteTagAlias() {
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", "DELETE FROM tag_aliases WHERE id = ?", uint64(1)).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+			},
+		},
+		{
+			name: "Sad path - Exec returns error",
+			setup: func() {
+				s.mockOrmQuery.On("Exec", "DELETE FROM tag_aliases WHERE id = ?", uint64(1)).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.beforeSetup()
+			test.setup()
+
+			s.Equal(test.expectedErr, s.tagAlias.DeleteTagAlias(&TagAlias{UUIDModel: UUIDModel{ID: 1}}))
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *TagAliasSuite) TestGetTagAliasByName() {
+	tests := []struct {
+		name        string
+		setup       func()
+		expectAlias *TagAlias
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrmQuery.On("Where", "name = ?", "orm").Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("First", mock.AnythingOfType("*models.TagAlias")).Run(func(args mock.Arguments) {
+					*args.Get(0).(*TagAlias) = TagAlias{UUIDModel: UUIDModel{ID: 1}, TagID: 2, Name: "orm"}
+				}).Return(nil).Once()
+			},
+			expectAlias: &TagAlias{UUIDModel: UUIDModel{ID: 1}, TagID: 2, Name: "orm"},
+		},
+		{
+			name: "Sad path - First returns error",
+			setup: func() {
+				s.mockOrmQuery.On("Where", "name = ?", "orm").Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("First", mock.AnythingOfType("*models.TagAlias")).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.beforeSetup()
+			test.setup()
+
+			alias, err := s.tagAlias.GetTagAliasByName("ORM")
+			s.Equal(test.expectAlias, alias)
+			s.Equal(test.expectedErr, err)
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
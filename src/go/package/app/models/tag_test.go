@@ -0,0 +1,268 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/contracts/http"
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	protopackage "market.goravel.dev/proto/package"
+	utilserrors "market.goravel.dev/utils/errors"
+)
+
+type TagSuite struct {
+	suite.Suite
+	tag          *Tag
+	mockOrm      *mocksorm.Orm
+	mockOrmQuery *mocksorm.Query
+}
+
+func TestTagSuite(t *testing.T) {
+	suite.Run(t, new(TagSuite))
+}
+
+func (s *TagSuite) SetupTest() {
+	s.tag = NewTag()
+}
+
+func (s *TagSuite) beforeSetup() {
+	mockFactory := testingmock.Factory()
+	s.mockOrm = mockFactory.Orm()
+	s.mockOrmQuery = mockFactory.OrmQuery()
+	mockFactory.Log()
+}
+
+func (s *TagSuite) TestCreateTag() {
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrmQuery.On("Create", mock.MatchedBy(func(tag *Tag) bool {
+					return tag.ID != 0 && tag.Name == "orm"
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name: "Sad path - Create returns error",
+			setup: func() {
+				s.mockOrmQuery.On("Create", mock.AnythingOfType("*models.Tag")).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.beforeSetup()
+			s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+			test.setup()
+
+			s.Equal(test.expectedErr, s.tag.CreateTag(&Tag{Name: "orm", IsShow: TagShown}))
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *TagSuite) TestGetTagByID() {
+	tests := []struct {
+		name        string
+		setup       func()
+		expectTag   *Tag
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrmQuery.On("Where", "id = ?", "1").Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("First", mock.AnythingOfType("*models.Tag")).Run(func(args mock.Arguments) {
+					*args.Get(0).(*Tag) = Tag{UUIDModel: UUIDModel{ID: 1}, Name: "orm"}
+				}).Return(nil).Once()
+			},
+			expectTag: &Tag{UUIDModel: UUIDModel{ID: 1}, Name: "orm"},
+		},
+		{
+			name: "Sad path - First returns error",
+			setup: func() {
+				s.mockOrmQuery.On("Where", "id = ?", "1").Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("First", mock.AnythingOfType("*models.Tag")).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.beforeSetup()
+			s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+			test.setup()
+
+			tag, err := s.tag.GetTagByID("1")
+			s.Equal(test.expectTag, tag)
+			s.Equal(test.expectedErr, err)
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *TagSuite) TestGetTagByName() {
+	s.beforeSetup()
+	s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+	s.mockOrmQuery.On("Where", "LOWER(name) = ?", "orm").Return(s.mockOrmQuery).Once()
+	s.mockOrmQuery.On("First", mock.AnythingOfType("*models.Tag")).Run(func(args mock.Arguments) {
+		*args.Get(0).(*Tag) = Tag{UUIDModel: UUIDModel{ID: 1}, Name: "orm"}
+	}).Return(nil).Once()
+
+	tag, err := s.tag.GetTagByName("ORM")
+	s.Nil(err)
+	s.Equal(&Tag{UUIDModel: UUIDModel{ID: 1}, Name: "orm"}, tag)
+
+	s.mockOrm.AssertExpectations(s.T())
+	s.mockOrmQuery.AssertExpectations(s.T())
+}
+
+func (s *TagSuite) TestMergeTags() {
+	var (
+		mockTransaction *mocksorm.Transaction
+		tag             = &Tag{UUIDModel: UUIDModel{ID: 1}, Name: "Database-ORM"}
+		target          = &Tag{UUIDModel: UUIDModel{ID: 2}, Name: "orm"}
+		sqlHasPrefix    = func(sql string) any {
+			return mock.MatchedBy(func(query string) bool {
+				return strings.HasPrefix(query, sql)
+			})
+		}
+	)
+
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				mockTransaction.On("Exec", sqlHasPrefix("INSERT INTO package_tags"), uint64(2), uint64(1)).Return(&contractsorm.Result{}, nil).Once()
+				mockTransaction.On("Exec", "DELETE FROM package_tags WHERE tag_id = ?", uint64(1)).Return(&contractsorm.Result{}, nil).Once()
+				mockTransaction.On("Exec", "UPDATE tag_aliases SET tag_id = ?, updated_at = ? WHERE tag_id = ?", uint64(2), mock.Anything, uint64(1)).Return(&contractsorm.Result{}, nil).Once()
+				mockTransaction.On("Exec", sqlHasPrefix("INSERT INTO tag_aliases"), mock.AnythingOfType("uint64"), uint64(2), "database-orm", mock.Anything, mock.Anything).Return(&contractsorm.Result{}, nil).Once()
+				mockTransaction.On("Exec", "UPDATE tags SET deleted_at = ? WHERE id = ?", mock.Anything, uint64(1)).Return(&contractsorm.Result{}, nil).Once()
+				mockTransaction.On("Exec", fmt.Sprintf(refreshTagPackageCountsSQL, "id = ?"), PackageApproved, PackagePublic, uint64(2)).Return(&contractsorm.Result{}, nil).Once()
+			},
+		},
+		{
+			name: "Sad path - rewriting package tags returns error",
+			setup: func() {
+				mockTransaction.On("Exec", sqlHasPrefix("INSERT INTO package_tags"), uint64(2), uint64(1)).Return(&contractsorm.Result{}, nil).Once()
+				mockTransaction.On("Exec", "DELETE FROM package_tags WHERE tag_id = ?", uint64(1)).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+		{
+			name: "Sad path - deleting the tag returns error",
+			setup: func() {
+				mockTransaction.On("Exec", sqlHasPrefix("INSERT INTO package_tags"), uint64(2), uint64(1)).Return(&contractsorm.Result{}, nil).Once()
+				mockTransaction.On("Exec", "DELETE FROM package_tags WHERE tag_id = ?", uint64(1)).Return(&contractsorm.Result{}, nil).Once()
+				mockTransaction.On("Exec", "UPDATE tag_aliases SET tag_id = ?, updated_at = ? WHERE tag_id = ?", uint64(2), mock.Anything, uint64(1)).Return(&contractsorm.Result{}, nil).Once()
+				mockTransaction.On("Exec", sqlHasPrefix("INSERT INTO tag_aliases"), mock.AnythingOfType("uint64"), uint64(2), "database-orm", mock.Anything, mock.Anything).Return(&contractsorm.Result{}, nil).Once()
+				mockTransaction.On("Exec", "UPDATE tags SET deleted_at = ? WHERE id = ?", mock.Anything, uint64(1)).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.beforeSetup()
+			mockTransaction = &mocksorm.Transaction{}
+			s.mockOrm.On("Transaction", mock.Anything).Return(func(txFunc func(contractsorm.Transaction) error) error {
+				return txFunc(mockTransaction)
+			}).Once()
+			test.setup()
+
+			s.Equal(test.expectedErr, s.tag.MergeTags(tag, target))
+
+			s.mockOrm.AssertExpectations(s.T())
+			mockTransaction.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *TagSuite) TestUpdateTag() {
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrmQuery.On("Save", mock.AnythingOfType("*models.Tag")).Return(nil).Once()
+			},
+		},
+		{
+			name: "Sad path - Save returns error",
+			setup: func() {
+				s.mockOrmQuery.On("Save", mock.AnythingOfType("*models.Tag")).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.beforeSetup()
+			s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+			test.setup()
+
+			s.Equal(test.expectedErr, s.tag.UpdateTag(&Tag{UUIDModel: UUIDModel{ID: 1}, Name: "orm", IsShow: TagHidden}))
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *TagSuite) TestToProto() {
+	s.Equal(&protopackage.Tag{Id: "1", UserId: "2", Name: "orm", IsShow: 2, PackageCount: 3}, (&Tag{UUIDModel: UUIDModel{ID: 1}, UserID: 2, Name: "orm", IsShow: TagHidden, PackageCount: 3}).ToProto())
+	s.Equal(&protopackage.Tag{Id: "1", Name: "orm", IsShow: 1}, (&Tag{UUIDModel: UUIDModel{ID: 1}, Name: "orm", IsShow: TagShown}).ToProto())
+}
+
+func TestNormalizeTagName(t *testing.T) {
+	tests := []struct {
+		name   string
+		expect string
+	}{
+		{name: "orm", expect: "orm"},
+		{name: " Cache ", expect: "cache"},
+		{name: "Database_ORM", expect: "database-orm"},
+		{name: "database  -  orm", expect: "database-orm"},
+		{name: "--Vue.js--", expect: "vue.js"},
+		{name: "C++", expect: "c++"},
+		{name: "数据库", expect: "数据库"},
+		{name: " _ ", expect: ""},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expect, NormalizeTagName(test.name), test.name)
+	}
+}
+
+func TestNormalizeTags(t *testing.T) {
+	assert.Equal(t, []string{"cache", "database-orm"}, NormalizeTags([]string{"Cache", "cache ", " ", "Database ORM", "database_orm"}))
+	assert.Empty(t, NormalizeTags(nil))
+}
@@ -0,0 +1,279 @@
+package services
+
+import (
+	"context"
+
+	"github.com/goravel/framework/facades"
+	"github.com/spf13/cast"
+
+	"market.goravel.dev/package/app/models"
+	protobase "market.goravel.dev/proto/base"
+	protopackage "market.goravel.dev/proto/package"
+	protouser "market.goravel.dev/proto/user"
+	"market.goravel.dev/utils/errors"
+	utilspagination "market.goravel.dev/utils/pagination"
+)
+
+type Comment interface {
+	CreateComment(ctx context.Context, req *protopackage.CreateCommentRequest) (*models.Comment, error)
+	DeleteComment(ctx context.Context, userID, packageID, id string) error
+	ListComments(ctx context.Context, userID, packageID, parentID string, pagination *protobase.Pagination) ([]*models.Comment, int64, string, error)
+	UpdateComment(ctx context.Context, req *protopackage.UpdateCommentRequest) (*models.Comment, error)
+}
+
+type CommentImpl struct {
+	commentModel    models.CommentInterface
+	markdownService Markdown
+	packageModel    models.PackageInterface
+	userService     User
+}
+
+func NewCommentImpl() *CommentImpl {
+	return &CommentImpl{
+		commentModel:    models.NewComment(),
+		markdownService: NewMarkdownImpl(),
+		packageModel:    models.NewPackage(),
+		userService:     NewUserImpl(),
+	}
+}
+
+// CreateComment comments on the package or replies to a comment, a reply to a reply is added to the same thread.
+func (r *CommentImpl) CreateComment(ctx context.Context, req *protopackage.CreateCommentRequest) (*models.Comment, error) {
+	pkg, err := getVisiblePackage(ctx, r.packageModel, r.userService, req.GetUserId(), req.GetPackageId())
+	if err != nil {
+		return nil, err
+	}
+
+	var parentID uint64
+	if req.GetParentId() != "" {
+		parent, err := r.getComment(ctx, pkg.ID, req.GetParentId())
+		if err != nil {
+			return nil, err
+		}
+
+		parentID = parent.ID
+		if parent.ParentID != 0 {
+			parentID = parent.ParentID
+		}
+	}
+
+	comment := &models.Comment{
+		PackageID: pkg.ID,
+		UserID:    cast.ToUint64(req.GetUserId()),
+		ParentID:  parentID,
+		Content:   req.GetContent(),
+	}
+
+	if err := r.commentModel.CreateComment(comment); err != nil {
+		return nil, err
+	}
+
+	comment.IsPackageAuthor = comment.UserID == pkg.UserID
+
+	if err := r.renderContents([]*models.Comment{comment}); err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+// DeleteComment deletes the comment, both the commenter and the author of the package can delete it.
+func (r *CommentImpl) DeleteComment(ctx context.Context, userID, packageID, id string) error {
+	pkg, err := getVisiblePackage(ctx, r.packageModel, r.userService, userID, packageID)
+	if err != nil {
+		return err
+	}
+
+	comment, err := r.getComment(ctx, pkg.ID, id)
+	if err != nil {
+		return err
+	}
+
+	if comment.UserID != cast.ToUint64(userID) && pkg.UserID != cast.ToUint64(userID) {
+		return errors.NewUnauthorized(facades.Lang(ctx).Get("forbidden.delete_comment"))
+	}
+
+	return r.commentModel.DeleteComment(comment)
+}
+
+// ListComments gets the threads of the package, the newest first, the replies of each thread are the oldest first and
+// limited to the comment_replies_limit. The replies of the parent are paginated instead if the parent is given, the
+// oldest first.
+func (r *CommentImpl) ListComments(ctx context.Context, userID, packageID, parentID string, pagination *protobase.Pagination) (comments []*models.Comment, total int64, nextCursor string, err error) {
+	pkg, err := getVisiblePackage(ctx, r.packageModel, r.userService, userID, packageID)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	if parentID != "" {
+		ormQuery := facades.Orm().Query().Scopes(models.ListableReplies(packageID, parentID))
+		nextCursor, err = utilspagination.Paginate(ormQuery, pagination, &utilspagination.Order{}, func(comment *models.Comment) (any, uint64) {
+			return nil, comment.ID
+		}, &comments, &total)
+		if err != nil {
+			return nil, 0, "", paginationError(ctx, err)
+		}
+
+		if err := r.fillComments(ctx, pkg, comments); err != nil {
+			return nil, 0, "", err
+		}
+
+		return comments, total, nextCursor, nil
+	}
+
+	ormQuery := facades.Orm().Query().WithTrashed().Scopes(models.ListableComments(packageID))
+	nextCursor, err = utilspagination.Paginate(ormQuery, pagination, &utilspagination.Order{Desc: true}, func(comment *models.Comment) (any, uint64) {
+		return nil, comment.ID
+	}, &comments, &total)
+	if err != nil {
+		return nil, 0, "", paginationError(ctx, err)
+	}
+
+	if len(comments) == 0 {
+		return comments, total, nextCursor, nil
+	}
+
+	commentIDs := make([]uint64, len(comments))
+	commentMap := make(map[uint64]*models.Comment, len(comments))
+	for i, comment := range comments {
+		commentIDs[i] = comment.ID
+		commentMap[comment.ID] = comment
+	}
+
+	// One more reply of each thread is fetched to know whether the thread has more replies.
+	repliesLimit := facades.Config().GetInt("package.comment_replies_limit", 3)
+	replies, err := r.commentModel.GetReplies(commentIDs, repliesLimit+1)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	allComments := append(make([]*models.Comment, 0, len(comments)+len(replies)), comments...)
+	for _, reply := range replies {
+		parent, ok := commentMap[reply.ParentID]
+		if !ok {
+			continue
+		}
+
+		if len(parent.Replies) == repliesLimit {
+			parent.HasMoreReplies = true
+			continue
+		}
+
+		parent.Replies = append(parent.Replies, reply)
+		allComments = append(allComments, reply)
+	}
+
+	if err := r.fillComments(ctx, pkg, allComments); err != nil {
+		return nil, 0, "", err
+	}
+
+	return comments, total, nextCursor, nil
+}
+
+// UpdateComment edits the content of the comment, only the commenter can edit it.
+func (r *CommentImpl) UpdateComment(ctx context.Context, req *protopackage.UpdateCommentRequest) (*models.Comment, error) {
+	pkg, err := getVisiblePackage(ctx, r.packageModel, r.userService, req.GetUserId(), req.GetPackageId())
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := r.getComment(ctx, pkg.ID, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.UserID != cast.ToUint64(req.GetUserId()) {
+		return nil, errors.NewUnauthorized(facades.Lang(ctx).Get("forbidden.update_comment"))
+	}
+
+	comment.Content = req.GetContent()
+	if err := r.commentModel.UpdateComment(comment); err != nil {
+		return nil, err
+	}
+
+	comment.IsPackageAuthor = comment.UserID == pkg.UserID
+
+	if err := r.renderContents([]*models.Comment{comment}); err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+// fillComments fills the listed comments of the package with the fields that aren't stored.
+func (r *CommentImpl) fillComments(ctx context.Context, pkg *models.Package, comments []*models.Comment) error {
+	for _, comment := range comments {
+		comment.IsPackageAuthor = comment.UserID == pkg.UserID
+	}
+
+	if err := r.renderContents(comments); err != nil {
+		return err
+	}
+
+	return r.fillUsers(ctx, comments)
+}
+
+// fillUsers fills the commenters, the deleted comments are skipped since their commenters are hidden.
+func (r *CommentImpl) fillUsers(ctx context.Context, comments []*models.Comment) error {
+	userIDs := make([]string, 0, len(comments))
+	for _, comment := range comments {
+		if !comment.IsDeleted() {
+			userIDs = append(userIDs, cast.ToString(comment.UserID))
+		}
+	}
+
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	users, err := r.userService.GetUsers(ctx, userIDs)
+	if err != nil {
+		return errors.NewInternalServerError(err)
+	}
+
+	userMap := make(map[string]*protouser.User)
+	for _, user := range users {
+		userMap[user.GetId()] = user
+	}
+
+	for _, comment := range comments {
+		if !comment.IsDeleted() {
+			comment.User = userMap[cast.ToString(comment.UserID)]
+		}
+	}
+
+	return nil
+}
+
+// renderContents renders the markdown contents of the comments to sanitized HTML, the deleted comments are skipped
+// since their contents are hidden. The relative links aren't resolved, the comments aren't a part of the repository.
+func (r *CommentImpl) renderContents(comments []*models.Comment) error {
+	for _, comment := range comments {
+		if comment.IsDeleted() {
+			continue
+		}
+
+		contentHTML, err := r.markdownService.Render(comment.Content, "")
+		if err != nil {
+			return err
+		}
+		comment.ContentHTML = contentHTML
+	}
+
+	return nil
+}
+
+// getComment gets the comment of the package, it returns a not found error if the comment doesn't exist, is deleted
+// or doesn't belong to the package.
+func (r *CommentImpl) getComment(ctx context.Context, packageID uint64, id string) (*models.Comment, error) {
+	comment, err := r.commentModel.GetCommentByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.ID == 0 || comment.PackageID != packageID {
+		return nil, errors.NewNotFound(facades.Lang(ctx).Get("not_exist.comment"))
+	}
+
+	return comment, nil
+}
@@ -0,0 +1,487 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goravel/framework/contracts/http"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	mockstranslation "github.com/goravel/framework/mocks/translation"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	mocksmodels "market.goravel.dev/package/app/mocks/models"
+	mocksservice "market.goravel.dev/package/app/mocks/services"
+	"market.goravel.dev/package/app/models"
+	protobase "market.goravel.dev/proto/base"
+	protopackage "market.goravel.dev/proto/package"
+	protouser "market.goravel.dev/proto/user"
+	utilserrors "market.goravel.dev/utils/errors"
+)
+
+type CommentTestSuite struct {
+	suite.Suite
+	commentImpl          *CommentImpl
+	ctx                  context.Context
+	mockCommentInterface *mocksmodels.CommentInterface
+	mockLang             *mockstranslation.Translator
+	mockMarkdownService  *mocksservice.Markdown
+	mockOrm              *mocksorm.Orm
+	mockOrmQuery         *mocksorm.Query
+	mockPackageInterface *mocksmodels.PackageInterface
+	mockUserService      *mocksservice.User
+	visibleFields        []string
+	publicPackage        *models.Package
+}
+
+func TestCommentTestSuite(t *testing.T) {
+	suite.Run(t, new(CommentTestSuite))
+}
+
+func (s *CommentTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	mockFactory := testingmock.Factory()
+	s.mockLang = mockFactory.Lang(s.ctx)
+	s.mockOrm = mockFactory.Orm()
+	s.mockOrmQuery = mockFactory.OrmQuery()
+	s.mockCommentInterface = &mocksmodels.CommentInterface{}
+	s.mockPackageInterface = &mocksmodels.PackageInterface{}
+	s.mockMarkdownService = &mocksservice.Markdown{}
+	s.mockUserService = &mocksservice.User{}
+	s.commentImpl = &CommentImpl{
+		commentModel:    s.mockCommentInterface,
+		markdownService: s.mockMarkdownService,
+		packageModel:    s.mockPackageInterface,
+		userService:     s.mockUserService,
+	}
+	s.visibleFields = []string{"id", "user_id", "is_approved", "is_public", "organization_id"}
+	s.publicPackage = &models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 3, IsApproved: models.PackageApproved, IsPublic: models.PackagePublic}
+}
+
+func (s *CommentTestSuite) TestCreateComment() {
+	tests := []struct {
+		name          string
+		request       *protopackage.CreateCommentRequest
+		setup         func()
+		expectComment *models.Comment
+		expectedErr   error
+	}{
+		{
+			name:    "Happy path - top-level comment",
+			request: &protopackage.CreateCommentRequest{UserId: "2", PackageId: "1", Content: "Question"},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", "1", s.visibleFields).Return(s.publicPackage, nil).Once()
+				s.mockCommentInterface.On("CreateComment", &models.Comment{PackageID: 1, UserID: 2, Content: "Question"}).Return(nil).Once()
+				s.mockMarkdownService.On("Render", "Question", "").Return("<p>Question</p>", nil).Once()
+			},
+			expectComment: &models.Comment{PackageID: 1, UserID: 2, Content: "Question", ContentHTML: "<p>Question</p>"},
+		},
+		{
+			name:    "Happy path - the package author replies to a reply",
+			request: &protopackage.CreateCommentRequest{UserId: "3", PackageId: "1", ParentId: "11", Content: "Answer"},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", "1", s.visibleFields).Return(s.publicPackage, nil).Once()
+				s.mockCommentInterface.On("GetCommentByID", "11").Return(&models.Comment{UUIDModel: models.UUIDModel{ID: 11}, PackageID: 1, ParentID: 10}, nil).Once()
+				s.mockCommentInterface.On("CreateComment", &models.Comment{PackageID: 1, UserID: 3, ParentID: 10, Content: "Answer"}).Return(nil).Once()
+				s.mockMarkdownService.On("Render", "Answer", "").Return("<p>Answer</p>", nil).Once()
+			},
+			expectComment: &models.Comment{PackageID: 1, UserID: 3, ParentID: 10, Content: "Answer", ContentHTML: "<p>Answer</p>", IsPackageAuthor: true},
+		},
+		{
+			name:    "Sad path - parent belongs to another package",
+			request: &protopackage.CreateCommentRequest{UserId: "2", PackageId: "1", ParentId: "11", Content: "Answer"},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", "1", s.visibleFields).Return(s.publicPackage, nil).Once()
+				s.mockCommentInterface.On("GetCommentByID", "11").Return(&models.Comment{UUIDModel: models.UUIDModel{ID: 11}, PackageID: 5}, nil).Once()
+				s.mockLang.On("Get", "not_exist.comment").Return("comment not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "comment not exist"),
+		},
+		{
+			name:    "Sad path - package is private",
+			request: &protopackage.CreateCommentRequest{UserId: "2", PackageId: "1", Content: "Question"},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", "1", s.visibleFields).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 3}, nil).Once()
+				s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("Where", "package_id = ? AND user_id = ?", uint64(1), uint64(2)).Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("First", mock.AnythingOfType("*models.Maintainer")).Return(nil).Once()
+				s.mockLang.On("Get", "not_exist.package").Return("package not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "package not exist"),
+		},
+		{
+			name:    "Sad path - CreateComment returns error",
+			request: &protopackage.CreateCommentRequest{UserId: "2", PackageId: "1", Content: "Question"},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", "1", s.visibleFields).Return(s.publicPackage, nil).Once()
+				s.mockCommentInterface.On("CreateComment", mock.AnythingOfType("*models.Comment")).Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			test.setup()
+
+			comment, err := s.commentImpl.CreateComment(s.ctx, test.request)
+			s.Equal(test.expectComment, comment)
+			s.Equal(test.expectedErr, err)
+
+			s.mockCommentInterface.AssertExpectations(s.T())
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *CommentTestSuite) TestUpdateComment() {
+	request := &protopackage.UpdateCommentRequest{UserId: "2", PackageId: "1", Id: "10", Content: "Edited"}
+
+	tests := []struct {
+		name          string
+		setup         func()
+		expectComment *models.Comment
+		expectedErr   error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", "1", s.visibleFields).Return(s.publicPackage, nil).Once()
+				s.mockCommentInterface.On("GetCommentByID", "10").Return(&models.Comment{UUIDModel: models.UUIDModel{ID: 10}, PackageID: 1, UserID: 2, Content: "Question"}, nil).Once()
+				s.mockCommentInterface.On("UpdateComment", &models.Comment{UUIDModel: models.UUIDModel{ID: 10}, PackageID: 1, UserID: 2, Content: "Edited"}).Return(nil).Once()
+				s.mockMarkdownService.On("Render", "Edited", "").Return("<p>Edited</p>", nil).Once()
+			},
+			expectComment: &models.Comment{UUIDModel: models.UUIDModel{ID: 10}, PackageID: 1, UserID: 2, Content: "Edited", ContentHTML: "<p>Edited</p>"},
+		},
+		{
+			name: "Sad path - not the commenter",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", "1", s.visibleFields).Return(s.publicPackage, nil).Once()
+				s.mockCommentInterface.On("GetCommentByID", "10").Return(&models.Comment{UUIDModel: models.UUIDModel{ID: 10}, PackageID: 1, UserID: 4}, nil).Once()
+				s.mockLang.On("Get", "forbidden.update_comment").Return("forbidden").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusUnauthorized, "forbidden"),
+		},
+		{
+			name: "Sad path - comment doesn't exist",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", "1", s.visibleFields).Return(s.publicPackage, nil).Once()
+				s.mockCommentInterface.On("GetCommentByID", "10").Return(&models.Comment{}, nil).Once()
+				s.mockLang.On("Get", "not_exist.comment").Return("comment not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "comment not exist"),
+		},
+		{
+			name: "Sad path - UpdateComment returns error",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", "1", s.visibleFields).Return(s.publicPackage, nil).Once()
+				s.mockCommentInterface.On("GetCommentByID", "10").Return(&models.Comment{UUIDModel: models.UUIDModel{ID: 10}, PackageID: 1, UserID: 2}, nil).Once()
+				s.mockCommentInterface.On("UpdateComment", mock.AnythingOfType("*models.Comment")).Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			test.setup()
+
+			comment, err := s.commentImpl.UpdateComment(s.ctx, request)
+			s.Equal(test.expectComment, comment)
+			s.Equal(test.expectedErr, err)
+
+			s.mockCommentInterface.AssertExpectations(s.T())
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *CommentTestSuite) TestDeleteComment() {
+	tests := []struct {
+		name        string
+		userID      string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name:   "Happy path - commenter",
+			userID: "2",
+			setup: func() {
+				comment := &models.Comment{UUIDModel: models.UUIDModel{ID: 10}, PackageID: 1, UserID: 2}
+				s.mockPackageInterface.On("GetPackageByID", "1", s.visibleFields).Return(s.publicPackage, nil).Once()
+				s.mockCommentInterface.On("GetCommentByID", "10").Return(comment, nil).Once()
+				s.mockCommentInterface.On("DeleteComment", comment).Return(nil).Once()
+			},
+		},
+		{
+			name:   "Happy path - package author",
+			userID: "3",
+			setup: func() {
+				comment := &models.Comment{UUIDModel: models.UUIDModel{ID: 10}, PackageID: 1, UserID: 2}
+				s.mockPackageInterface.On("GetPackageByID", "1", s.visibleFields).Return(s.publicPackage, nil).Once()
+				s.mockCommentInterface.On("GetCommentByID", "10").Return(comment, nil).Once()
+				s.mockCommentInterface.On("DeleteComment", comment).Return(nil).Once()
+			},
+		},
+		{
+			name:   "Sad path - another user",
+			userID: "4",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", "1", s.visibleFields).Return(s.publicPackage, nil).Once()
+				s.mockCommentInterface.On("GetCommentByID", "10").Return(&models.Comment{UUIDModel: models.UUIDModel{ID: 10}, PackageID: 1, UserID: 2}, nil).Once()
+				s.mockLang.On("Get", "forbidden.delete_comment").Return("forbidden").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusUnauthorized, "forbidden"),
+		},
+		{
+			name:   "Sad path - GetCommentByID returns error",
+			userID: "2",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", "1", s.visibleFields).Return(s.publicPackage, nil).Once()
+				s.mockCommentInterface.On("GetCommentByID", "10").Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			test.setup()
+
+			s.Equal(test.expectedErr, s.commentImpl.DeleteComment(s.ctx, test.userID, "1", "10"))
+
+			s.mockCommentInterface.AssertExpectations(s.T())
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *CommentTestSuite) TestListComments() {
+	var (
+		pagination = &protobase.Pagination{Page: 1, Limit: 10}
+		users      = []*protouser.User{
+			{Id: "2", Name: "user"},
+			{Id: "3", Name: "author"},
+		}
+
+		mockConfig   *mocksconfig.Config
+		mockOrm      *mocksorm.Orm
+		mockOrmQuery *mocksorm.Query
+	)
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockConfig = mockFactory.Config()
+		mockOrm = mockFactory.Orm()
+		mockFactory.Log()
+		mockOrmQuery = mockFactory.OrmQuery()
+		s.mockPackageInterface.On("GetPackageByID", "1", s.visibleFields).Return(s.publicPackage, nil).Once()
+		mockOrm.On("Query").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("WithTrashed").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Scopes", mock.Anything).Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Order", "id DESC").Return(mockOrmQuery).Once()
+	}
+
+	beforeRepliesSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockConfig = mockFactory.Config()
+		mockOrm = mockFactory.Orm()
+		mockFactory.Log()
+		mockOrmQuery = mockFactory.OrmQuery()
+		s.mockPackageInterface.On("GetPackageByID", "1", s.visibleFields).Return(s.publicPackage, nil).Once()
+		mockOrm.On("Query").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Scopes", mock.Anything).Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Order", "id ASC").Return(mockOrmQuery).Once()
+	}
+
+	threads := func(args mock.Arguments) {
+		deleted := &models.Comment{UUIDModel: models.UUIDModel{ID: 20}, PackageID: 1, UserID: 4}
+		deleted.DeletedAt.Valid = true
+		*args.Get(2).(*[]*models.Comment) = []*models.Comment{
+			deleted,
+			{UUIDModel: models.UUIDModel{ID: 10}, PackageID: 1, UserID: 2, Content: "Question"},
+		}
+		*args.Get(3).(*int64) = 2
+	}
+
+	tests := []struct {
+		name        string
+		parentID    string
+		setup       func()
+		assert      func(comments []*models.Comment)
+		expectTotal int64
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				beforeSetup()
+				mockOrmQuery.On("Paginate", 1, 10, mock.AnythingOfType("*[]*models.Comment"), mock.AnythingOfType("*int64")).Run(threads).Return(nil).Once()
+				mockConfig.On("GetInt", "package.comment_replies_limit", 3).Return(1).Once()
+				s.mockCommentInterface.On("GetReplies", []uint64{20, 10}, 2).Return([]*models.Comment{
+					{UUIDModel: models.UUIDModel{ID: 21}, PackageID: 1, UserID: 2, ParentID: 20, Content: "Reply"},
+					{UUIDModel: models.UUIDModel{ID: 11}, PackageID: 1, UserID: 3, ParentID: 10, Content: "Answer"},
+					{UUIDModel: models.UUIDModel{ID: 12}, PackageID: 1, UserID: 2, ParentID: 10, Content: "Thanks"},
+				}, nil).Once()
+				s.mockMarkdownService.On("Render", "Question", "").Return("<p>Question</p>", nil).Once()
+				s.mockMarkdownService.On("Render", "Reply", "").Return("<p>Reply</p>", nil).Once()
+				s.mockMarkdownService.On("Render", "Answer", "").Return("<p>Answer</p>", nil).Once()
+				s.mockUserService.On("GetUsers", s.ctx, []string{"2", "2", "3"}).Return(users, nil).Once()
+			},
+			assert: func(comments []*models.Comment) {
+				s.Len(comments, 2)
+
+				s.Equal(uint64(20), comments[0].ID)
+				s.Nil(comments[0].User)
+				s.Empty(comments[0].ContentHTML)
+				s.Len(comments[0].Replies, 1)
+				s.Equal(uint64(21), comments[0].Replies[0].ID)
+				s.Equal(users[0], comments[0].Replies[0].User)
+				s.Equal("<p>Reply</p>", comments[0].Replies[0].ContentHTML)
+				s.False(comments[0].HasMoreReplies)
+
+				s.Equal(uint64(10), comments[1].ID)
+				s.Equal(users[0], comments[1].User)
+				s.Equal("<p>Question</p>", comments[1].ContentHTML)
+				s.False(comments[1].IsPackageAuthor)
+				s.Len(comments[1].Replies, 1)
+				s.Equal(users[1], comments[1].Replies[0].User)
+				s.True(comments[1].Replies[0].IsPackageAuthor)
+				s.True(comments[1].HasMoreReplies)
+			},
+			expectTotal: 2,
+		},
+		{
+			name:     "Happy path - list the replies of the parent",
+			parentID: "10",
+			setup: func() {
+				beforeRepliesSetup()
+				mockOrmQuery.On("Paginate", 1, 10, mock.AnythingOfType("*[]*models.Comment"), mock.AnythingOfType("*int64")).Run(func(args mock.Arguments) {
+					*args.Get(2).(*[]*models.Comment) = []*models.Comment{
+						{UUIDModel: models.UUIDModel{ID: 11}, PackageID: 1, UserID: 3, ParentID: 10, Content: "Answer"},
+						{UUIDModel: models.UUIDModel{ID: 12}, PackageID: 1, UserID: 2, ParentID: 10, Content: "Thanks"},
+					}
+					*args.Get(3).(*int64) = 2
+				}).Return(nil).Once()
+				s.mockMarkdownService.On("Render", "Answer", "").Return("<p>Answer</p>", nil).Once()
+				s.mockMarkdownService.On("Render", "Thanks", "").Return("<p>Thanks</p>", nil).Once()
+				s.mockUserService.On("GetUsers", s.ctx, []string{"3", "2"}).Return(users, nil).Once()
+			},
+			assert: func(comments []*models.Comment) {
+				s.Len(comments, 2)
+				s.Equal(users[1], comments[0].User)
+				s.Equal("<p>Answer</p>", comments[0].ContentHTML)
+				s.True(comments[0].IsPackageAuthor)
+				s.Equal(users[0], comments[1].User)
+				s.Equal("<p>Thanks</p>", comments[1].ContentHTML)
+				s.False(comments[1].IsPackageAuthor)
+			},
+			expectTotal: 2,
+		},
+		{
+			name:     "Sad path - Paginate of the replies returns error",
+			parentID: "10",
+			setup: func() {
+				beforeRepliesSetup()
+				mockOrmQuery.On("Paginate", 1, 10, mock.AnythingOfType("*[]*models.Comment"), mock.AnythingOfType("*int64")).Return(errors.New("error")).Once()
+			},
+			assert: func(comments []*models.Comment) {
+				s.Nil(comments)
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+		{
+			name: "Happy path - no comments",
+			setup: func() {
+				beforeSetup()
+				mockOrmQuery.On("Paginate", 1, 10, mock.AnythingOfType("*[]*models.Comment"), mock.AnythingOfType("*int64")).Return(nil).Once()
+			},
+			assert: func(comments []*models.Comment) {
+				s.Empty(comments)
+			},
+		},
+		{
+			name: "Sad path - Paginate returns error",
+			setup: func() {
+				beforeSetup()
+				mockOrmQuery.On("Paginate", 1, 10, mock.AnythingOfType("*[]*models.Comment"), mock.AnythingOfType("*int64")).Return(errors.New("error")).Once()
+			},
+			assert: func(comments []*models.Comment) {
+				s.Nil(comments)
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+		{
+			name: "Sad path - GetReplies returns error",
+			setup: func() {
+				beforeSetup()
+				mockOrmQuery.On("Paginate", 1, 10, mock.AnythingOfType("*[]*models.Comment"), mock.AnythingOfType("*int64")).Run(threads).Return(nil).Once()
+				mockConfig.On("GetInt", "package.comment_replies_limit", 3).Return(3).Once()
+				s.mockCommentInterface.On("GetReplies", []uint64{20, 10}, 4).Return(nil, errors.New("error")).Once()
+			},
+			assert: func(comments []*models.Comment) {
+				s.Nil(comments)
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			name: "Sad path - Render returns error",
+			setup: func() {
+				beforeSetup()
+				mockOrmQuery.On("Paginate", 1, 10, mock.AnythingOfType("*[]*models.Comment"), mock.AnythingOfType("*int64")).Run(threads).Return(nil).Once()
+				mockConfig.On("GetInt", "package.comment_replies_limit", 3).Return(3).Once()
+				s.mockCommentInterface.On("GetReplies", []uint64{20, 10}, 4).Return([]*models.Comment{}, nil).Once()
+				s.mockMarkdownService.On("Render", "Question", "").Return("", errors.New("error")).Once()
+			},
+			assert: func(comments []*models.Comment) {
+				s.Nil(comments)
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			name: "Sad path - GetUsers returns error",
+			setup: func() {
+				beforeSetup()
+				mockOrmQuery.On("Paginate", 1, 10, mock.AnythingOfType("*[]*models.Comment"), mock.AnythingOfType("*int64")).Run(threads).Return(nil).Once()
+				mockConfig.On("GetInt", "package.comment_replies_limit", 3).Return(3).Once()
+				s.mockCommentInterface.On("GetReplies", []uint64{20, 10}, 4).Return([]*models.Comment{}, nil).Once()
+				s.mockMarkdownService.On("Render", "Question", "").Return("<p>Question</p>", nil).Once()
+				s.mockUserService.On("GetUsers", s.ctx, []string{"2"}).Return(nil, errors.New("error")).Once()
+			},
+			assert: func(comments []*models.Comment) {
+				s.Nil(comments)
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			test.setup()
+
+			comments, total, nextCursor, err := s.commentImpl.ListComments(s.ctx, "", "1", test.parentID, pagination)
+			test.assert(comments)
+			s.Equal(test.expectTotal, total)
+			s.Empty(nextCursor)
+			s.Equal(test.expectedErr, err)
+
+			mockConfig.AssertExpectations(s.T())
+			mockOrm.AssertExpectations(s.T())
+			mockOrmQuery.AssertExpectations(s.T())
+			s.mockCommentInterface.AssertExpectations(s.T())
+			s.mockMarkdownService.AssertExpectations(s.T())
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockUserService.AssertExpectations(s.T())
+		})
+	}
+}
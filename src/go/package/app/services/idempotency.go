@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/goravel/framework/facades"
+	"google.golang.org/protobuf/proto"
+
+	"market.goravel.dev/utils/errors"
+)
+
+const (
+	idempotencyKey     = "idempotency:%s:%s"
+	idempotencyLockKey = "idempotency:%s:%s:lock"
+)
+
+// Idempotency replays the responses of the requests carrying an idempotency key, so the clients can retry a request
+// after a timeout without repeating its side effects.
+type Idempotency interface {
+	Do(ctx context.Context, scope, key string, req, resp proto.Message, handler func() (proto.Message, error)) error
+}
+
+type IdempotencyImpl struct {
+}
+
+func NewIdempotencyImpl() *IdempotencyImpl {
+	return &IdempotencyImpl{}
+}
+
+// idempotencyRecord is the cached result of a request, the request hash detects the key being reused by a different
+// request.
+type idempotencyRecord struct {
+	RequestHash string `json:"request_hash"`
+	Response    []byte `json:"response"`
+}
+
+// Do fills resp with the cached response of the key in the scope, or calls the handler and caches its response for
+// package.idempotency_ttl_hours. The handler is called directly if the key is empty, the failed responses aren't
+// cached so the request can be retried with the same key.
+func (r *IdempotencyImpl) Do(ctx context.Context, scope, key string, req, resp proto.Message, handler func() (proto.Message, error)) error {
+	if key == "" {
+		return r.handle(resp, handler)
+	}
+
+	requestHash, err := r.hash(req)
+	if err != nil {
+		return err
+	}
+
+	cacheKey := fmt.Sprintf(idempotencyKey, scope, key)
+	if replayed, err := r.replay(ctx, cacheKey, requestHash, resp); replayed || err != nil {
+		return err
+	}
+
+	lockKey := fmt.Sprintf(idempotencyLockKey, scope, key)
+	lock := facades.Cache().Lock(lockKey, time.Minute)
+	if !lock.Get() {
+		return errors.NewConflict(facades.Lang(ctx).Get("conflict.idempotency_in_progress"))
+	}
+	defer lock.Release()
+
+	// The request may have been finished while the lock was being acquired.
+	if replayed, err := r.replay(ctx, cacheKey, requestHash, resp); replayed || err != nil {
+		return err
+	}
+
+	if err := r.handle(resp, handler); err != nil {
+		return err
+	}
+
+	response, err := proto.Marshal(resp)
+	if err != nil {
+		return errors.NewInternalServerError(err)
+	}
+	record, err := json.Marshal(idempotencyRecord{
+		RequestHash: requestHash,
+		Response:    response,
+	})
+	if err != nil {
+		return errors.NewInternalServerError(err)
+	}
+
+	ttl := time.Duration(facades.Config().GetInt("package.idempotency_ttl_hours", 24)) * time.Hour
+	if err := facades.Cache().Put(cacheKey, string(record), ttl); err != nil {
+		return errors.NewInternalServerError(err)
+	}
+
+	return nil
+}
+
+func (r *IdempotencyImpl) handle(resp proto.Message, handler func() (proto.Message, error)) error {
+	result, err := handler()
+	if err != nil {
+		return err
+	}
+
+	proto.Merge(resp, result)
+
+	return nil
+}
+
+func (r *IdempotencyImpl) hash(req proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", errors.NewInternalServerError(err)
+	}
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// replay fills resp with the cached response and reports whether the key has a cached response.
+func (r *IdempotencyImpl) replay(ctx context.Context, cacheKey, requestHash string, resp proto.Message) (bool, error) {
+	cached := facades.Cache().GetString(cacheKey)
+	if cached == "" {
+		return false, nil
+	}
+
+	var record idempotencyRecord
+	if err := json.Unmarshal([]byte(cached), &record); err != nil {
+		return false, errors.NewInternalServerError(err)
+	}
+	if record.RequestHash != requestHash {
+		return false, errors.NewConflict(facades.Lang(ctx).Get("conflict.idempotency_key"))
+	}
+	if err := proto.Unmarshal(record.Response, resp); err != nil {
+		return false, errors.NewInternalServerError(err)
+	}
+
+	return true, nil
+}
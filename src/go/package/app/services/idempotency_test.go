@@ -0,0 +1,183 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	mockscache "github.com/goravel/framework/mocks/cache"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mockstranslation "github.com/goravel/framework/mocks/translation"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
+
+	protopackage "market.goravel.dev/proto/package"
+	utilserrors "market.goravel.dev/utils/errors"
+	utilsresponse "market.goravel.dev/utils/response"
+)
+
+type IdempotencyTestSuite struct {
+	suite.Suite
+	ctx             context.Context
+	idempotencyImpl *IdempotencyImpl
+	mockCache       *mockscache.Cache
+	mockConfig      *mocksconfig.Config
+	mockLang        *mockstranslation.Translator
+	mockLock        *mockscache.Lock
+}
+
+func TestIdempotencyTestSuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyTestSuite))
+}
+
+func (s *IdempotencyTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	mockFactory := testingmock.Factory()
+	s.mockCache = mockFactory.Cache()
+	s.mockConfig = mockFactory.Config()
+	s.mockLang = mockFactory.Lang(s.ctx)
+	s.mockLock = mockFactory.CacheLock()
+	mockFactory.Log()
+	s.idempotencyImpl = NewIdempotencyImpl()
+}
+
+func (s *IdempotencyTestSuite) TestDo() {
+	var (
+		cacheKey = "idempotency:create_package:1:key"
+		lockKey  = "idempotency:create_package:1:key:lock"
+		req      = &protopackage.CreatePackageRequest{UserId: "1", Name: "goravel/gin"}
+		response = &protopackage.CreatePackageResponse{
+			Status:  utilsresponse.NewOkStatus(),
+			Package: &protopackage.Package{Id: "1", Name: "goravel/gin"},
+		}
+	)
+
+	requestHash, err := s.idempotencyImpl.hash(req)
+	s.Require().NoError(err)
+	responseData, err := proto.Marshal(response)
+	s.Require().NoError(err)
+	record, err := json.Marshal(idempotencyRecord{RequestHash: requestHash, Response: responseData})
+	s.Require().NoError(err)
+	otherRecord, err := json.Marshal(idempotencyRecord{RequestHash: "other", Response: responseData})
+	s.Require().NoError(err)
+
+	tests := []struct {
+		name             string
+		key              string
+		setup            func()
+		handlerErr       error
+		expectCalled     bool
+		expectedResponse *protopackage.CreatePackageResponse
+		expectedErr      error
+	}{
+		{
+			name:             "Happy path - no key",
+			setup:            func() {},
+			expectCalled:     true,
+			expectedResponse: response,
+		},
+		{
+			name: "Happy path - first request is cached",
+			key:  "key",
+			setup: func() {
+				s.mockCache.On("GetString", cacheKey).Return("").Twice()
+				s.mockCache.On("Lock", lockKey, time.Minute).Return(s.mockLock).Once()
+				s.mockLock.On("Get").Return(true).Once()
+				s.mockLock.On("Release").Return(true).Once()
+				s.mockConfig.On("GetInt", "package.idempotency_ttl_hours", 24).Return(24).Once()
+				s.mockCache.On("Put", cacheKey, mock.MatchedBy(func(cached string) bool {
+					var r idempotencyRecord
+					return json.Unmarshal([]byte(cached), &r) == nil && r.RequestHash == requestHash
+				}), 24*time.Hour).Return(nil).Once()
+			},
+			expectCalled:     true,
+			expectedResponse: response,
+		},
+		{
+			name: "Happy path - retry replays the cached response",
+			key:  "key",
+			setup: func() {
+				s.mockCache.On("GetString", cacheKey).Return(string(record)).Once()
+			},
+			expectedResponse: response,
+		},
+		{
+			name: "Happy path - request finished while acquiring the lock",
+			key:  "key",
+			setup: func() {
+				s.mockCache.On("GetString", cacheKey).Return("").Once()
+				s.mockCache.On("Lock", lockKey, time.Minute).Return(s.mockLock).Once()
+				s.mockLock.On("Get").Return(true).Once()
+				s.mockLock.On("Release").Return(true).Once()
+				s.mockCache.On("GetString", cacheKey).Return(string(record)).Once()
+			},
+			expectedResponse: response,
+		},
+		{
+			name: "Sad path - key is used by a different request",
+			key:  "key",
+			setup: func() {
+				s.mockCache.On("GetString", cacheKey).Return(string(otherRecord)).Once()
+				s.mockLang.On("Get", "conflict.idempotency_key").Return("key reused").Once()
+			},
+			expectedErr: utilserrors.NewConflict("key reused"),
+		},
+		{
+			name: "Sad path - request with the key is in progress",
+			key:  "key",
+			setup: func() {
+				s.mockCache.On("GetString", cacheKey).Return("").Once()
+				s.mockCache.On("Lock", lockKey, time.Minute).Return(s.mockLock).Once()
+				s.mockLock.On("Get").Return(false).Once()
+				s.mockLang.On("Get", "conflict.idempotency_in_progress").Return("in progress").Once()
+			},
+			expectedErr: utilserrors.NewConflict("in progress"),
+		},
+		{
+			name: "Sad path - failed response isn't cached",
+			key:  "key",
+			setup: func() {
+				s.mockCache.On("GetString", cacheKey).Return("").Twice()
+				s.mockCache.On("Lock", lockKey, time.Minute).Return(s.mockLock).Once()
+				s.mockLock.On("Get").Return(true).Once()
+				s.mockLock.On("Release").Return(true).Once()
+			},
+			handlerErr:   errors.New("error"),
+			expectCalled: true,
+			expectedErr:  errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			test.setup()
+
+			var called bool
+			var resp protopackage.CreatePackageResponse
+			err := s.idempotencyImpl.Do(s.ctx, "create_package:1", test.key, req, &resp, func() (proto.Message, error) {
+				called = true
+				if test.handlerErr != nil {
+					return nil, test.handlerErr
+				}
+
+				return response, nil
+			})
+
+			s.Equal(test.expectCalled, called)
+			s.Equal(test.expectedErr, err)
+			if test.expectedResponse != nil {
+				s.True(proto.Equal(test.expectedResponse, &resp))
+			}
+
+			s.mockCache.AssertExpectations(s.T())
+			s.mockLock.AssertExpectations(s.T())
+			s.mockConfig.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/goravel/framework/facades"
+
+	"market.goravel.dev/package/app/markdown"
+	"market.goravel.dev/utils/errors"
+)
+
+// markdownKey is the cached HTML of a markdown, the version is bumped when the rendering changes to skip the stale HTML.
+const markdownKey = "markdown:v1:%s"
+
+type Markdown interface {
+	Render(source, link string) (string, error)
+}
+
+type MarkdownImpl struct {
+}
+
+func NewMarkdownImpl() *MarkdownImpl {
+	return &MarkdownImpl{}
+}
+
+// Render renders the markdown to sanitized HTML, the relative links are resolved against the link. The HTML is cached
+// for package.markdown_cache_days by the hash of the markdown and the link, so the edited markdown is rendered again.
+func (r *MarkdownImpl) Render(source, link string) (string, error) {
+	if source == "" {
+		return "", nil
+	}
+
+	sum := sha256.Sum256([]byte(link + "\x00" + source))
+	cacheKey := fmt.Sprintf(markdownKey, hex.EncodeToString(sum[:]))
+	if html := facades.Cache().GetString(cacheKey); html != "" {
+		return html, nil
+	}
+
+	html, err := markdown.Render(source, link)
+	if err != nil {
+		return "", errors.NewInternalServerError(err)
+	}
+
+	// The HTML can be rendered again, so a failed cache write doesn't fail the request.
+	ttl := time.Duration(facades.Config().GetInt("package.markdown_cache_days", 7)) * 24 * time.Hour
+	if err := facades.Cache().Put(cacheKey, html, ttl); err != nil {
+		facades.Log().Errorf("cache markdown error: %+v", err)
+	}
+
+	return html, nil
+}
@@ -0,0 +1,99 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	mockscache "github.com/goravel/framework/mocks/cache"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MarkdownTestSuite struct {
+	suite.Suite
+	markdownImpl *MarkdownImpl
+	mockCache    *mockscache.Cache
+	mockConfig   *mocksconfig.Config
+}
+
+func TestMarkdownTestSuite(t *testing.T) {
+	suite.Run(t, new(MarkdownTestSuite))
+}
+
+func (s *MarkdownTestSuite) SetupTest() {
+	mockFactory := testingmock.Factory()
+	s.mockCache = mockFactory.Cache()
+	s.mockConfig = mockFactory.Config()
+	mockFactory.Log()
+	s.markdownImpl = NewMarkdownImpl()
+}
+
+func (s *MarkdownTestSuite) TestRender() {
+	var (
+		source = "# Hello"
+		link   = "https://github.com/goravel/gin"
+		html   = "<h1 id=\"hello\">Hello</h1>\n"
+	)
+
+	sum := sha256.Sum256([]byte(link + "\x00" + source))
+	cacheKey := "markdown:v1:" + hex.EncodeToString(sum[:])
+
+	tests := []struct {
+		name         string
+		source       string
+		setup        func()
+		expectedHTML string
+	}{
+		{
+			name:   "Happy path - empty markdown",
+			setup:  func() {},
+			source: "",
+		},
+		{
+			name:   "Happy path - cached HTML",
+			source: source,
+			setup: func() {
+				s.mockCache.On("GetString", cacheKey).Return("cached").Once()
+			},
+			expectedHTML: "cached",
+		},
+		{
+			name:   "Happy path - HTML is rendered and cached",
+			source: source,
+			setup: func() {
+				s.mockCache.On("GetString", cacheKey).Return("").Once()
+				s.mockConfig.On("GetInt", "package.markdown_cache_days", 7).Return(7).Once()
+				s.mockCache.On("Put", cacheKey, html, 7*24*time.Hour).Return(nil).Once()
+			},
+			expectedHTML: html,
+		},
+		{
+			name:   "Happy path - failed cache write is logged",
+			source: source,
+			setup: func() {
+				s.mockCache.On("GetString", cacheKey).Return("").Once()
+				s.mockConfig.On("GetInt", "package.markdown_cache_days", 7).Return(7).Once()
+				s.mockCache.On("Put", cacheKey, html, 7*24*time.Hour).Return(errors.New("error")).Once()
+			},
+			expectedHTML: html,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			test.setup()
+
+			result, err := s.markdownImpl.Render(test.source, link)
+			s.NoError(err)
+			s.Equal(test.expectedHTML, result)
+
+			s.mockCache.AssertExpectations(s.T())
+			s.mockConfig.AssertExpectations(s.T())
+		})
+	}
+}
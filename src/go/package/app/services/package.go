@@ -2,6 +2,10 @@ package services
 
 import (
 	"context"
+	stderrors "errors"
+	"fmt"
+	"html"
+	"strings"
 
 	"github.com/goravel/framework/contracts/database/orm"
 	"github.com/goravel/framework/facades"
@@ -13,91 +17,125 @@ import (
 	protopackage "market.goravel.dev/proto/package"
 	protouser "market.goravel.dev/proto/user"
 	"market.goravel.dev/utils/errors"
+	utilsfieldmask "market.goravel.dev/utils/fieldmask"
+	utilspagination "market.goravel.dev/utils/pagination"
 )
 
 type Package interface {
-	CreatePackage(req *protopackage.CreatePackageRequest) (*models.Package, error)
-	GetPackages(query *protopackage.PackagesQuery, pagination *protobase.Pagination) ([]*models.Package, int64, error)
+	ApprovePackage(ctx context.Context, id string) (*models.Package, error)
+	CreatePackage(ctx context.Context, req *protopackage.CreatePackageRequest) (*models.Package, error)
+	DeletePackage(ctx context.Context, id, userID string) error
+	GetPackages(ctx context.Context, userID string, query *protopackage.PackagesQuery, pagination *protobase.Pagination) ([]*models.Package, int64, string, error)
 	GetPackageByID(id string) (*models.Package, error)
+	GetPackageBySlug(slug string) (*models.Package, error)
+	GetPendingPackages(ctx context.Context, pagination *protobase.Pagination) ([]*models.Package, int64, string, error)
+	IsPackageVisible(ctx context.Context, pkg *models.Package, userID string) (bool, error)
+	RejectPackage(ctx context.Context, id, reason string) (*models.Package, error)
+	RestorePackage(ctx context.Context, id, userID string) (*models.Package, error)
 	UpdatePackage(ctx context.Context, req *protopackage.UpdatePackageRequest) (*models.Package, error)
 }
 
 type PackageImpl struct {
-	packageModel models.PackageInterface
-	userService  User
+	maintainerModel models.MaintainerInterface
+	markdownService Markdown
+	packageModel    models.PackageInterface
+	releaseModel    models.ReleaseInterface
+	userService     User
 }
 
 func NewPackageImpl() *PackageImpl {
 	return &PackageImpl{
-		packageModel: models.NewPackage(),
-		userService:  NewUserImpl(),
+		maintainerModel: models.NewMaintainer(),
+		markdownService: NewMarkdownImpl(),
+		packageModel:    models.NewPackage(),
+		releaseModel:    models.NewRelease(),
+		userService:     NewUserImpl(),
 	}
 }
 
-func (r *PackageImpl) CreatePackage(req *protopackage.CreatePackageRequest) (*models.Package, error) {
-	pkg := models.Package{
-		UserID:        cast.ToUint64(req.GetUserId()),
-		Name:          req.GetName(),
-		Summary:       req.GetSummary(),
-		Description:   req.GetDescription(),
-		Link:          req.GetUrl(),
-		Cover:         req.GetCover(),
-		Version:       req.GetVersion(),
-		IsPublic:      req.GetIsPublic(),
-		LastUpdatedAt: carbon.DateTime{Carbon: carbon.Parse(req.GetLastUpdatedAt())},
+func (r *PackageImpl) ApprovePackage(ctx context.Context, id string) (*models.Package, error) {
+	pkg, err := r.packageModel.GetPackageByID(id, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	if pkg.ID == 0 {
+		return nil, errors.NewNotFound(facades.Lang(ctx).Get("not_exist.package"))
 	}
 
-	pkg.ID = pkg.GetID()
+	pkg.IsApproved = models.PackageApproved
+	pkg.RejectReason = ""
 
-	if err := facades.Orm().Query().Create(&pkg); err != nil {
-		return nil, errors.NewInternalServerError(err)
+	if err := r.packageModel.UpdatePackage(facades.Orm().Query(), pkg); err != nil {
+		return nil, packageConflictError(ctx, err)
 	}
 
-	// Tags
-	tags := req.GetTags()
-	if len(tags) > 0 {
-		tagsAny := make([]any, len(tags))
-		for i, tag := range tags {
-			tagsAny[i] = tag
-		}
-		existTags := make([]*models.Tag, 0, len(tags))
-		if err := facades.Orm().Query().WhereIn("name", tagsAny).Find(&existTags); err != nil {
-			return nil, errors.NewInternalServerError(err)
-		}
-		existTagMap := make(map[string]bool, len(existTags))
-		for _, tag := range existTags {
-			existTagMap[tag.Name] = true
-		}
+	return pkg, nil
+}
 
-		newTags := make([]*models.Tag, 0, len(tags))
-		for _, tag := range tags {
-			if !existTagMap[tag] {
-				newTag := models.Tag{
-					Name:   tag,
-					UserID: pkg.UserID,
-					IsShow: 1,
-				}
-				newTag.ID = newTag.GetID()
-				newTags = append(newTags, &newTag)
+func (r *PackageImpl) CreatePackage(ctx context.Context, req *protopackage.CreatePackageRequest) (*models.Package, error) {
+	pkg := models.Package{
+		UserID:         cast.ToUint64(req.GetUserId()),
+		Name:           req.GetName(),
+		Summary:        req.GetSummary(),
+		Description:    req.GetDescription(),
+		Link:           req.GetUrl(),
+		Cover:          req.GetCover(),
+		Version:        req.GetVersion(),
+		IsPublic:       models.NormalizeIsPublic(req.GetIsPublic()),
+		IsApproved:     models.PackageNotApproved,
+		LastUpdatedAt:  carbon.DateTime{Carbon: carbon.Parse(req.GetLastUpdatedAt())},
+		OrganizationID: cast.ToUint64(req.GetOrganizationId()),
+	}
+
+	if err := r.checkOrganization(ctx, pkg.OrganizationID, req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	// The package and its tags are created together, a failure of any statement leaves nothing behind.
+	if err := retryOnSlugConflict(func() error {
+		return facades.Orm().Transaction(func(tx orm.Transaction) error {
+			if err := r.packageModel.CreatePackage(tx, &pkg); err != nil {
+				return err
 			}
-		}
 
-		if len(newTags) > 0 {
-			if err := facades.Orm().Query().Create(newTags); err != nil {
-				return nil, errors.NewInternalServerError(err)
+			// Tags
+			if tags := req.GetTags(); len(tags) > 0 {
+				return r.packageModel.AttachTags(tx, &pkg, tags)
 			}
-			existTags = append(existTags, newTags...)
-		}
 
-		if err := facades.Orm().Query().Model(&pkg).Association("Tags").Replace(existTags); err != nil {
-			return nil, errors.NewInternalServerError(err)
-		}
+			return nil
+		})
+	}); err != nil {
+		return nil, packageConflictError(ctx, err)
 	}
 
 	return &pkg, nil
 }
 
-func (r *PackageImpl) GetPackages(query *protopackage.PackagesQuery, pagination *protobase.Pagination) (packages []*models.Package, total int64, err error) {
+func (r *PackageImpl) DeletePackage(ctx context.Context, id, userID string) error {
+	pkg, err := r.packageModel.GetPackageByID(id, []string{})
+	if err != nil {
+		return err
+	}
+
+	if pkg.ID == 0 {
+		return errors.NewNotFound(facades.Lang(ctx).Get("not_exist.package"))
+	}
+
+	role, err := maintainerRole(ctx, r.maintainerModel, r.userService, pkg, userID)
+	if err != nil {
+		return err
+	}
+
+	if role != models.MaintainerRoleOwner {
+		return errors.NewUnauthorized(facades.Lang(ctx).Get("forbidden.delete_package"))
+	}
+
+	return r.packageModel.DeletePackage(pkg)
+}
+
+func (r *PackageImpl) GetPackages(ctx context.Context, userID string, query *protopackage.PackagesQuery, pagination *protobase.Pagination) (packages []*models.Package, total int64, nextCursor string, err error) {
 	const (
 		categoryHot    = "hot"
 		categoryNewest = "newest"
@@ -105,72 +143,171 @@ func (r *PackageImpl) GetPackages(query *protopackage.PackagesQuery, pagination
 
 	ormQuery := facades.Orm().Query()
 
-	page := pagination.GetPage()
-	limit := pagination.GetLimit()
+	fields := packageListFields
+	search := strings.TrimSpace(query.GetSearch())
+	if search != "" {
+		// The relevance is the first order, the sort only orders the results with the same relevance.
+		ormQuery = searchPackages(ormQuery, search, fields)
+	} else {
+		ormQuery = ormQuery.Select(fields)
+	}
 
-	category := query.GetCategory()
+	sort := query.GetSort()
+	if sort == "" {
+		// The category is kept for the clients that don't use the sort yet.
+		switch query.GetCategory() {
+		case categoryHot:
+			sort = models.PackageSortViews
+		case categoryNewest:
+			sort = models.PackageSortCreated
+		}
+	}
 
-	switch category {
-	case categoryHot:
-		ormQuery = ormQuery.OrderByDesc("view_count")
-	case categoryNewest:
-		ormQuery = ormQuery.OrderByDesc("created_at")
+	// The newest packages come first without a sort.
+	order := utilspagination.Order{Desc: true}
+	if column, ok := models.PackageSortColumns[sort]; ok {
+		direction := query.GetDirection()
+		if direction == "" {
+			direction = "desc"
+			if sort == models.PackageSortName {
+				direction = "asc"
+			}
+		}
+
+		order = utilspagination.Order{Column: column, Desc: direction == "desc"}
 	}
 
+	// The relevance can't be paginated by keyset, so the search results are paginated by offset.
+	order.ByOffset = search != ""
+
+	ormQuery = filterPackagesByTags(ormQuery, query.GetTagIds(), query.GetTagNames(), query.GetTagMatch() == models.TagMatchAll)
+
 	name := query.GetName()
 	if name != "" {
 		// fuzzy search
 		ormQuery = ormQuery.Where("name LIKE ?", "%"+name+"%")
 	}
 
-	userID := query.GetUserId()
-	if userID != "" {
-		ormQuery = ormQuery.Where("user_id = ?", userID)
+	if queryUserID := query.GetUserId(); queryUserID != "" {
+		ormQuery = ormQuery.Where("user_id = ?", queryUserID)
 	}
 
-	if err := ormQuery.With("Tags", func(query orm.Query) orm.Query {
-		return query.Where("is_show = ?", "1").Select([]string{"id", "name"})
-	}).Select([]string{"id", "name", "user_id", "summary", "link", "view_count"}).Paginate(int(page), int(limit), &packages, &total); err != nil {
-		return nil, 0, errors.NewInternalServerError(err)
+	visibleScope, err := visiblePackages(r.userService, userID)
+	if err != nil {
+		return nil, 0, "", err
 	}
 
-	userIDs := make([]string, len(packages))
-	for i, pkg := range packages {
-		userIDs[i] = cast.ToString(pkg.UserID)
+	ormQuery = ormQuery.Scopes(visibleScope).With("Tags", func(query orm.Query) orm.Query {
+		return query.Where("is_show = ?", "1").Select([]string{"id", "name"})
+	})
+
+	nextCursor, err = utilspagination.Paginate(ormQuery, pagination, &order, func(pkg *models.Package) (any, uint64) {
+		return pkg.SortValue(sort), pkg.ID
+	}, &packages, &total)
+	if err != nil {
+		return nil, 0, "", paginationError(ctx, err)
 	}
 
-	var users []*protouser.User
-	if len(packages) > 0 {
-		users, err = r.userService.GetUsers(context.Background(), userIDs)
-		if err != nil {
-			return nil, 0, errors.NewInternalServerError(err)
+	if search != "" {
+		for _, pkg := range packages {
+			pkg.Highlight = markHighlight(pkg.Highlight)
 		}
 	}
 
-	userMap := make(map[string]*protouser.User)
-	for _, user := range users {
-		userMap[user.GetId()] = user
+	if err := fillPackageUsers(r.userService, packages); err != nil {
+		return nil, 0, "", err
 	}
 
-	for _, pkg := range packages {
-		pkg.User = userMap[cast.ToString(pkg.UserID)]
+	return packages, total, nextCursor, nil
+}
+
+func (r *PackageImpl) GetPackageByID(id string) (*models.Package, error) {
+	pkg, err := r.packageModel.GetPackageByID(id, packageDetailFields)
+	if err != nil {
+		return nil, err
 	}
 
-	return packages, total, nil
+	return r.fillPackageDetail(pkg)
 }
 
-func (r *PackageImpl) GetPackageByID(id string) (pkg *models.Package, err error) {
-	pkg, err = r.packageModel.GetPackageByID(id, []string{"id", "name", "user_id", "summary", "description", "link", "version", "last_updated_at", "view_count"})
+// GetPackageBySlug gets the package by its current or a previous slug, the ID of the returned package is 0 if it
+// doesn't exist.
+func (r *PackageImpl) GetPackageBySlug(slug string) (*models.Package, error) {
+	pkg, err := r.packageModel.GetPackageBySlug(slug, packageDetailFields)
 	if err != nil {
 		return nil, err
 	}
 
-	if pkg.ID > 0 {
-		user, err := r.userService.GetUser(context.Background(), pkg.UserID)
-		pkg.User = user
-		if err != nil {
-			return nil, err
-		}
+	return r.fillPackageDetail(pkg)
+}
+
+func (r *PackageImpl) GetPendingPackages(ctx context.Context, pagination *protobase.Pagination) (packages []*models.Package, total int64, nextCursor string, err error) {
+	ormQuery := facades.Orm().Query().Where("is_approved = ?", models.PackageNotApproved).With("Tags", func(query orm.Query) orm.Query {
+		return query.Where("is_show = ?", "1").Select([]string{"id", "name"})
+	})
+
+	// The oldest packages are reviewed first.
+	nextCursor, err = utilspagination.Paginate(ormQuery, pagination, &utilspagination.Order{}, func(pkg *models.Package) (any, uint64) {
+		return nil, pkg.ID
+	}, &packages, &total)
+	if err != nil {
+		return nil, 0, "", paginationError(ctx, err)
+	}
+
+	if err := fillPackageUsers(r.userService, packages); err != nil {
+		return nil, 0, "", err
+	}
+
+	return packages, total, nextCursor, nil
+}
+
+// IsPackageVisible reports whether the user can read the package, see models.Package.IsVisibleTo, the members of the
+// organization publishing the package can read it as well.
+func (r *PackageImpl) IsPackageVisible(ctx context.Context, pkg *models.Package, userID string) (bool, error) {
+	return isPackageVisible(ctx, r.userService, pkg, userID)
+}
+
+func (r *PackageImpl) RejectPackage(ctx context.Context, id, reason string) (*models.Package, error) {
+	pkg, err := r.packageModel.GetPackageByID(id, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	if pkg.ID == 0 {
+		return nil, errors.NewNotFound(facades.Lang(ctx).Get("not_exist.package"))
+	}
+
+	pkg.IsApproved = models.PackageRejected
+	pkg.RejectReason = reason
+
+	if err := r.packageModel.UpdatePackage(facades.Orm().Query(), pkg); err != nil {
+		return nil, packageConflictError(ctx, err)
+	}
+
+	return pkg, nil
+}
+
+func (r *PackageImpl) RestorePackage(ctx context.Context, id, userID string) (*models.Package, error) {
+	pkg, err := r.packageModel.GetDeletedPackageByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if pkg.ID == 0 {
+		return nil, errors.NewNotFound(facades.Lang(ctx).Get("not_exist.package"))
+	}
+
+	role, err := maintainerRole(ctx, r.maintainerModel, r.userService, pkg, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if role != models.MaintainerRoleOwner {
+		return nil, errors.NewUnauthorized(facades.Lang(ctx).Get("forbidden.restore_package"))
+	}
+
+	if err := r.packageModel.RestorePackage(pkg); err != nil {
+		return nil, packageConflictError(ctx, err)
 	}
 
 	return pkg, nil
@@ -186,30 +323,318 @@ func (r *PackageImpl) UpdatePackage(ctx context.Context, req *protopackage.Updat
 		return nil, errors.NewNotFound(facades.Lang(ctx).Get("not_exist.package"))
 	}
 
-	if pkg.UserID != cast.ToUint64(req.GetUserId()) {
+	role, err := maintainerRole(ctx, r.maintainerModel, r.userService, pkg, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if role == "" {
 		return nil, errors.NewUnauthorized(facades.Lang(ctx).Get("forbidden.update_package"))
 	}
 
-	pkg.Name = req.GetName()
-	pkg.Summary = req.GetSummary()
-	pkg.Description = req.GetDescription()
-	pkg.Link = req.GetUrl()
-	pkg.Cover = req.GetCover()
-	pkg.Version = req.GetVersion()
-	pkg.IsPublic = req.GetIsPublic()
-	pkg.LastUpdatedAt = carbon.DateTime{Carbon: carbon.Parse(req.GetLastUpdatedAt())}
+	// The package was read by the client before the update, the changes made since would be overwritten.
+	if req.GetRevision() != 0 && req.GetRevision() != pkg.Revision {
+		return nil, errors.NewConflict(facades.Lang(ctx).Get("conflict.package"))
+	}
 
-	if err := r.packageModel.UpdatePackage(pkg); err != nil {
-		return nil, err
+	// Only the fields in the update mask are changed, all of them are changed without a mask.
+	fields := utilsfieldmask.New(req.GetUpdateMask())
+
+	// The packages created before the slugs were introduced get their slugs once they are updated.
+	renamed := fields.Has("name") && pkg.Name != req.GetName() || pkg.Slug == ""
+	if fields.Has("name") {
+		pkg.Name = req.GetName()
+	}
+	if fields.Has("summary") {
+		pkg.Summary = req.GetSummary()
+	}
+	if fields.Has("description") {
+		pkg.Description = req.GetDescription()
+	}
+	if fields.Has("url") {
+		pkg.Link = req.GetUrl()
+	}
+	// The omitted cover is kept without a mask, but the masked empty cover removes it.
+	previousCover, previousCoverThumbnail := pkg.Cover, pkg.CoverThumbnail
+	if cover := req.GetCover(); (fields["cover"] || len(fields) == 0 && cover != "") && cover != previousCover {
+		// The thumbnail belongs to the uploaded cover, a linked cover has none.
+		pkg.Cover = cover
+		pkg.CoverThumbnail = ""
+	}
+	if fields.Has("is_public") {
+		pkg.IsPublic = models.NormalizeIsPublic(req.GetIsPublic())
+	}
+	// The omitted organization is kept without a mask, but the masked empty organization publishes the package by its
+	// owner. Only the owner can move the package, into an organization they are a member of.
+	organizationID := cast.ToUint64(req.GetOrganizationId())
+	if moved := fields["organization_id"] || len(fields) == 0 && organizationID != 0; moved && organizationID != pkg.OrganizationID {
+		if role != models.MaintainerRoleOwner {
+			return nil, errors.NewUnauthorized(facades.Lang(ctx).Get("forbidden.update_package"))
+		}
+		if err := r.checkOrganization(ctx, organizationID, req.GetUserId()); err != nil {
+			return nil, err
+		}
+		pkg.OrganizationID = organizationID
 	}
 
-	// Tags
-	tags := req.GetTags()
-	if len(tags) > 0 {
-		if err := r.packageModel.AttachTags(pkg, tags); err != nil {
+	// The version is derived from the latest release once the package has releases.
+	if fields.Has("version") || fields.Has("last_updated_at") {
+		releases, err := r.releaseModel.GetReleases(req.GetId())
+		if err != nil {
 			return nil, err
 		}
+		if len(releases) == 0 {
+			if fields.Has("version") {
+				pkg.Version = req.GetVersion()
+			}
+			if fields.Has("last_updated_at") {
+				pkg.LastUpdatedAt = carbon.DateTime{Carbon: carbon.Parse(req.GetLastUpdatedAt())}
+			}
+		}
+	}
+
+	// A rejected package goes back to the moderation queue once the owner updates it.
+	if pkg.IsApproved == models.PackageRejected {
+		pkg.IsApproved = models.PackageNotApproved
+		pkg.RejectReason = ""
+	}
+
+	// The package and its tags are updated together, a failure of any statement keeps the previous ones.
+	previousSlug, previousRevision := pkg.Slug, pkg.Revision
+	if err := retryOnSlugConflict(func() error {
+		// A failed attempt changed them already.
+		pkg.Slug, pkg.Revision = previousSlug, previousRevision
+
+		return facades.Orm().Transaction(func(tx orm.Transaction) error {
+			if renamed {
+				if err := r.packageModel.UpdateSlug(tx, pkg); err != nil {
+					return err
+				}
+			}
+
+			if err := r.packageModel.UpdatePackage(tx, pkg); err != nil {
+				return err
+			}
+
+			// Tags, the omitted tags are kept without a mask, but the masked empty tags clear them.
+			if tags := req.GetTags(); fields["tags"] || len(fields) == 0 && len(tags) > 0 {
+				return r.packageModel.AttachTags(tx, pkg, tags)
+			}
+
+			return nil
+		})
+	}); err != nil {
+		return nil, packageConflictError(ctx, err)
+	}
+
+	if pkg.Cover != previousCover {
+		deleteCover(pkg.ID, previousCover, previousCoverThumbnail)
 	}
 
 	return pkg, nil
 }
+
+// checkOrganization checks the user is a member of the organization publishing the package, nothing is checked for the
+// packages published by their owners.
+func (r *PackageImpl) checkOrganization(ctx context.Context, organizationID uint64, userID string) error {
+	if organizationID == 0 {
+		return nil
+	}
+
+	member, err := r.userService.GetMember(ctx, organizationID, cast.ToUint64(userID))
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return errors.NewUnauthorized(facades.Lang(ctx).Get("forbidden.organization"))
+	}
+
+	return nil
+}
+
+// packageConflictError converts models.ErrDuplicatePackageName and models.ErrPackageChanged to conflict errors, the
+// other errors are kept.
+func packageConflictError(ctx context.Context, err error) error {
+	if stderrors.Is(err, models.ErrDuplicatePackageName) {
+		return errors.NewConflict(facades.Lang(ctx).Get("exist.package"))
+	}
+	if stderrors.Is(err, models.ErrPackageChanged) {
+		return errors.NewConflict(facades.Lang(ctx).Get("conflict.package"))
+	}
+
+	return err
+}
+
+// maxSlugAttempts is how many times a transaction saving a package is run while the concurrent packages keep taking
+// its generated slug.
+const maxSlugAttempts = 3
+
+// retryOnSlugConflict runs the transaction saving the package again if a concurrent package took its generated slug,
+// the slug is generated again in the new transaction.
+func retryOnSlugConflict(transaction func() error) error {
+	var err error
+	for attempt := 0; attempt < maxSlugAttempts; attempt++ {
+		if err = transaction(); !stderrors.Is(err, models.ErrDuplicatePackageSlug) {
+			return err
+		}
+	}
+
+	return errors.NewInternalServerError(err)
+}
+
+// fillPackageDetail fills the owner and the HTML of the description shown on the package pages.
+func (r *PackageImpl) fillPackageDetail(pkg *models.Package) (*models.Package, error) {
+	if pkg.ID == 0 {
+		return pkg, nil
+	}
+
+	descriptionHTML, err := r.markdownService.Render(pkg.Description, pkg.Link)
+	if err != nil {
+		return nil, err
+	}
+	pkg.DescriptionHTML = descriptionHTML
+
+	return fillPackageUser(r.userService, pkg)
+}
+
+// visiblePackages is the models.VisiblePackages scope of the user, the organizations the user is a member of are
+// looked up in the user service.
+func visiblePackages(userService User, userID string) (func(orm.Query) orm.Query, error) {
+	if userID == "" {
+		return models.VisiblePackages(userID, nil), nil
+	}
+
+	organizationIDs, err := userService.ListUserOrganizations(context.Background(), cast.ToUint64(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	return models.VisiblePackages(userID, organizationIDs), nil
+}
+
+// fillPackageUser fills the owner of the package, the package is returned as it is if it doesn't exist.
+func fillPackageUser(userService User, pkg *models.Package) (*models.Package, error) {
+	if pkg.ID == 0 {
+		return pkg, nil
+	}
+
+	user, err := userService.GetUser(context.Background(), pkg.UserID)
+	pkg.User = user
+	if err != nil {
+		return nil, err
+	}
+
+	return pkg, nil
+}
+
+// fillPackageUsers fills the owners of the packages.
+// paginationError converts the error of utilspagination.Paginate, the cursors are opaque to the clients, so a malformed
+// cursor or a cursor of another order is a bad request instead of an internal error.
+func paginationError(ctx context.Context, err error) error {
+	if stderrors.Is(err, utilspagination.ErrInvalidCursor) {
+		return errors.NewBadRequest(facades.Lang(ctx).Get("invalid.cursor"))
+	}
+
+	return errors.NewInternalServerError(err)
+}
+
+func fillPackageUsers(userService User, packages []*models.Package) error {
+	if len(packages) == 0 {
+		return nil
+	}
+
+	userIDs := make([]string, len(packages))
+	for i, pkg := range packages {
+		userIDs[i] = cast.ToString(pkg.UserID)
+	}
+
+	users, err := userService.GetUsers(context.Background(), userIDs)
+	if err != nil {
+		return errors.NewInternalServerError(err)
+	}
+
+	userMap := make(map[string]*protouser.User)
+	for _, user := range users {
+		userMap[user.GetId()] = user
+	}
+
+	for _, pkg := range packages {
+		pkg.User = userMap[cast.ToString(pkg.UserID)]
+	}
+
+	return nil
+}
+
+// packageDetailFields are the columns of the package pages.
+var packageDetailFields = []string{"id", "name", "slug", "user_id", "summary", "description", "link", "cover", "cover_thumbnail", "version", "last_updated_at", "view_count", "stars", "license", "readme", "synced_at", "favorite_count", "rating_count", "rating_sum", "comment_count", "is_approved", "is_public", "revision", "pending_owner_id", "organization_id"}
+
+// packageListFields are the columns of the packages in listings, the large ones like the readme are left out.
+var packageListFields = []string{"id", "name", "slug", "user_id", "summary", "link", "cover", "cover_thumbnail", "view_count", "stars", "favorite_count", "rating_count", "rating_sum", "comment_count", "last_updated_at", "is_approved", "is_public", "created_at"}
+
+// packageTagExists is the condition that the package carries a shown tag matching the condition in it.
+const packageTagExists = "EXISTS (SELECT 1 FROM package_tags JOIN tags ON tags.id = package_tags.tag_id AND tags.is_show = 1 AND tags.deleted_at IS NULL WHERE package_tags.package_id = packages.id AND %s)"
+
+// filterPackagesByTags filters the packages carrying the tags given by ID or name, the packages have to carry all the
+// tags if matchAll is true, otherwise any of them. The names are normalized and resolved through the tag aliases the
+// same as AttachTags does, so "ORM" and an alias of "orm" match the packages tagged "orm".
+func filterPackagesByTags(query orm.Query, tagIDs, tagNames []string, matchAll bool) orm.Query {
+	tagNames = models.NormalizeTags(tagNames)
+
+	if matchAll {
+		for _, tagID := range tagIDs {
+			query = query.Where(fmt.Sprintf(packageTagExists, "tags.id = ?"), cast.ToUint64(tagID))
+		}
+		for _, tagName := range tagNames {
+			query = query.Where(fmt.Sprintf(packageTagExists, "(LOWER(tags.name) = ? OR tags.id IN (SELECT tag_id FROM tag_aliases WHERE name = ?))"), tagName, tagName)
+		}
+
+		return query
+	}
+
+	var (
+		conditions []string
+		args       []any
+	)
+	if len(tagIDs) > 0 {
+		ids := make([]uint64, len(tagIDs))
+		for i, tagID := range tagIDs {
+			ids[i] = cast.ToUint64(tagID)
+		}
+
+		conditions = append(conditions, "tags.id IN ?")
+		args = append(args, ids)
+	}
+	if len(tagNames) > 0 {
+		conditions = append(conditions, "LOWER(tags.name) IN ?", "tags.id IN (SELECT tag_id FROM tag_aliases WHERE name IN ?)")
+		args = append(args, tagNames, tagNames)
+	}
+
+	if len(conditions) == 0 {
+		return query
+	}
+
+	return query.Where(fmt.Sprintf(packageTagExists, "("+strings.Join(conditions, " OR ")+")"), args...)
+}
+
+// searchPackages filters the packages matching the search by the full-text search of Postgres and orders them by
+// relevance, the highlight of each package is built from its summary and description.
+func searchPackages(query orm.Query, search string, fields []string) orm.Query {
+	const tsQuery = "websearch_to_tsquery('english', ?)"
+
+	return query.Select(fmt.Sprintf("%s, ts_rank_cd(search_vector, %s) AS search_rank, ts_headline('english', concat_ws(' ', summary, description), %s, 'StartSel=%s, StopSel=%s, MaxWords=20, MinWords=5, MaxFragments=2') AS highlight", strings.Join(fields, ", "), tsQuery, tsQuery, highlightStartSel, highlightStopSel), search, search).
+		Where("search_vector @@ "+tsQuery, search).
+		Order("search_rank DESC")
+}
+
+// highlightStartSel and highlightStopSel wrap the matches in the highlights before the text is escaped, they are
+// replaced by <mark></mark> afterward by markHighlight.
+const (
+	highlightStartSel = "[[mark]]"
+	highlightStopSel  = "[[/mark]]"
+)
+
+// markHighlight escapes the highlight, the text is written by the package authors and the clients render the highlight
+// as HTML to show the marks, then replaces the placeholders around the matches with <mark></mark>.
+func markHighlight(highlight string) string {
+	return strings.NewReplacer(highlightStartSel, "<mark>", highlightStopSel, "</mark>").Replace(html.EscapeString(highlight))
+}
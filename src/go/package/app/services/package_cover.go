@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/goravel/framework/contracts/filesystem"
+	"github.com/goravel/framework/facades"
+
+	"market.goravel.dev/package/app/models"
+	"market.goravel.dev/utils/errors"
+	"market.goravel.dev/utils/image"
+)
+
+// packageCoverDir is the directory of the uploaded covers of a package on the cover disk.
+const packageCoverDir = "covers/%d"
+
+// PackageCover stores the cover images uploaded for the packages.
+type PackageCover interface {
+	DeleteCovers(packages []*models.Package)
+	UploadCover(ctx context.Context, id, userID string, data []byte) (*models.Package, error)
+}
+
+type PackageCoverImpl struct {
+	maintainerModel models.MaintainerInterface
+	packageModel    models.PackageInterface
+	userService     User
+}
+
+func NewPackageCoverImpl() *PackageCoverImpl {
+	return &PackageCoverImpl{
+		maintainerModel: models.NewMaintainer(),
+		packageModel:    models.NewPackage(),
+		userService:     NewUserImpl(),
+	}
+}
+
+// DeleteCovers deletes the uploaded cover files of the purged packages.
+func (r *PackageCoverImpl) DeleteCovers(packages []*models.Package) {
+	for _, pkg := range packages {
+		deleteCover(pkg.ID, pkg.Cover, pkg.CoverThumbnail)
+	}
+}
+
+// UploadCover replaces the cover of the package by the uploaded image and its thumbnail, the previously uploaded
+// cover is deleted once the package is saved.
+func (r *PackageCoverImpl) UploadCover(ctx context.Context, id, userID string, data []byte) (*models.Package, error) {
+	pkg, err := r.packageModel.GetPackageByID(id, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	if pkg.ID == 0 {
+		return nil, errors.NewNotFound(facades.Lang(ctx).Get("not_exist.package"))
+	}
+
+	role, err := maintainerRole(ctx, r.maintainerModel, r.userService, pkg, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if role == "" {
+		return nil, errors.NewUnauthorized(facades.Lang(ctx).Get("forbidden.update_package"))
+	}
+
+	options := coverOptions()
+	img, err := image.Process(data, options)
+	if err != nil {
+		return nil, image.TranslateError(ctx, err, options)
+	}
+
+	cover, coverThumbnail, err := image.Store(coverDisk(), fmt.Sprintf(packageCoverDir, pkg.ID), img)
+	if err != nil {
+		return nil, errors.NewInternalServerError(err)
+	}
+
+	previousCover, previousCoverThumbnail := pkg.Cover, pkg.CoverThumbnail
+	pkg.Cover = cover
+	pkg.CoverThumbnail = coverThumbnail
+	if err := r.packageModel.UpdatePackage(facades.Orm().Query(), pkg); err != nil {
+		deleteCover(pkg.ID, cover, coverThumbnail)
+
+		return nil, packageConflictError(ctx, err)
+	}
+
+	deleteCover(pkg.ID, previousCover, previousCoverThumbnail)
+
+	return pkg, nil
+}
+
+func coverDisk() filesystem.Driver {
+	return facades.Storage().Disk(facades.Config().GetString("package.cover.disk", "public"))
+}
+
+func coverOptions() image.Options {
+	config := facades.Config()
+
+	return image.Options{
+		MaxSize:         config.GetInt("package.cover.max_size_kb", 2048) * 1024,
+		MinWidth:        config.GetInt("package.cover.min_width", 320),
+		MinHeight:       config.GetInt("package.cover.min_height", 180),
+		MaxWidth:        config.GetInt("package.cover.max_width", 4096),
+		MaxHeight:       config.GetInt("package.cover.max_height", 4096),
+		ThumbnailWidth:  config.GetInt("package.cover.thumbnail_width", 480),
+		ThumbnailHeight: config.GetInt("package.cover.thumbnail_height", 270),
+	}
+}
+
+// deleteCover deletes the uploaded cover files of the package, the linked covers are skipped. A failure is only logged
+// since the package has been saved, the file is left orphaned.
+func deleteCover(packageID uint64, urls ...string) {
+	if strings.Join(urls, "") == "" {
+		return
+	}
+
+	if err := image.Delete(coverDisk(), fmt.Sprintf(packageCoverDir, packageID), urls...); err != nil {
+		facades.Log().Errorf("delete cover of package %d error: %+v", packageID, err)
+	}
+}
@@ -0,0 +1,220 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/translation"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	mocksfilesystem "github.com/goravel/framework/mocks/filesystem"
+	mockstranslation "github.com/goravel/framework/mocks/translation"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	mocksmodels "market.goravel.dev/package/app/mocks/models"
+	"market.goravel.dev/package/app/models"
+	utilserrors "market.goravel.dev/utils/errors"
+)
+
+type PackageCoverTestSuite struct {
+	suite.Suite
+	ctx                     context.Context
+	mockConfig              *mocksconfig.Config
+	mockDisk                *mocksfilesystem.Driver
+	mockLang                *mockstranslation.Translator
+	mockOrm                 *mocksorm.Orm
+	mockOrmQuery            *mocksorm.Query
+	mockMaintainerInterface *mocksmodels.MaintainerInterface
+	mockPackageInterface    *mocksmodels.PackageInterface
+	mockStorage             *mocksfilesystem.Storage
+	packageCoverImpl        *PackageCoverImpl
+}
+
+func TestPackageCoverTestSuite(t *testing.T) {
+	suite.Run(t, new(PackageCoverTestSuite))
+}
+
+func (s *PackageCoverTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	mockFactory := testingmock.Factory()
+	s.mockConfig = mockFactory.Config()
+	s.mockLang = mockFactory.Lang(s.ctx)
+	s.mockOrm = mockFactory.Orm()
+	s.mockOrmQuery = mockFactory.OrmQuery()
+	s.mockStorage = mockFactory.Storage()
+	s.mockDisk = mockFactory.StorageDriver()
+	mockFactory.Log()
+	s.mockMaintainerInterface = &mocksmodels.MaintainerInterface{}
+	s.mockPackageInterface = &mocksmodels.PackageInterface{}
+	s.packageCoverImpl = &PackageCoverImpl{
+		maintainerModel: s.mockMaintainerInterface,
+		packageModel:    s.mockPackageInterface,
+	}
+}
+
+func (s *PackageCoverTestSuite) TestDeleteCovers() {
+	s.expectCoverDisk()
+	s.mockDisk.On("Url", "covers/1").Return("https://goravel.dev/storage/covers/1").Once()
+	s.mockDisk.On("Delete", "covers/1/cover.png", "covers/1/cover_thumb.png").Return(nil).Once()
+
+	// The package without a cover is skipped.
+	s.packageCoverImpl.DeleteCovers([]*models.Package{
+		{UUIDModel: models.UUIDModel{ID: 1}, Cover: "https://goravel.dev/storage/covers/1/cover.png", CoverThumbnail: "https://goravel.dev/storage/covers/1/cover_thumb.png"},
+		{UUIDModel: models.UUIDModel{ID: 2}},
+	})
+
+	s.mockConfig.AssertExpectations(s.T())
+	s.mockStorage.AssertExpectations(s.T())
+	s.mockDisk.AssertExpectations(s.T())
+}
+
+func (s *PackageCoverTestSuite) TestUploadCover() {
+	var (
+		packageID = "1"
+		userID    = "1"
+		cover     = "https://goravel.dev/storage/covers/1/old.png"
+		thumbnail = "https://goravel.dev/storage/covers/1/old_thumb.png"
+		pngData   = newPNG(s.T(), 320, 180)
+	)
+
+	tests := []struct {
+		name        string
+		userID      string
+		data        []byte
+		setup       func()
+		assert      func(pkg *models.Package)
+		expectedErr error
+	}{
+		{
+			name:   "Happy path - previous cover is deleted",
+			userID: userID,
+			data:   pngData,
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, Cover: cover, CoverThumbnail: thumbnail}, nil).Once()
+				s.expectCoverOptions()
+				s.expectCoverDisk()
+				s.mockDisk.On("Put", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil).Twice()
+				s.mockDisk.On("Url", mock.AnythingOfType("string")).Return(func(file string) string {
+					return "https://goravel.dev/storage/" + file
+				}).Twice()
+				s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+				s.mockPackageInterface.On("UpdatePackage", s.mockOrmQuery, mock.AnythingOfType("*models.Package")).Return(nil).Once()
+				s.expectCoverDisk()
+				s.mockDisk.On("Url", "covers/1").Return("https://goravel.dev/storage/covers/1").Once()
+				s.mockDisk.On("Delete", "covers/1/old.png", "covers/1/old_thumb.png").Return(nil).Once()
+			},
+			assert: func(pkg *models.Package) {
+				s.Regexp(`^https://goravel.dev/storage/covers/1/[0-9a-f]{32}\.png$`, pkg.Cover)
+				s.Regexp(`^https://goravel.dev/storage/covers/1/[0-9a-f]{32}_thumb\.png$`, pkg.CoverThumbnail)
+			},
+		},
+		{
+			name:   "Sad path - Package does not exist",
+			userID: userID,
+			data:   pngData,
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{}, nil).Once()
+				s.mockLang.On("Get", "not_exist.package").Return("package not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "package not exist"),
+		},
+		{
+			name:   "Sad path - User isn't owner of package",
+			userID: "2",
+			data:   pngData,
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1}, nil).Once()
+				s.mockMaintainerInterface.On("GetMaintainer", uint64(1), uint64(2)).Return(&models.Maintainer{}, nil).Once()
+				s.mockLang.On("Get", "forbidden.update_package").Return("forbidden").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusUnauthorized, "forbidden"),
+		},
+		{
+			name:   "Sad path - image is too small",
+			userID: userID,
+			data:   newPNG(s.T(), 100, 100),
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1}, nil).Once()
+				s.expectCoverOptions()
+				s.mockLang.On("Get", "invalid.image_dimensions", translation.Option{
+					Replace: map[string]string{"min_width": "320", "min_height": "180", "max_width": "4096", "max_height": "4096"},
+				}).Return("invalid dimensions").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusBadRequest, "invalid dimensions"),
+		},
+		{
+			name:   "Sad path - stored cover is deleted if UpdatePackage returns error",
+			userID: userID,
+			data:   pngData,
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1}, nil).Once()
+				s.expectCoverOptions()
+				s.expectCoverDisk()
+				s.mockDisk.On("Put", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil).Twice()
+				s.mockDisk.On("Url", mock.AnythingOfType("string")).Return(func(file string) string {
+					return "https://goravel.dev/storage/" + file
+				}).Twice()
+				s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+				s.mockPackageInterface.On("UpdatePackage", s.mockOrmQuery, mock.AnythingOfType("*models.Package")).Return(errors.New("error")).Once()
+				s.expectCoverDisk()
+				s.mockDisk.On("Url", "covers/1").Return("https://goravel.dev/storage/covers/1").Once()
+				s.mockDisk.On("Delete", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			test.setup()
+
+			pkg, err := s.packageCoverImpl.UploadCover(s.ctx, packageID, test.userID, test.data)
+			s.Equal(test.expectedErr, err)
+			if test.expectedErr == nil {
+				test.assert(pkg)
+			}
+
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockConfig.AssertExpectations(s.T())
+			s.mockStorage.AssertExpectations(s.T())
+			s.mockDisk.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageCoverTestSuite) expectCoverDisk() {
+	s.mockConfig.On("GetString", "package.cover.disk", "public").Return("public").Once()
+	s.mockStorage.On("Disk", "public").Return(s.mockDisk).Once()
+}
+
+func (s *PackageCoverTestSuite) expectCoverOptions() {
+	for key, value := range map[string]int{
+		"package.cover.max_size_kb":      2048,
+		"package.cover.min_width":        320,
+		"package.cover.min_height":       180,
+		"package.cover.max_width":        4096,
+		"package.cover.max_height":       4096,
+		"package.cover.thumbnail_width":  480,
+		"package.cover.thumbnail_height": 270,
+	} {
+		s.mockConfig.On("GetInt", key, value).Return(value).Once()
+	}
+}
+
+func newPNG(t *testing.T, width, height int) []byte {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
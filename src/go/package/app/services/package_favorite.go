@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+
+	"github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/facades"
+	"github.com/spf13/cast"
+
+	"market.goravel.dev/package/app/models"
+	protobase "market.goravel.dev/proto/base"
+	"market.goravel.dev/utils/errors"
+	utilspagination "market.goravel.dev/utils/pagination"
+)
+
+// PackageFavorite manages the packages bookmarked by users, the favorite count of a package is updated in the same
+// statement as its favorites.
+type PackageFavorite interface {
+	FavoritePackage(ctx context.Context, id, userID string) (*models.Package, error)
+	FillFavorited(userID string, packages []*models.Package) error
+	ListFavoritePackages(ctx context.Context, userID string, pagination *protobase.Pagination) ([]*models.Package, int64, string, error)
+	UnfavoritePackage(ctx context.Context, id, userID string) (*models.Package, error)
+}
+
+type PackageFavoriteImpl struct {
+	favoriteModel models.FavoriteInterface
+	packageModel  models.PackageInterface
+	userService   User
+}
+
+func NewPackageFavoriteImpl() *PackageFavoriteImpl {
+	return &PackageFavoriteImpl{
+		favoriteModel: models.NewFavorite(),
+		packageModel:  models.NewPackage(),
+		userService:   NewUserImpl(),
+	}
+}
+
+// FavoritePackage favorites the package for the user, favoriting a package twice is a no-op.
+func (r *PackageFavoriteImpl) FavoritePackage(ctx context.Context, id, userID string) (*models.Package, error) {
+	pkg, err := r.packageModel.GetPackageByID(id, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	if pkg.ID == 0 {
+		return nil, errors.NewNotFound(facades.Lang(ctx).Get("not_exist.package"))
+	}
+
+	visible, err := isPackageVisible(ctx, r.userService, pkg, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, errors.NewNotFound(facades.Lang(ctx).Get("not_exist.package"))
+	}
+
+	created, err := r.favoriteModel.CreateFavorite(pkg.ID, cast.ToUint64(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	if created {
+		pkg.FavoriteCount++
+	}
+	pkg.IsFavorited = true
+
+	return pkg, nil
+}
+
+// FillFavorited marks the packages favorited by the user, nothing is marked for anonymous users.
+func (r *PackageFavoriteImpl) FillFavorited(userID string, packages []*models.Package) error {
+	if userID == "" || len(packages) == 0 {
+		return nil
+	}
+
+	packageIDs := make([]uint64, len(packages))
+	for i, pkg := range packages {
+		packageIDs[i] = pkg.ID
+	}
+
+	favorited, err := r.favoriteModel.GetFavoritedPackageIDs(cast.ToUint64(userID), packageIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, pkg := range packages {
+		pkg.IsFavorited = favorited[pkg.ID]
+	}
+
+	return nil
+}
+
+// ListFavoritePackages gets the packages favorited by the user, the latest favorited first, the ones that the user
+// can't read anymore are left out.
+func (r *PackageFavoriteImpl) ListFavoritePackages(ctx context.Context, userID string, pagination *protobase.Pagination) (packages []*models.Package, total int64, nextCursor string, err error) {
+	visibleScope, err := visiblePackages(r.userService, userID)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	// The columns are qualified since package_favorites has the same columns.
+	fields := make([]string, 0, len(packageListFields)+2)
+	for _, field := range packageListFields {
+		fields = append(fields, "packages."+field)
+	}
+	fields = append(fields, "package_favorites.id AS favorite_id", "package_favorites.created_at AS favorited_at")
+
+	ormQuery := facades.Orm().Query().Select(fields).
+		Join("JOIN package_favorites ON package_favorites.package_id = packages.id AND package_favorites.user_id = ?", userID).
+		Scopes(visibleScope).
+		With("Tags", func(query orm.Query) orm.Query {
+			return query.Where("is_show = ?", "1").Select([]string{"id", "name"})
+		})
+
+	order := &utilspagination.Order{Column: "package_favorites.created_at", IDColumn: "package_favorites.id", Desc: true}
+	nextCursor, err = utilspagination.Paginate(ormQuery, pagination, order, func(pkg *models.Package) (any, uint64) {
+		return pkg.FavoritedAt.ToDateTimeMicroString(), pkg.FavoriteID
+	}, &packages, &total)
+	if err != nil {
+		return nil, 0, "", paginationError(ctx, err)
+	}
+
+	for _, pkg := range packages {
+		pkg.IsFavorited = true
+	}
+
+	if err := fillPackageUsers(r.userService, packages); err != nil {
+		return nil, 0, "", err
+	}
+
+	return packages, total, nextCursor, nil
+}
+
+// UnfavoritePackage unfavorites the package for the user, unfavoriting a package that isn't favorited is a no-op.
+func (r *PackageFavoriteImpl) UnfavoritePackage(ctx context.Context, id, userID string) (*models.Package, error) {
+	pkg, err := r.packageModel.GetPackageByID(id, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	if pkg.ID == 0 {
+		return nil, errors.NewNotFound(facades.Lang(ctx).Get("not_exist.package"))
+	}
+
+	visible, err := isPackageVisible(ctx, r.userService, pkg, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, errors.NewNotFound(facades.Lang(ctx).Get("not_exist.package"))
+	}
+
+	deleted, err := r.favoriteModel.DeleteFavorite(pkg.ID, cast.ToUint64(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	if deleted && pkg.FavoriteCount > 0 {
+		pkg.FavoriteCount--
+	}
+	pkg.IsFavorited = false
+
+	return pkg, nil
+}
@@ -0,0 +1,387 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goravel/framework/contracts/http"
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	mockstranslation "github.com/goravel/framework/mocks/translation"
+	"github.com/goravel/framework/support/carbon"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	mocksmodels "market.goravel.dev/package/app/mocks/models"
+	mocksservice "market.goravel.dev/package/app/mocks/services"
+	"market.goravel.dev/package/app/models"
+	protobase "market.goravel.dev/proto/base"
+	protouser "market.goravel.dev/proto/user"
+	utilserrors "market.goravel.dev/utils/errors"
+	utilspagination "market.goravel.dev/utils/pagination"
+)
+
+type PackageFavoriteTestSuite struct {
+	suite.Suite
+	ctx                   context.Context
+	mockFavoriteInterface *mocksmodels.FavoriteInterface
+	mockLang              *mockstranslation.Translator
+	mockOrm               *mocksorm.Orm
+	mockOrmQuery          *mocksorm.Query
+	mockPackageInterface  *mocksmodels.PackageInterface
+	mockUserService       *mocksservice.User
+	packageFavoriteImpl   *PackageFavoriteImpl
+}
+
+func TestPackageFavoriteTestSuite(t *testing.T) {
+	suite.Run(t, new(PackageFavoriteTestSuite))
+}
+
+func (s *PackageFavoriteTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	mockFactory := testingmock.Factory()
+	s.mockLang = mockFactory.Lang(s.ctx)
+	s.mockOrm = mockFactory.Orm()
+	s.mockOrmQuery = mockFactory.OrmQuery()
+	s.mockFavoriteInterface = &mocksmodels.FavoriteInterface{}
+	s.mockPackageInterface = &mocksmodels.PackageInterface{}
+	s.mockUserService = &mocksservice.User{}
+	s.packageFavoriteImpl = &PackageFavoriteImpl{
+		favoriteModel: s.mockFavoriteInterface,
+		packageModel:  s.mockPackageInterface,
+		userService:   s.mockUserService,
+	}
+}
+
+func (s *PackageFavoriteTestSuite) TestFavoritePackage() {
+	var (
+		packageID = "1"
+		userID    = "2"
+	)
+
+	tests := []struct {
+		name          string
+		setup         func()
+		expectPackage *models.Package
+		expectedErr   error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 3, IsApproved: models.PackageApproved, IsPublic: models.PackagePublic, FavoriteCount: 5}, nil).Once()
+				s.mockFavoriteInterface.On("CreateFavorite", uint64(1), uint64(2)).Return(true, nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 3, IsApproved: models.PackageApproved, IsPublic: models.PackagePublic, FavoriteCount: 6, IsFavorited: true},
+		},
+		{
+			name: "Happy path - favorited already",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 3, IsApproved: models.PackageApproved, IsPublic: models.PackagePublic, FavoriteCount: 5}, nil).Once()
+				s.mockFavoriteInterface.On("CreateFavorite", uint64(1), uint64(2)).Return(false, nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 3, IsApproved: models.PackageApproved, IsPublic: models.PackagePublic, FavoriteCount: 5, IsFavorited: true},
+		},
+		{
+			name: "Happy path - owner favorites private package",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 2}, nil).Once()
+				s.mockFavoriteInterface.On("CreateFavorite", uint64(1), uint64(2)).Return(true, nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 2, FavoriteCount: 1, IsFavorited: true},
+		},
+		{
+			name: "Sad path - package doesn't exist",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{}, nil).Once()
+				s.mockLang.On("Get", "not_exist.package").Return("package not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "package not exist"),
+		},
+		{
+			name: "Sad path - package is private",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 3}, nil).Once()
+				s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("Where", "package_id = ? AND user_id = ?", uint64(1), uint64(2)).Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("First", mock.AnythingOfType("*models.Maintainer")).Return(nil).Once()
+				s.mockLang.On("Get", "not_exist.package").Return("package not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "package not exist"),
+		},
+		{
+			name: "Sad path - GetPackageByID returns error",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			name: "Sad path - CreateFavorite returns error",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 2}, nil).Once()
+				s.mockFavoriteInterface.On("CreateFavorite", uint64(1), uint64(2)).Return(false, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			test.setup()
+
+			pkg, err := s.packageFavoriteImpl.FavoritePackage(s.ctx, packageID, userID)
+			s.Equal(test.expectPackage, pkg)
+			s.Equal(test.expectedErr, err)
+
+			s.mockFavoriteInterface.AssertExpectations(s.T())
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageFavoriteTestSuite) TestUnfavoritePackage() {
+	var (
+		packageID = "1"
+		userID    = "2"
+	)
+
+	tests := []struct {
+		name          string
+		setup         func()
+		expectPackage *models.Package
+		expectedErr   error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 3, IsApproved: models.PackageApproved, IsPublic: models.PackagePublic, FavoriteCount: 5}, nil).Once()
+				s.mockFavoriteInterface.On("DeleteFavorite", uint64(1), uint64(2)).Return(true, nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 3, IsApproved: models.PackageApproved, IsPublic: models.PackagePublic, FavoriteCount: 4},
+		},
+		{
+			name: "Happy path - not favorited",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 3, IsApproved: models.PackageApproved, IsPublic: models.PackagePublic, FavoriteCount: 5}, nil).Once()
+				s.mockFavoriteInterface.On("DeleteFavorite", uint64(1), uint64(2)).Return(false, nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 3, IsApproved: models.PackageApproved, IsPublic: models.PackagePublic, FavoriteCount: 5},
+		},
+		{
+			name: "Sad path - package is private",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 3}, nil).Once()
+				s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("Where", "package_id = ? AND user_id = ?", uint64(1), uint64(2)).Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("First", mock.AnythingOfType("*models.Maintainer")).Return(nil).Once()
+				s.mockLang.On("Get", "not_exist.package").Return("package not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "package not exist"),
+		},
+		{
+			name: "Sad path - DeleteFavorite returns error",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 2}, nil).Once()
+				s.mockFavoriteInterface.On("DeleteFavorite", uint64(1), uint64(2)).Return(false, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			test.setup()
+
+			pkg, err := s.packageFavoriteImpl.UnfavoritePackage(s.ctx, packageID, userID)
+			s.Equal(test.expectPackage, pkg)
+			s.Equal(test.expectedErr, err)
+
+			s.mockFavoriteInterface.AssertExpectations(s.T())
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageFavoriteTestSuite) TestFillFavorited() {
+	tests := []struct {
+		name           string
+		userID         string
+		packages       []*models.Package
+		setup          func()
+		expectPackages []*models.Package
+		expectedErr    error
+	}{
+		{
+			name:     "Happy path",
+			userID:   "2",
+			packages: []*models.Package{{UUIDModel: models.UUIDModel{ID: 1}}, {UUIDModel: models.UUIDModel{ID: 3}}},
+			setup: func() {
+				s.mockFavoriteInterface.On("GetFavoritedPackageIDs", uint64(2), []uint64{1, 3}).Return(map[uint64]bool{3: true}, nil).Once()
+			},
+			expectPackages: []*models.Package{{UUIDModel: models.UUIDModel{ID: 1}}, {UUIDModel: models.UUIDModel{ID: 3}, IsFavorited: true}},
+		},
+		{
+			name:           "Happy path - anonymous user",
+			packages:       []*models.Package{{UUIDModel: models.UUIDModel{ID: 1}}},
+			setup:          func() {},
+			expectPackages: []*models.Package{{UUIDModel: models.UUIDModel{ID: 1}}},
+		},
+		{
+			name:     "Sad path - GetFavoritedPackageIDs returns error",
+			userID:   "2",
+			packages: []*models.Package{{UUIDModel: models.UUIDModel{ID: 1}}},
+			setup: func() {
+				s.mockFavoriteInterface.On("GetFavoritedPackageIDs", uint64(2), []uint64{1}).Return(nil, errors.New("error")).Once()
+			},
+			expectPackages: []*models.Package{{UUIDModel: models.UUIDModel{ID: 1}}},
+			expectedErr:    errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			test.setup()
+
+			err := s.packageFavoriteImpl.FillFavorited(test.userID, test.packages)
+			s.Equal(test.expectPackages, test.packages)
+			s.Equal(test.expectedErr, err)
+
+			s.mockFavoriteInterface.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageFavoriteTestSuite) TestListFavoritePackages() {
+	var (
+		userID = "2"
+		users  = []*protouser.User{
+			{
+				Id:   "3",
+				Name: "test",
+			},
+		}
+
+		favoritedAt = carbon.NewDateTime(carbon.FromDateTimeMicro(2024, 1, 2, 3, 4, 5, 123456))
+		fields      = make([]string, 0, len(packageListFields)+2)
+
+		mockOrm      *mocksorm.Orm
+		mockOrmQuery *mocksorm.Query
+	)
+
+	for _, field := range packageListFields {
+		fields = append(fields, "packages."+field)
+	}
+	fields = append(fields, "package_favorites.id AS favorite_id", "package_favorites.created_at AS favorited_at")
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockOrm = mockFactory.Orm()
+		mockFactory.Log()
+		mockOrmQuery = mockFactory.OrmQuery()
+		mockOrm.On("Query").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Select", fields).Return(mockOrmQuery).Once()
+		s.mockUserService.On("ListUserOrganizations", context.Background(), uint64(2)).Return(nil, nil).Once()
+		mockOrmQuery.On("Join", "JOIN package_favorites ON package_favorites.package_id = packages.id AND package_favorites.user_id = ?", userID).Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Scopes", mock.Anything).Return(mockOrmQuery).Once()
+		mockOrmQuery.On("With", "Tags", mock.Anything).Return(mockOrmQuery).Once()
+	}
+
+	tests := []struct {
+		name           string
+		pagination     *protobase.Pagination
+		setup          func()
+		expectPackages []*models.Package
+		expectedTotal  int64
+		expectedCursor string
+		expectedErr    error
+	}{
+		{
+			name:       "Happy path",
+			pagination: &protobase.Pagination{Page: 1, Limit: 1},
+			setup: func() {
+				beforeSetup()
+				mockOrmQuery.On("Order", "package_favorites.created_at DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "package_favorites.id DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Paginate", 1, 1, mock.AnythingOfType("*[]*models.Package"), mock.AnythingOfType("*int64")).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						*args.Get(2).(*[]*models.Package) = []*models.Package{{UUIDModel: models.UUIDModel{ID: 5}, UserID: 3, FavoriteID: 50, FavoritedAt: favoritedAt}}
+						*args.Get(3).(*int64) = 2
+					}).Once()
+				s.mockUserService.On("GetUsers", context.Background(), []string{"3"}).Return(users, nil).Once()
+			},
+			expectPackages: []*models.Package{{UUIDModel: models.UUIDModel{ID: 5}, UserID: 3, FavoriteID: 50, FavoritedAt: favoritedAt, IsFavorited: true, User: users[0]}},
+			expectedTotal:  2,
+			expectedCursor: utilspagination.EncodeCursor(utilspagination.Cursor{ID: 50, Value: "2024-01-02 03:04:05.123456", Sort: "package_favorites.created_at", Desc: true}),
+		},
+		{
+			name:       "Happy path - with cursor",
+			pagination: &protobase.Pagination{Limit: 1, Cursor: utilspagination.EncodeCursor(utilspagination.Cursor{ID: 50, Value: "2024-01-02 03:04:05.123456", Sort: "package_favorites.created_at", Desc: true})},
+			setup: func() {
+				beforeSetup()
+				mockOrmQuery.On("Where", "(package_favorites.created_at < ? OR (package_favorites.created_at = ? AND package_favorites.id < ?))", "2024-01-02 03:04:05.123456", "2024-01-02 03:04:05.123456", uint64(50)).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "package_favorites.created_at DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "package_favorites.id DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Limit", 2).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Package")).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						*args.Get(0).(*[]*models.Package) = []*models.Package{{UUIDModel: models.UUIDModel{ID: 4}, UserID: 3}}
+					}).Once()
+				s.mockUserService.On("GetUsers", context.Background(), []string{"3"}).Return(users, nil).Once()
+			},
+			expectPackages: []*models.Package{{UUIDModel: models.UUIDModel{ID: 4}, UserID: 3, IsFavorited: true, User: users[0]}},
+		},
+		{
+			name:       "Sad path - Paginate returns error",
+			pagination: &protobase.Pagination{Page: 1, Limit: 1},
+			setup: func() {
+				beforeSetup()
+				mockOrmQuery.On("Order", "package_favorites.created_at DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "package_favorites.id DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Paginate", 1, 1, mock.AnythingOfType("*[]*models.Package"), mock.AnythingOfType("*int64")).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+		{
+			name:       "Sad path - GetUsers returns error",
+			pagination: &protobase.Pagination{Page: 1, Limit: 1},
+			setup: func() {
+				beforeSetup()
+				mockOrmQuery.On("Order", "package_favorites.created_at DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "package_favorites.id DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Paginate", 1, 1, mock.AnythingOfType("*[]*models.Package"), mock.AnythingOfType("*int64")).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						*args.Get(2).(*[]*models.Package) = []*models.Package{{UUIDModel: models.UUIDModel{ID: 5}, UserID: 3}}
+						*args.Get(3).(*int64) = 1
+					}).Once()
+				s.mockUserService.On("GetUsers", context.Background(), []string{"3"}).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			test.setup()
+
+			packages, total, nextCursor, err := s.packageFavoriteImpl.ListFavoritePackages(s.ctx, userID, test.pagination)
+			s.Equal(test.expectPackages, packages)
+			s.Equal(test.expectedTotal, total)
+			s.Equal(test.expectedCursor, nextCursor)
+			s.Equal(test.expectedErr, err)
+
+			mockOrm.AssertExpectations(s.T())
+			mockOrmQuery.AssertExpectations(s.T())
+			s.mockUserService.AssertExpectations(s.T())
+		})
+	}
+}
@@ -0,0 +1,278 @@
+package services
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/facades"
+	"github.com/spf13/cast"
+
+	"market.goravel.dev/package/app/models"
+	"market.goravel.dev/utils/errors"
+)
+
+// PackageMaintainer manages the maintainers of the packages and the transfers of their ownership. The owner manages the
+// maintainers, a transfer is pending until the recipient accepts it.
+type PackageMaintainer interface {
+	AcceptPackageTransfer(ctx context.Context, id, userID string) (*models.Package, error)
+	AddMaintainer(ctx context.Context, packageID, userID, maintainerID string) (*models.Maintainer, error)
+	DeclinePackageTransfer(ctx context.Context, id, userID string) (*models.Package, error)
+	GetMaintainers(ctx context.Context, packageID, userID string) ([]*models.Maintainer, error)
+	RemoveMaintainer(ctx context.Context, packageID, userID, maintainerID string) error
+	TransferPackage(ctx context.Context, id, userID, ownerID string) (*models.Package, error)
+}
+
+type PackageMaintainerImpl struct {
+	maintainerModel models.MaintainerInterface
+	packageModel    models.PackageInterface
+	userService     User
+}
+
+func NewPackageMaintainerImpl() *PackageMaintainerImpl {
+	return &PackageMaintainerImpl{
+		maintainerModel: models.NewMaintainer(),
+		packageModel:    models.NewPackage(),
+		userService:     NewUserImpl(),
+	}
+}
+
+// AcceptPackageTransfer makes the recipient of the pending transfer the owner of the package, the previous owner stays a
+// maintainer.
+func (r *PackageMaintainerImpl) AcceptPackageTransfer(ctx context.Context, id, userID string) (*models.Package, error) {
+	pkg, err := r.packageModel.GetPackageByID(id, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	if pkg.ID == 0 || pkg.PendingOwnerID == 0 || pkg.PendingOwnerID != cast.ToUint64(userID) {
+		return nil, errors.NewNotFound(facades.Lang(ctx).Get("not_exist.package_transfer"))
+	}
+
+	previousOwnerID := pkg.UserID
+	pkg.UserID = pkg.PendingOwnerID
+	pkg.PendingOwnerID = 0
+
+	// The owner of the package and its maintainers are changed together.
+	if err := facades.Orm().Transaction(func(tx orm.Transaction) error {
+		if err := r.packageModel.UpdatePackage(tx, pkg); err != nil {
+			return err
+		}
+
+		return r.maintainerModel.TransferOwnership(tx, pkg.ID, previousOwnerID, pkg.UserID)
+	}); err != nil {
+		// The recipient may have a package with the same name.
+		return nil, packageConflictError(ctx, err)
+	}
+
+	return pkg, nil
+}
+
+// AddMaintainer adds the user to the maintainers of the package, only the owner can add maintainers.
+func (r *PackageMaintainerImpl) AddMaintainer(ctx context.Context, packageID, userID, maintainerID string) (*models.Maintainer, error) {
+	pkg, err := r.packageModel.GetPackageByID(packageID, []string{"id", "user_id"})
+	if err != nil {
+		return nil, err
+	}
+
+	if pkg.ID == 0 {
+		return nil, errors.NewNotFound(facades.Lang(ctx).Get("not_exist.package"))
+	}
+
+	if pkg.UserID != cast.ToUint64(userID) {
+		return nil, errors.NewUnauthorized(facades.Lang(ctx).Get("forbidden.manage_maintainers"))
+	}
+
+	// The user service returns a not found error if the user doesn't exist.
+	user, err := r.userService.GetUser(ctx, cast.ToUint64(maintainerID))
+	if err != nil {
+		return nil, err
+	}
+
+	maintainer := &models.Maintainer{
+		PackageID: pkg.ID,
+		UserID:    cast.ToUint64(maintainerID),
+		Role:      models.MaintainerRoleMaintainer,
+	}
+	if err := r.maintainerModel.AddMaintainer(maintainer); err != nil {
+		if stderrors.Is(err, models.ErrDuplicateMaintainer) {
+			return nil, errors.NewConflict(facades.Lang(ctx).Get("exist.maintainer"))
+		}
+
+		return nil, err
+	}
+	maintainer.User = user
+
+	return maintainer, nil
+}
+
+// DeclinePackageTransfer clears the pending transfer of the package, the recipient declines it or the owner cancels it.
+func (r *PackageMaintainerImpl) DeclinePackageTransfer(ctx context.Context, id, userID string) (*models.Package, error) {
+	pkg, err := r.packageModel.GetPackageByID(id, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	if pkg.ID == 0 || pkg.PendingOwnerID == 0 || pkg.PendingOwnerID != cast.ToUint64(userID) && pkg.UserID != cast.ToUint64(userID) {
+		return nil, errors.NewNotFound(facades.Lang(ctx).Get("not_exist.package_transfer"))
+	}
+
+	pkg.PendingOwnerID = 0
+	if err := r.packageModel.UpdatePackage(facades.Orm().Query(), pkg); err != nil {
+		return nil, packageConflictError(ctx, err)
+	}
+
+	return pkg, nil
+}
+
+// GetMaintainers gets the maintainers of the package with their users, the owner first.
+func (r *PackageMaintainerImpl) GetMaintainers(ctx context.Context, packageID, userID string) ([]*models.Maintainer, error) {
+	pkg, err := getVisiblePackage(ctx, r.packageModel, r.userService, userID, packageID)
+	if err != nil {
+		return nil, err
+	}
+
+	maintainers, err := r.maintainerModel.GetMaintainers(pkg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(maintainers) == 0 {
+		return maintainers, nil
+	}
+
+	userIDs := make([]string, len(maintainers))
+	for i, maintainer := range maintainers {
+		userIDs[i] = cast.ToString(maintainer.UserID)
+	}
+
+	users, err := r.userService.GetUsers(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	maintainerMap := make(map[string]*models.Maintainer, len(maintainers))
+	for _, maintainer := range maintainers {
+		maintainerMap[cast.ToString(maintainer.UserID)] = maintainer
+	}
+	for _, user := range users {
+		if maintainer, ok := maintainerMap[user.GetId()]; ok {
+			maintainer.User = user
+		}
+	}
+
+	return maintainers, nil
+}
+
+// RemoveMaintainer removes the user from the maintainers of the package. The owner can remove the other maintainers and
+// a maintainer can remove themselves, the owner can only leave by transferring the package.
+func (r *PackageMaintainerImpl) RemoveMaintainer(ctx context.Context, packageID, userID, maintainerID string) error {
+	pkg, err := r.packageModel.GetPackageByID(packageID, []string{"id", "user_id"})
+	if err != nil {
+		return err
+	}
+
+	if pkg.ID == 0 {
+		return errors.NewNotFound(facades.Lang(ctx).Get("not_exist.package"))
+	}
+
+	if pkg.UserID != cast.ToUint64(userID) && maintainerID != userID {
+		return errors.NewUnauthorized(facades.Lang(ctx).Get("forbidden.manage_maintainers"))
+	}
+
+	if pkg.UserID == cast.ToUint64(maintainerID) {
+		return errors.NewBadRequest(facades.Lang(ctx).Get("invalid.remove_owner"))
+	}
+
+	removed, err := r.maintainerModel.RemoveMaintainer(pkg.ID, cast.ToUint64(maintainerID))
+	if err != nil {
+		return err
+	}
+
+	if !removed {
+		return errors.NewNotFound(facades.Lang(ctx).Get("not_exist.maintainer"))
+	}
+
+	return nil
+}
+
+// TransferPackage starts transferring the package to the user, only the owner can transfer it. It replaces the pending
+// transfer of the package, the package is transferred once the user accepts it.
+func (r *PackageMaintainerImpl) TransferPackage(ctx context.Context, id, userID, ownerID string) (*models.Package, error) {
+	pkg, err := r.packageModel.GetPackageByID(id, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	if pkg.ID == 0 {
+		return nil, errors.NewNotFound(facades.Lang(ctx).Get("not_exist.package"))
+	}
+
+	if pkg.UserID != cast.ToUint64(userID) {
+		return nil, errors.NewUnauthorized(facades.Lang(ctx).Get("forbidden.transfer_package"))
+	}
+
+	if pkg.UserID == cast.ToUint64(ownerID) {
+		return nil, errors.NewBadRequest(facades.Lang(ctx).Get("invalid.transfer_owner"))
+	}
+
+	// The user service returns a not found error if the user doesn't exist.
+	if _, err := r.userService.GetUser(ctx, cast.ToUint64(ownerID)); err != nil {
+		return nil, err
+	}
+
+	pkg.PendingOwnerID = cast.ToUint64(ownerID)
+	if err := r.packageModel.UpdatePackage(facades.Orm().Query(), pkg); err != nil {
+		return nil, packageConflictError(ctx, err)
+	}
+
+	return pkg, nil
+}
+
+// isPackageVisible reports whether the user can read the package, the members of the organization publishing the
+// package can read it as well. It must be kept in line with visiblePackages.
+func isPackageVisible(ctx context.Context, userService User, pkg *models.Package, userID string) (bool, error) {
+	visible, err := pkg.IsVisibleTo(userID)
+	if err != nil || visible || userID == "" || pkg.OrganizationID == 0 {
+		return visible, err
+	}
+
+	member, err := userService.GetMember(ctx, pkg.OrganizationID, cast.ToUint64(userID))
+	if err != nil {
+		return false, err
+	}
+
+	return member != nil, nil
+}
+
+// maintainerRole gets the role of the user in the maintainers of the package, it's empty if the user doesn't maintain
+// the package. The owner is known from the package, only the other maintainers are looked up. The members of the
+// organization publishing the package maintain it as well.
+func maintainerRole(ctx context.Context, maintainerModel models.MaintainerInterface, userService User, pkg *models.Package, userID string) (string, error) {
+	if userID == "" {
+		return "", nil
+	}
+
+	if pkg.UserID == cast.ToUint64(userID) {
+		return models.MaintainerRoleOwner, nil
+	}
+
+	maintainer, err := maintainerModel.GetMaintainer(pkg.ID, cast.ToUint64(userID))
+	if err != nil {
+		return "", err
+	}
+
+	if maintainer.Role != "" || pkg.OrganizationID == 0 {
+		return maintainer.Role, nil
+	}
+
+	member, err := userService.GetMember(ctx, pkg.OrganizationID, cast.ToUint64(userID))
+	if err != nil {
+		return "", err
+	}
+	if member == nil {
+		return "", nil
+	}
+
+	return models.MaintainerRoleMaintainer, nil
+}
@@ -0,0 +1,534 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/contracts/http"
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	mockstranslation "github.com/goravel/framework/mocks/translation"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	mocksmodels "market.goravel.dev/package/app/mocks/models"
+	mocksservice "market.goravel.dev/package/app/mocks/services"
+	"market.goravel.dev/package/app/models"
+	protouser "market.goravel.dev/proto/user"
+	utilserrors "market.goravel.dev/utils/errors"
+)
+
+type PackageMaintainerTestSuite struct {
+	suite.Suite
+	ctx                     context.Context
+	mockLang                *mockstranslation.Translator
+	mockMaintainerInterface *mocksmodels.MaintainerInterface
+	mockOrm                 *mocksorm.Orm
+	mockOrmQuery            *mocksorm.Query
+	mockPackageInterface    *mocksmodels.PackageInterface
+	mockTransaction         *mocksorm.Transaction
+	mockUserService         *mocksservice.User
+	packageMaintainerImpl   *PackageMaintainerImpl
+}
+
+func TestPackageMaintainerTestSuite(t *testing.T) {
+	suite.Run(t, new(PackageMaintainerTestSuite))
+}
+
+func (s *PackageMaintainerTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	mockFactory := testingmock.Factory()
+	s.mockLang = mockFactory.Lang(s.ctx)
+	s.mockOrm = mockFactory.Orm()
+	s.mockOrmQuery = mockFactory.OrmQuery()
+	s.mockTransaction = mockFactory.OrmTransaction()
+	mockFactory.Log()
+	s.mockMaintainerInterface = &mocksmodels.MaintainerInterface{}
+	s.mockPackageInterface = &mocksmodels.PackageInterface{}
+	s.mockUserService = &mocksservice.User{}
+	s.packageMaintainerImpl = &PackageMaintainerImpl{
+		maintainerModel: s.mockMaintainerInterface,
+		packageModel:    s.mockPackageInterface,
+		userService:     s.mockUserService,
+	}
+}
+
+// expectNotMaintainer expects the visibility check of a private package to find that the user doesn't maintain it.
+func (s *PackageMaintainerTestSuite) expectNotMaintainer(packageID, userID uint64) {
+	s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+	s.mockOrmQuery.On("Where", "package_id = ? AND user_id = ?", packageID, userID).Return(s.mockOrmQuery).Once()
+	s.mockOrmQuery.On("First", mock.AnythingOfType("*models.Maintainer")).Return(nil).Once()
+}
+
+func (s *PackageMaintainerTestSuite) TestAcceptPackageTransfer() {
+	packageID := "1"
+
+	tests := []struct {
+		name          string
+		userID        string
+		setup         func()
+		expectPackage *models.Package
+		expectedErr   error
+	}{
+		{
+			name:   "Happy path",
+			userID: "2",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, PendingOwnerID: 2}, nil).Once()
+				s.mockOrm.On("Transaction", mock.Anything).Return(func(txFunc func(contractsorm.Transaction) error) error {
+					return txFunc(s.mockTransaction)
+				}).Once()
+				s.mockPackageInterface.On("UpdatePackage", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
+					return pkg.UserID == 2 && pkg.PendingOwnerID == 0
+				})).Return(nil).Once()
+				s.mockMaintainerInterface.On("TransferOwnership", s.mockTransaction, uint64(1), uint64(1), uint64(2)).Return(nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 2},
+		},
+		{
+			name:   "Sad path - Transfer is pending for another user",
+			userID: "3",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, PendingOwnerID: 2}, nil).Once()
+				s.mockLang.On("Get", "not_exist.package_transfer").Return("transfer not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "transfer not exist"),
+		},
+		{
+			name:   "Sad path - Recipient has a package with the same name",
+			userID: "2",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, PendingOwnerID: 2}, nil).Once()
+				s.mockOrm.On("Transaction", mock.Anything).Return(func(txFunc func(contractsorm.Transaction) error) error {
+					return txFunc(s.mockTransaction)
+				}).Once()
+				s.mockPackageInterface.On("UpdatePackage", s.mockTransaction, mock.AnythingOfType("*models.Package")).Return(models.ErrDuplicatePackageName).Once()
+				s.mockLang.On("Get", "exist.package").Return("package exists").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusConflict, "package exists"),
+		},
+		{
+			name:   "Sad path - TransferOwnership returns error",
+			userID: "2",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, PendingOwnerID: 2}, nil).Once()
+				s.mockOrm.On("Transaction", mock.Anything).Return(func(txFunc func(contractsorm.Transaction) error) error {
+					return txFunc(s.mockTransaction)
+				}).Once()
+				s.mockPackageInterface.On("UpdatePackage", s.mockTransaction, mock.AnythingOfType("*models.Package")).Return(nil).Once()
+				s.mockMaintainerInterface.On("TransferOwnership", s.mockTransaction, uint64(1), uint64(1), uint64(2)).Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+
+			pkg, err := s.packageMaintainerImpl.AcceptPackageTransfer(s.ctx, packageID, test.userID)
+			s.Equal(test.expectPackage, pkg)
+			s.Equal(test.expectedErr, err)
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockMaintainerInterface.AssertExpectations(s.T())
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageMaintainerTestSuite) TestAddMaintainer() {
+	var (
+		packageID = "1"
+		user      = &protouser.User{Id: "2", Name: "goravel"}
+	)
+
+	tests := []struct {
+		name             string
+		userID           string
+		setup            func()
+		expectMaintainer *models.Maintainer
+		expectedErr      error
+	}{
+		{
+			name:   "Happy path",
+			userID: "1",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{"id", "user_id"}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1}, nil).Once()
+				s.mockUserService.On("GetUser", s.ctx, uint64(2)).Return(user, nil).Once()
+				s.mockMaintainerInterface.On("AddMaintainer", &models.Maintainer{PackageID: 1, UserID: 2, Role: models.MaintainerRoleMaintainer}).Return(nil).Once()
+			},
+			expectMaintainer: &models.Maintainer{PackageID: 1, UserID: 2, Role: models.MaintainerRoleMaintainer, User: user},
+		},
+		{
+			name:   "Sad path - Package does not exist",
+			userID: "1",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{"id", "user_id"}).Return(&models.Package{}, nil).Once()
+				s.mockLang.On("Get", "not_exist.package").Return("package not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "package not exist"),
+		},
+		{
+			name:   "Sad path - User isn't owner of package",
+			userID: "3",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{"id", "user_id"}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1}, nil).Once()
+				s.mockLang.On("Get", "forbidden.manage_maintainers").Return("forbidden").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusUnauthorized, "forbidden"),
+		},
+		{
+			name:   "Sad path - GetUser returns error",
+			userID: "1",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{"id", "user_id"}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1}, nil).Once()
+				s.mockUserService.On("GetUser", s.ctx, uint64(2)).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			name:   "Sad path - User maintains the package already",
+			userID: "1",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{"id", "user_id"}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1}, nil).Once()
+				s.mockUserService.On("GetUser", s.ctx, uint64(2)).Return(user, nil).Once()
+				s.mockMaintainerInterface.On("AddMaintainer", mock.AnythingOfType("*models.Maintainer")).Return(models.ErrDuplicateMaintainer).Once()
+				s.mockLang.On("Get", "exist.maintainer").Return("maintainer exists").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusConflict, "maintainer exists"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+
+			maintainer, err := s.packageMaintainerImpl.AddMaintainer(s.ctx, packageID, test.userID, "2")
+			s.Equal(test.expectMaintainer, maintainer)
+			s.Equal(test.expectedErr, err)
+
+			s.mockMaintainerInterface.AssertExpectations(s.T())
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockUserService.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageMaintainerTestSuite) TestDeclinePackageTransfer() {
+	packageID := "1"
+
+	tests := []struct {
+		name          string
+		userID        string
+		setup         func()
+		expectPackage *models.Package
+		expectedErr   error
+	}{
+		{
+			name:   "Happy path - Recipient declines the transfer",
+			userID: "2",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, PendingOwnerID: 2}, nil).Once()
+				s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+				s.mockPackageInterface.On("UpdatePackage", s.mockOrmQuery, mock.MatchedBy(func(pkg *models.Package) bool {
+					return pkg.UserID == 1 && pkg.PendingOwnerID == 0
+				})).Return(nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1},
+		},
+		{
+			name:   "Happy path - Owner cancels the transfer",
+			userID: "1",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, PendingOwnerID: 2}, nil).Once()
+				s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+				s.mockPackageInterface.On("UpdatePackage", s.mockOrmQuery, mock.AnythingOfType("*models.Package")).Return(nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1},
+		},
+		{
+			name:   "Sad path - No transfer is pending",
+			userID: "1",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1}, nil).Once()
+				s.mockLang.On("Get", "not_exist.package_transfer").Return("transfer not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "transfer not exist"),
+		},
+		{
+			name:   "Sad path - User isn't a party of the transfer",
+			userID: "3",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, PendingOwnerID: 2}, nil).Once()
+				s.mockLang.On("Get", "not_exist.package_transfer").Return("transfer not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "transfer not exist"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+
+			pkg, err := s.packageMaintainerImpl.DeclinePackageTransfer(s.ctx, packageID, test.userID)
+			s.Equal(test.expectPackage, pkg)
+			s.Equal(test.expectedErr, err)
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageMaintainerTestSuite) TestGetMaintainers() {
+	var (
+		packageID = "1"
+		fields    = []string{"id", "user_id", "is_approved", "is_public", "organization_id"}
+		owner     = &protouser.User{Id: "1", Name: "owner"}
+		user      = &protouser.User{Id: "2", Name: "maintainer"}
+	)
+
+	tests := []struct {
+		name              string
+		userID            string
+		setup             func()
+		expectMaintainers []*models.Maintainer
+		expectedErr       error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, fields).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, IsApproved: models.PackageApproved, IsPublic: models.PackagePublic}, nil).Once()
+				s.mockMaintainerInterface.On("GetMaintainers", uint64(1)).Return([]*models.Maintainer{
+					{UUIDModel: models.UUIDModel{ID: 1}, PackageID: 1, UserID: 1, Role: models.MaintainerRoleOwner},
+					{UUIDModel: models.UUIDModel{ID: 2}, PackageID: 1, UserID: 2, Role: models.MaintainerRoleMaintainer},
+				}, nil).Once()
+				s.mockUserService.On("GetUsers", s.ctx, []string{"1", "2"}).Return([]*protouser.User{user, owner}, nil).Once()
+			},
+			expectMaintainers: []*models.Maintainer{
+				{UUIDModel: models.UUIDModel{ID: 1}, PackageID: 1, UserID: 1, Role: models.MaintainerRoleOwner, User: owner},
+				{UUIDModel: models.UUIDModel{ID: 2}, PackageID: 1, UserID: 2, Role: models.MaintainerRoleMaintainer, User: user},
+			},
+		},
+		{
+			name: "Sad path - Package isn't visible",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, fields).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, IsPublic: models.PackagePrivate}, nil).Once()
+				s.mockLang.On("Get", "not_exist.package").Return("package not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "package not exist"),
+		},
+		{
+			name:   "Happy path - organization member reads the private package",
+			userID: "3",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, fields).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, IsPublic: models.PackagePrivate, OrganizationID: 5}, nil).Once()
+				s.expectNotMaintainer(1, 3)
+				s.mockUserService.On("GetMember", s.ctx, uint64(5), uint64(3)).Return(&protouser.OrganizationMember{UserId: "3"}, nil).Once()
+				s.mockMaintainerInterface.On("GetMaintainers", uint64(1)).Return([]*models.Maintainer{
+					{UUIDModel: models.UUIDModel{ID: 1}, PackageID: 1, UserID: 1, Role: models.MaintainerRoleOwner},
+				}, nil).Once()
+				s.mockUserService.On("GetUsers", s.ctx, []string{"1"}).Return([]*protouser.User{owner}, nil).Once()
+			},
+			expectMaintainers: []*models.Maintainer{
+				{UUIDModel: models.UUIDModel{ID: 1}, PackageID: 1, UserID: 1, Role: models.MaintainerRoleOwner, User: owner},
+			},
+		},
+		{
+			name:   "Sad path - other users can't read the private organization package",
+			userID: "3",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, fields).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, IsPublic: models.PackagePrivate, OrganizationID: 5}, nil).Once()
+				s.expectNotMaintainer(1, 3)
+				s.mockUserService.On("GetMember", s.ctx, uint64(5), uint64(3)).Return(nil, nil).Once()
+				s.mockLang.On("Get", "not_exist.package").Return("package not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "package not exist"),
+		},
+		{
+			name:   "Sad path - GetUsers returns error",
+			userID: "1",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, fields).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, IsPublic: models.PackagePrivate}, nil).Once()
+				s.mockMaintainerInterface.On("GetMaintainers", uint64(1)).Return([]*models.Maintainer{
+					{UUIDModel: models.UUIDModel{ID: 1}, PackageID: 1, UserID: 1, Role: models.MaintainerRoleOwner},
+				}, nil).Once()
+				s.mockUserService.On("GetUsers", s.ctx, []string{"1"}).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+
+			maintainers, err := s.packageMaintainerImpl.GetMaintainers(s.ctx, packageID, test.userID)
+			s.Equal(test.expectMaintainers, maintainers)
+			s.Equal(test.expectedErr, err)
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockOrmQuery.AssertExpectations(s.T())
+			s.mockMaintainerInterface.AssertExpectations(s.T())
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockUserService.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageMaintainerTestSuite) TestRemoveMaintainer() {
+	packageID := "1"
+
+	tests := []struct {
+		name         string
+		userID       string
+		maintainerID string
+		setup        func()
+		expectedErr  error
+	}{
+		{
+			name:         "Happy path - Owner removes a maintainer",
+			userID:       "1",
+			maintainerID: "2",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{"id", "user_id"}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1}, nil).Once()
+				s.mockMaintainerInterface.On("RemoveMaintainer", uint64(1), uint64(2)).Return(true, nil).Once()
+			},
+		},
+		{
+			name:         "Happy path - Maintainer removes themselves",
+			userID:       "2",
+			maintainerID: "2",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{"id", "user_id"}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1}, nil).Once()
+				s.mockMaintainerInterface.On("RemoveMaintainer", uint64(1), uint64(2)).Return(true, nil).Once()
+			},
+		},
+		{
+			name:         "Sad path - Maintainer removes another maintainer",
+			userID:       "3",
+			maintainerID: "2",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{"id", "user_id"}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1}, nil).Once()
+				s.mockLang.On("Get", "forbidden.manage_maintainers").Return("forbidden").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusUnauthorized, "forbidden"),
+		},
+		{
+			name:         "Sad path - Owner removes themselves",
+			userID:       "1",
+			maintainerID: "1",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{"id", "user_id"}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1}, nil).Once()
+				s.mockLang.On("Get", "invalid.remove_owner").Return("remove owner").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusBadRequest, "remove owner"),
+		},
+		{
+			name:         "Sad path - User isn't a maintainer",
+			userID:       "1",
+			maintainerID: "2",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{"id", "user_id"}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1}, nil).Once()
+				s.mockMaintainerInterface.On("RemoveMaintainer", uint64(1), uint64(2)).Return(false, nil).Once()
+				s.mockLang.On("Get", "not_exist.maintainer").Return("maintainer not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "maintainer not exist"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+
+			s.Equal(test.expectedErr, s.packageMaintainerImpl.RemoveMaintainer(s.ctx, packageID, test.userID, test.maintainerID))
+
+			s.mockMaintainerInterface.AssertExpectations(s.T())
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageMaintainerTestSuite) TestTransferPackage() {
+	packageID := "1"
+
+	tests := []struct {
+		name          string
+		userID        string
+		ownerID       string
+		setup         func()
+		expectPackage *models.Package
+		expectedErr   error
+	}{
+		{
+			name:    "Happy path",
+			userID:  "1",
+			ownerID: "2",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1}, nil).Once()
+				s.mockUserService.On("GetUser", s.ctx, uint64(2)).Return(&protouser.User{Id: "2"}, nil).Once()
+				s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+				s.mockPackageInterface.On("UpdatePackage", s.mockOrmQuery, mock.MatchedBy(func(pkg *models.Package) bool {
+					return pkg.UserID == 1 && pkg.PendingOwnerID == 2
+				})).Return(nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, PendingOwnerID: 2},
+		},
+		{
+			name:    "Sad path - User isn't owner of package",
+			userID:  "3",
+			ownerID: "2",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1}, nil).Once()
+				s.mockLang.On("Get", "forbidden.transfer_package").Return("forbidden").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusUnauthorized, "forbidden"),
+		},
+		{
+			name:    "Sad path - Package is transferred to its owner",
+			userID:  "1",
+			ownerID: "1",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1}, nil).Once()
+				s.mockLang.On("Get", "invalid.transfer_owner").Return("transfer owner").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusBadRequest, "transfer owner"),
+		},
+		{
+			name:    "Sad path - UpdatePackage returns error",
+			userID:  "1",
+			ownerID: "2",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1}, nil).Once()
+				s.mockUserService.On("GetUser", s.ctx, uint64(2)).Return(&protouser.User{Id: "2"}, nil).Once()
+				s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+				s.mockPackageInterface.On("UpdatePackage", s.mockOrmQuery, mock.AnythingOfType("*models.Package")).Return(models.ErrPackageChanged).Once()
+				s.mockLang.On("Get", "conflict.package").Return("package changed").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusConflict, "package changed"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+
+			pkg, err := s.packageMaintainerImpl.TransferPackage(s.ctx, packageID, test.userID, test.ownerID)
+			s.Equal(test.expectPackage, pkg)
+			s.Equal(test.expectedErr, err)
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockUserService.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/support/carbon"
+	"github.com/spf13/cast"
+
+	"market.goravel.dev/package/app/github"
+	"market.goravel.dev/package/app/models"
+	"market.goravel.dev/utils/errors"
+)
+
+// PackageSync syncs the metadata of the packages from the GitHub repositories of their links.
+type PackageSync interface {
+	SyncPackage(ctx context.Context, id, userID string) (*models.Package, error)
+	SyncPackages(ctx context.Context) (int, error)
+}
+
+type PackageSyncImpl struct {
+	githubClient    github.Client
+	maintainerModel models.MaintainerInterface
+	packageModel    models.PackageInterface
+	releaseModel    models.ReleaseInterface
+	userService     User
+}
+
+func NewPackageSyncImpl() *PackageSyncImpl {
+	return &PackageSyncImpl{
+		githubClient:    github.NewClientImpl(),
+		maintainerModel: models.NewMaintainer(),
+		packageModel:    models.NewPackage(),
+		releaseModel:    models.NewRelease(),
+		userService:     NewUserImpl(),
+	}
+}
+
+func (r *PackageSyncImpl) SyncPackage(ctx context.Context, id, userID string) (*models.Package, error) {
+	pkg, err := r.packageModel.GetPackageByID(id, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	if pkg.ID == 0 {
+		return nil, errors.NewNotFound(facades.Lang(ctx).Get("not_exist.package"))
+	}
+
+	role, err := maintainerRole(ctx, r.maintainerModel, r.userService, pkg, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if role == "" {
+		return nil, errors.NewUnauthorized(facades.Lang(ctx).Get("forbidden.sync_package"))
+	}
+
+	if err := r.sync(ctx, pkg); err != nil {
+		return nil, err
+	}
+
+	return pkg, nil
+}
+
+// SyncPackages syncs a batch of the packages that haven't been synced for the longest time and returns the number of
+// synced packages. A package that fails to sync is skipped, its synced time is still updated, so it's retried after the
+// others instead of filling every batch.
+func (r *PackageSyncImpl) SyncPackages(ctx context.Context) (int, error) {
+	packages, err := r.packageModel.GetPackagesToSync(facades.Config().GetInt("package.github.sync_batch_size", 100))
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	for _, pkg := range packages {
+		if err := r.sync(ctx, pkg); err != nil {
+			facades.Log().Errorf("sync package %d error: %+v", pkg.ID, err)
+			if err := r.packageModel.UpdateSyncedAt(pkg); err != nil {
+				facades.Log().Errorf("update synced time of package %d error: %+v", pkg.ID, err)
+			}
+
+			continue
+		}
+
+		count++
+	}
+
+	return count, nil
+}
+
+func (r *PackageSyncImpl) sync(ctx context.Context, pkg *models.Package) error {
+	owner, name, ok := github.ParseRepository(pkg.Link)
+	if !ok {
+		return errors.NewBadRequest(facades.Lang(ctx).Get("invalid.github_link"))
+	}
+
+	repository, err := r.githubClient.GetRepository(ctx, owner, name)
+	if err != nil {
+		return err
+	}
+
+	pkg.Stars = repository.Stars
+	pkg.License = repository.License
+	pkg.Readme = repository.Readme
+	pkg.SyncedAt = carbon.DateTime{Carbon: carbon.Now()}
+
+	// The version and the last updated time follow the releases if the package has any.
+	releases, err := r.releaseModel.GetReleases(cast.ToString(pkg.ID))
+	if err != nil {
+		return err
+	}
+
+	if len(releases) == 0 {
+		if repository.LatestTag != "" {
+			pkg.Version = repository.LatestTag
+		}
+		if !repository.LastCommitAt.IsZero() {
+			pkg.LastUpdatedAt = carbon.DateTime{Carbon: carbon.FromStdTime(repository.LastCommitAt)}
+		}
+	}
+
+	return r.packageModel.UpdateSyncedPackage(pkg)
+}
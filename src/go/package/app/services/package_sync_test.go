@@ -0,0 +1,233 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goravel/framework/contracts/http"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mockstranslation "github.com/goravel/framework/mocks/translation"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"market.goravel.dev/package/app/github"
+	mocksgithub "market.goravel.dev/package/app/mocks/github"
+	mocksmodels "market.goravel.dev/package/app/mocks/models"
+	"market.goravel.dev/package/app/models"
+	utilserrors "market.goravel.dev/utils/errors"
+)
+
+type PackageSyncTestSuite struct {
+	suite.Suite
+	ctx                     context.Context
+	mockConfig              *mocksconfig.Config
+	mockGithubClient        *mocksgithub.Client
+	mockLang                *mockstranslation.Translator
+	mockMaintainerInterface *mocksmodels.MaintainerInterface
+	mockPackageInterface    *mocksmodels.PackageInterface
+	mockReleaseInterface    *mocksmodels.ReleaseInterface
+	packageSyncImpl         *PackageSyncImpl
+}
+
+func TestPackageSyncTestSuite(t *testing.T) {
+	suite.Run(t, new(PackageSyncTestSuite))
+}
+
+func (s *PackageSyncTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	mockFactory := testingmock.Factory()
+	s.mockConfig = mockFactory.Config()
+	s.mockLang = mockFactory.Lang(s.ctx)
+	mockFactory.Log()
+	s.mockGithubClient = &mocksgithub.Client{}
+	s.mockMaintainerInterface = &mocksmodels.MaintainerInterface{}
+	s.mockPackageInterface = &mocksmodels.PackageInterface{}
+	s.mockReleaseInterface = &mocksmodels.ReleaseInterface{}
+	s.packageSyncImpl = &PackageSyncImpl{
+		githubClient:    s.mockGithubClient,
+		maintainerModel: s.mockMaintainerInterface,
+		packageModel:    s.mockPackageInterface,
+		releaseModel:    s.mockReleaseInterface,
+	}
+}
+
+func (s *PackageSyncTestSuite) TestSyncPackage() {
+	var (
+		packageID  = "1"
+		userID     = "1"
+		link       = "https://github.com/goravel/framework"
+		repository = &github.Repository{
+			Stars:        2000,
+			License:      "MIT",
+			LatestTag:    "v1.10.0",
+			LastCommitAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			Readme:       "# Goravel",
+		}
+	)
+
+	tests := []struct {
+		name        string
+		userID      string
+		setup       func()
+		assert      func(pkg *models.Package)
+		expectedErr error
+	}{
+		{
+			name:   "Happy path - package without releases",
+			userID: userID,
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, Link: link, Version: "v1.0.0"}, nil).Once()
+				s.mockGithubClient.On("GetRepository", s.ctx, "goravel", "framework").Return(repository, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{}, nil).Once()
+				s.mockPackageInterface.On("UpdateSyncedPackage", mock.AnythingOfType("*models.Package")).Return(nil).Once()
+			},
+			assert: func(pkg *models.Package) {
+				s.Equal(uint32(2000), pkg.Stars)
+				s.Equal("MIT", pkg.License)
+				s.Equal("# Goravel", pkg.Readme)
+				s.Equal("v1.10.0", pkg.Version)
+				s.Equal(repository.LastCommitAt.Unix(), pkg.LastUpdatedAt.Timestamp())
+				s.False(pkg.SyncedAt.IsZero())
+			},
+		},
+		{
+			name:   "Happy path - package with releases keeps its version",
+			userID: userID,
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, Link: link, Version: "v1.0.0"}, nil).Once()
+				s.mockGithubClient.On("GetRepository", s.ctx, "goravel", "framework").Return(repository, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{{Version: "v1.0.0"}}, nil).Once()
+				s.mockPackageInterface.On("UpdateSyncedPackage", mock.AnythingOfType("*models.Package")).Return(nil).Once()
+			},
+			assert: func(pkg *models.Package) {
+				s.Equal(uint32(2000), pkg.Stars)
+				s.Equal("v1.0.0", pkg.Version)
+				s.True(pkg.LastUpdatedAt.IsZero())
+			},
+		},
+		{
+			name:   "Sad path - Package does not exist",
+			userID: userID,
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{}, nil).Once()
+				s.mockLang.On("Get", "not_exist.package").Return("package not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "package not exist"),
+		},
+		{
+			name:   "Sad path - User isn't owner of package",
+			userID: "2",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, Link: link}, nil).Once()
+				s.mockMaintainerInterface.On("GetMaintainer", uint64(1), uint64(2)).Return(&models.Maintainer{}, nil).Once()
+				s.mockLang.On("Get", "forbidden.sync_package").Return("forbidden").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusUnauthorized, "forbidden"),
+		},
+		{
+			name:   "Sad path - Link isn't a GitHub repository",
+			userID: userID,
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, Link: "https://goravel.dev"}, nil).Once()
+				s.mockLang.On("Get", "invalid.github_link").Return("invalid link").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusBadRequest, "invalid link"),
+		},
+		{
+			name:   "Sad path - GetRepository returns error",
+			userID: userID,
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, Link: link}, nil).Once()
+				s.mockGithubClient.On("GetRepository", s.ctx, "goravel", "framework").Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			name:   "Sad path - UpdateSyncedPackage returns error",
+			userID: userID,
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, Link: link}, nil).Once()
+				s.mockGithubClient.On("GetRepository", s.ctx, "goravel", "framework").Return(repository, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{}, nil).Once()
+				s.mockPackageInterface.On("UpdateSyncedPackage", mock.AnythingOfType("*models.Package")).Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			test.setup()
+
+			pkg, err := s.packageSyncImpl.SyncPackage(s.ctx, packageID, test.userID)
+			if test.expectedErr != nil {
+				s.Nil(pkg)
+				s.Equal(test.expectedErr, err)
+			} else {
+				s.Nil(err)
+				test.assert(pkg)
+			}
+
+			s.mockGithubClient.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockReleaseInterface.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageSyncTestSuite) TestSyncPackages() {
+	tests := []struct {
+		name        string
+		setup       func()
+		expectCount int
+		expectedErr error
+	}{
+		{
+			name: "Happy path - failed package is skipped",
+			setup: func() {
+				s.mockConfig.On("GetInt", "package.github.sync_batch_size", 100).Return(100).Once()
+				s.mockPackageInterface.On("GetPackagesToSync", 100).Return([]*models.Package{
+					{UUIDModel: models.UUIDModel{ID: 1}, Link: "https://github.com/goravel/framework"},
+					{UUIDModel: models.UUIDModel{ID: 2}, Link: "https://github.com/goravel/missing"},
+				}, nil).Once()
+				s.mockGithubClient.On("GetRepository", s.ctx, "goravel", "framework").Return(&github.Repository{Stars: 1}, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", "1").Return([]*models.Release{}, nil).Once()
+				s.mockPackageInterface.On("UpdateSyncedPackage", mock.AnythingOfType("*models.Package")).Return(nil).Once()
+				s.mockGithubClient.On("GetRepository", s.ctx, "goravel", "missing").Return(nil, errors.New("error")).Once()
+				s.mockPackageInterface.On("UpdateSyncedAt", mock.MatchedBy(func(pkg *models.Package) bool {
+					return pkg.ID == 2
+				})).Return(nil).Once()
+			},
+			expectCount: 1,
+		},
+		{
+			name: "Sad path - GetPackagesToSync returns error",
+			setup: func() {
+				s.mockConfig.On("GetInt", "package.github.sync_batch_size", 100).Return(10).Once()
+				s.mockPackageInterface.On("GetPackagesToSync", 10).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			test.setup()
+
+			count, err := s.packageSyncImpl.SyncPackages(s.ctx)
+			s.Equal(test.expectCount, count)
+			s.Equal(test.expectedErr, err)
+
+			s.mockConfig.AssertExpectations(s.T())
+			s.mockGithubClient.AssertExpectations(s.T())
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockReleaseInterface.AssertExpectations(s.T())
+		})
+	}
+}
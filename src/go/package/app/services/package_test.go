@@ -4,15 +4,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
 	"github.com/goravel/framework/contracts/http"
+	mocksconfig "github.com/goravel/framework/mocks/config"
 	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	mocksfilesystem "github.com/goravel/framework/mocks/filesystem"
 	mockstranslation "github.com/goravel/framework/mocks/translation"
 	"github.com/goravel/framework/support/carbon"
 	testingmock "github.com/goravel/framework/testing/mock"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	protopackage "market.goravel.dev/proto/package"
 
 	mocks "market.goravel.dev/package/app/mocks/models"
@@ -21,15 +26,25 @@ import (
 	protobase "market.goravel.dev/proto/base"
 	protouser "market.goravel.dev/proto/user"
 	utilserrors "market.goravel.dev/utils/errors"
+	utilspagination "market.goravel.dev/utils/pagination"
 )
 
 type PackageTestSuite struct {
 	suite.Suite
-	ctx                  context.Context
-	packageImpl          *PackageImpl
-	mockPackageInterface *mocks.PackageInterface
-	mockUserService      *mocksservice.User
-	mockLang             *mockstranslation.Translator
+	ctx                     context.Context
+	packageImpl             *PackageImpl
+	mockConfig              *mocksconfig.Config
+	mockDisk                *mocksfilesystem.Driver
+	mockMaintainerInterface *mocks.MaintainerInterface
+	mockMarkdownService     *mocksservice.Markdown
+	mockPackageInterface    *mocks.PackageInterface
+	mockReleaseInterface    *mocks.ReleaseInterface
+	mockUserService         *mocksservice.User
+	mockLang                *mockstranslation.Translator
+	mockOrm                 *mocksorm.Orm
+	mockOrmQuery            *mocksorm.Query
+	mockStorage             *mocksfilesystem.Storage
+	mockTransaction         *mocksorm.Transaction
 }
 
 func TestPackageTestSuite(t *testing.T) {
@@ -39,12 +54,102 @@ func TestPackageTestSuite(t *testing.T) {
 func (s *PackageTestSuite) SetupTest() {
 	s.ctx = context.Background()
 	s.mockUserService = &mocksservice.User{}
+	s.mockMaintainerInterface = &mocks.MaintainerInterface{}
+	s.mockMarkdownService = &mocksservice.Markdown{}
 	s.mockPackageInterface = &mocks.PackageInterface{}
+	s.mockReleaseInterface = &mocks.ReleaseInterface{}
 	mockFactory := testingmock.Factory()
 	s.mockLang = mockFactory.Lang(s.ctx)
+	s.mockOrm = mockFactory.Orm()
+	s.mockOrmQuery = mockFactory.OrmQuery()
+	s.mockTransaction = mockFactory.OrmTransaction()
+	s.mockConfig = mockFactory.Config()
+	mockFactory.Log()
+	s.mockStorage = mockFactory.Storage()
+	s.mockDisk = mockFactory.StorageDriver()
 	s.packageImpl = &PackageImpl{
-		packageModel: s.mockPackageInterface,
-		userService:  s.mockUserService,
+		maintainerModel: s.mockMaintainerInterface,
+		markdownService: s.mockMarkdownService,
+		packageModel:    s.mockPackageInterface,
+		releaseModel:    s.mockReleaseInterface,
+		userService:     s.mockUserService,
+	}
+}
+
+// expectTransaction runs the function of the next transaction with the mocked transaction, the error of the function
+// is returned as if the transaction was rolled back.
+func (s *PackageTestSuite) expectTransaction() {
+	s.mockOrm.On("Transaction", mock.Anything).Return(func(txFunc func(contractsorm.Transaction) error) error {
+		return txFunc(s.mockTransaction)
+	}).Once()
+}
+
+func (s *PackageTestSuite) TestApprovePackage() {
+	var (
+		packageID = "1"
+		userID    = uint64(1)
+		name      = "goravel/gin"
+	)
+
+	tests := []struct {
+		name          string
+		setup         func()
+		expectPackage *models.Package
+		expectedErr   error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, UserID: userID, IsApproved: models.PackageRejected, RejectReason: "reason"}, nil).Once()
+				s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+				s.mockPackageInterface.On("UpdatePackage", s.mockOrmQuery, mock.MatchedBy(func(pkg *models.Package) bool {
+					return pkg.IsApproved == models.PackageApproved && pkg.RejectReason == ""
+				})).Return(nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, UserID: userID, IsApproved: models.PackageApproved},
+		},
+		{
+			name: "Sad path - GetPackageByID returns error",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			name: "Sad path - Package does not exist",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{}, nil).Once()
+				s.mockLang.On("Get", "not_exist.package").Return("package not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "package not exist"),
+		},
+		{
+			name: "Sad path - UpdatePackage returns error",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, UserID: userID, IsApproved: models.PackageNotApproved}, nil).Once()
+				s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+				s.mockPackageInterface.On("UpdatePackage", s.mockOrmQuery, mock.AnythingOfType("*models.Package")).Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+
+			pkg, err := s.packageImpl.ApprovePackage(s.ctx, packageID)
+			if test.expectedErr != nil {
+				s.Nil(pkg)
+				s.Equal(test.expectedErr, err)
+			} else {
+				s.Nil(err)
+				s.Equal(test.expectPackage, pkg)
+			}
+
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
 	}
 }
 
@@ -62,6 +167,8 @@ func (s *PackageTestSuite) TestCreatePackage() {
 			Name:          name,
 			UserID:        userID,
 			Link:          url,
+			IsPublic:      models.PackagePrivate,
+			IsApproved:    models.PackageNotApproved,
 			LastUpdatedAt: carbon.DateTime{Carbon: carbon.Parse(lastUpdatedAt)},
 		}
 
@@ -74,20 +181,8 @@ func (s *PackageTestSuite) TestCreatePackage() {
 				UserID: userID,
 			},
 		}
-		mockOrm            *mocksorm.Orm
-		mockOrmQuery       *mocksorm.Query
-		mockOrmAssociation *mocksorm.Association
 	)
 
-	beforeEach := func() {
-		mockFactory := testingmock.Factory()
-		mockOrm = mockFactory.Orm()
-		mockFactory.Log()
-		mockOrmQuery = mockFactory.OrmQuery()
-		mockOrmAssociation = mockFactory.OrmAssociation()
-		mockOrm.On("Query").Return(mockOrmQuery).Once()
-	}
-
 	tests := []struct {
 		name             string
 		request          *protopackage.CreatePackageRequest
@@ -104,13 +199,10 @@ func (s *PackageTestSuite) TestCreatePackage() {
 				LastUpdatedAt: lastUpdatedAt,
 			},
 			setup: func() {
-				beforeEach()
-				mockOrmQuery.On("Create", mock.MatchedBy(func(pkg *models.Package) bool {
-					if pkg.ID == 0 {
-						return false
-					}
+				s.expectTransaction()
+				s.mockPackageInterface.On("CreatePackage", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
 					pkg.ID = 1
-					return pkg.Name == name && pkg.UserID == userID && pkg.Link == url
+					return pkg.Name == name && pkg.UserID == userID && pkg.Link == url && pkg.IsApproved == models.PackageNotApproved
 				})).Return(nil).Once()
 			},
 			expectedResponse: &pkg,
@@ -123,130 +215,64 @@ func (s *PackageTestSuite) TestCreatePackage() {
 				Url:    url,
 			},
 			setup: func() {
-				beforeEach()
-				mockOrmQuery.On("Create", mock.MatchedBy(func(pkg *models.Package) bool {
-					if pkg.ID == 0 {
-						return false
-					}
+				s.expectTransaction()
+				s.mockPackageInterface.On("CreatePackage", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
 					pkg.ID = 1
 					return pkg.Name == name && pkg.UserID == userID && pkg.Link == url
-				})).Return(errors.New("error")).Once()
+				})).Return(utilserrors.New(http.StatusInternalServerError, "error")).Once()
 			},
 			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
 		},
 		{
-			name: "Happy path - Create package with tags",
+			name: "Sad path - Package name is duplicate",
 			request: &protopackage.CreatePackageRequest{
-				UserId:        fmt.Sprint(userID),
-				Name:          name,
-				Url:           url,
-				LastUpdatedAt: lastUpdatedAt,
-				Tags:          []string{"goravel"},
+				UserId: fmt.Sprint(userID),
+				Name:   name,
+				Url:    url,
 			},
 			setup: func() {
-				beforeEach()
-				mockOrmQuery.On("Create", mock.MatchedBy(func(pkg *models.Package) bool {
-					if pkg.ID == 0 {
-						return false
-					}
-					pkg.ID = 1
-					return pkg.Name == name && pkg.UserID == userID && pkg.Link == url
-				})).Return(nil).Once()
-				mockOrm.On("Query").Return(mockOrmQuery).Twice()
-				mockOrmQuery.On("WhereIn", "name", []any{"goravel"}).Return(mockOrmQuery).Once()
-				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Tag")).
-					Return(nil).
-					Run(func(args mock.Arguments) {
-						tagPtr := args.Get(0).(*[]*models.Tag)
-						*tagPtr = []*models.Tag{{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel", UserID: userID}}
-					}).Once()
-
-				mockOrmQuery.On("Model", mock.AnythingOfType("*models.Package")).Return(mockOrmQuery).
-					Run(func(args mock.Arguments) {
-						packagePtr := args.Get(0).(*models.Package)
-						packagePtr.Tags = tags
-					}).Once()
-				mockOrmQuery.On("Association", "Tags").Return(mockOrmAssociation).Once()
-				mockOrmAssociation.On("Replace", mock.MatchedBy(func(tags []*models.Tag) bool {
-					return len(tags) == 1 && tags[0].Name == "goravel"
-				})).Return(nil).Once()
-			},
-			expectedResponse: &models.Package{
-				UUIDModel: models.UUIDModel{
-					ID: 1,
-				},
-				Name:          name,
-				UserID:        userID,
-				Link:          url,
-				LastUpdatedAt: carbon.DateTime{Carbon: carbon.Parse(lastUpdatedAt)},
-				Tags:          tags,
+				s.expectTransaction()
+				s.mockPackageInterface.On("CreatePackage", s.mockTransaction, mock.AnythingOfType("*models.Package")).Return(models.ErrDuplicatePackageName).Once()
+				s.mockLang.On("Get", "exist.package").Return("package exists").Once()
 			},
+			expectedErr: utilserrors.New(http.StatusConflict, "package exists"),
 		},
 		{
-			name: "Sad path - Tags association error",
+			name: "Happy path - Package slug is taken concurrently",
 			request: &protopackage.CreatePackageRequest{
 				UserId:        fmt.Sprint(userID),
 				Name:          name,
 				Url:           url,
 				LastUpdatedAt: lastUpdatedAt,
-				Tags:          []string{"goravel"},
 			},
 			setup: func() {
-				beforeEach()
-				mockOrmQuery.On("Create", mock.MatchedBy(func(pkg *models.Package) bool {
-					if pkg.ID == 0 {
-						return false
-					}
+				s.expectTransaction()
+				s.mockPackageInterface.On("CreatePackage", s.mockTransaction, mock.AnythingOfType("*models.Package")).Return(models.ErrDuplicatePackageSlug).Once()
+				s.expectTransaction()
+				s.mockPackageInterface.On("CreatePackage", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
 					pkg.ID = 1
-					return pkg.Name == name && pkg.UserID == userID && pkg.Link == url
+					return pkg.Name == name && pkg.UserID == userID
 				})).Return(nil).Once()
-				mockOrm.On("Query").Return(mockOrmQuery).Twice()
-				mockOrmQuery.On("WhereIn", "name", []any{"goravel"}).Return(mockOrmQuery).Once()
-				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Tag")).
-					Return(nil).
-					Run(func(args mock.Arguments) {
-						tagPtr := args.Get(0).(*[]*models.Tag)
-						*tagPtr = []*models.Tag{{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel", UserID: userID}}
-					}).Once()
-
-				mockOrmQuery.On("Model", mock.AnythingOfType("*models.Package")).Return(mockOrmQuery).
-					Run(func(args mock.Arguments) {
-						packagePtr := args.Get(0).(*models.Package)
-						packagePtr.Tags = tags
-					}).Once()
-				mockOrmQuery.On("Association", "Tags").Return(mockOrmAssociation).Once()
-				mockOrmAssociation.On("Replace", mock.MatchedBy(func(tags []*models.Tag) bool {
-					return len(tags) == 1 && tags[0].Name == "goravel"
-				})).Return(errors.New("error")).Once()
 			},
-			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+			expectedResponse: &pkg,
 		},
 		{
-			name: "Sad path - Find tag error",
+			name: "Sad path - Package slug keeps being taken",
 			request: &protopackage.CreatePackageRequest{
 				UserId: fmt.Sprint(userID),
 				Name:   name,
 				Url:    url,
-				Tags:   []string{"goravel"},
 			},
 			setup: func() {
-				beforeEach()
-				mockOrmQuery.On("Create", mock.MatchedBy(func(pkg *models.Package) bool {
-					if pkg.ID == 0 {
-						return false
-					}
-					pkg.ID = 1
-					return pkg.Name == name && pkg.UserID == userID && pkg.Link == url
-				})).Return(nil).Once()
-				mockOrm.On("Query").Return(mockOrmQuery).Once()
-				mockOrmQuery.On("WhereIn", "name", []any{"goravel"}).Return(mockOrmQuery).Once()
-				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Tag")).
-					Return(errors.New("error")).Once()
+				for i := 0; i < maxSlugAttempts; i++ {
+					s.expectTransaction()
+				}
+				s.mockPackageInterface.On("CreatePackage", s.mockTransaction, mock.AnythingOfType("*models.Package")).Return(models.ErrDuplicatePackageSlug).Times(maxSlugAttempts)
 			},
-			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+			expectedErr: utilserrors.NewInternalServerError(models.ErrDuplicatePackageSlug),
 		},
 		{
-			name: "Happy path - Tags do not exist",
+			name: "Happy path - Create package with tags",
 			request: &protopackage.CreatePackageRequest{
 				UserId:        fmt.Sprint(userID),
 				Name:          name,
@@ -255,36 +281,15 @@ func (s *PackageTestSuite) TestCreatePackage() {
 				Tags:          []string{"goravel"},
 			},
 			setup: func() {
-				beforeEach()
-				mockOrmQuery.On("Create", mock.MatchedBy(func(pkg *models.Package) bool {
-					if pkg.ID == 0 {
-						return false
-					}
+				s.expectTransaction()
+				s.mockPackageInterface.On("CreatePackage", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
 					pkg.ID = 1
-					return pkg.Name == name && pkg.UserID == userID && pkg.Link == url
+					return pkg.Name == name && pkg.UserID == userID && pkg.Link == url && pkg.IsApproved == models.PackageNotApproved
 				})).Return(nil).Once()
-				mockOrm.On("Query").Return(mockOrmQuery).Times(3)
-				mockOrmQuery.On("WhereIn", "name", []any{"goravel"}).Return(mockOrmQuery).Once()
-				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Tag")).
-					Return(nil).Once()
-
-				mockOrmQuery.On("Create", mock.MatchedBy(func(tags []*models.Tag) bool {
-					return len(tags) == 1 && tags[0].Name == "goravel" && tags[0].UserID == userID && tags[0].IsShow == 1
-				})).Return(nil).
+				s.mockPackageInterface.On("AttachTags", s.mockTransaction, mock.AnythingOfType("*models.Package"), []string{"goravel"}).
 					Run(func(args mock.Arguments) {
-						tagPtr := args.Get(0).([]*models.Tag)
-						tagPtr[0] = &models.Tag{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel", UserID: userID}
-					}).Once()
-
-				mockOrmQuery.On("Model", mock.AnythingOfType("*models.Package")).Return(mockOrmQuery).
-					Run(func(args mock.Arguments) {
-						packagePtr := args.Get(0).(*models.Package)
-						packagePtr.Tags = tags
-					}).Once()
-				mockOrmQuery.On("Association", "Tags").Return(mockOrmAssociation).Once()
-				mockOrmAssociation.On("Replace", mock.MatchedBy(func(tags []*models.Tag) bool {
-					return len(tags) == 1 && tags[0].Name == "goravel"
-				})).Return(nil).Once()
+						args.Get(1).(*models.Package).Tags = tags
+					}).Return(nil).Once()
 			},
 			expectedResponse: &models.Package{
 				UUIDModel: models.UUIDModel{
@@ -293,35 +298,73 @@ func (s *PackageTestSuite) TestCreatePackage() {
 				Name:          name,
 				UserID:        userID,
 				Link:          url,
+				IsPublic:      models.PackagePrivate,
+				IsApproved:    models.PackageNotApproved,
 				LastUpdatedAt: carbon.DateTime{Carbon: carbon.Parse(lastUpdatedAt)},
 				Tags:          tags,
 			},
 		},
 		{
-			name: "Sad path - Create Tag error",
+			name: "Happy path - Create package in organization",
 			request: &protopackage.CreatePackageRequest{
-				UserId: fmt.Sprint(userID),
-				Name:   name,
-				Url:    url,
-				Tags:   []string{"goravel"},
+				UserId:         fmt.Sprint(userID),
+				Name:           name,
+				Url:            url,
+				LastUpdatedAt:  lastUpdatedAt,
+				OrganizationId: "2",
 			},
 			setup: func() {
-				beforeEach()
-				mockOrmQuery.On("Create", mock.MatchedBy(func(pkg *models.Package) bool {
-					if pkg.ID == 0 {
-						return false
-					}
+				s.mockUserService.On("GetMember", s.ctx, uint64(2), userID).Return(&protouser.OrganizationMember{OrganizationId: "2", UserId: "1", Role: "member"}, nil).Once()
+				s.expectTransaction()
+				s.mockPackageInterface.On("CreatePackage", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
 					pkg.ID = 1
-					return pkg.Name == name && pkg.UserID == userID && pkg.Link == url
+					return pkg.Name == name && pkg.UserID == userID && pkg.OrganizationID == 2
 				})).Return(nil).Once()
-				mockOrm.On("Query").Return(mockOrmQuery).Twice()
-				mockOrmQuery.On("WhereIn", "name", []any{"goravel"}).Return(mockOrmQuery).Once()
-				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Tag")).
-					Return(nil).Once()
-
-				mockOrmQuery.On("Create", mock.MatchedBy(func(tags []*models.Tag) bool {
-					return len(tags) == 1 && tags[0].Name == "goravel" && tags[0].UserID == userID && tags[0].IsShow == 1
-				})).Return(errors.New("error")).Once()
+			},
+			expectedResponse: &models.Package{
+				UUIDModel: models.UUIDModel{
+					ID: 1,
+				},
+				Name:           name,
+				UserID:         userID,
+				Link:           url,
+				IsPublic:       models.PackagePrivate,
+				IsApproved:     models.PackageNotApproved,
+				LastUpdatedAt:  carbon.DateTime{Carbon: carbon.Parse(lastUpdatedAt)},
+				OrganizationID: 2,
+			},
+		},
+		{
+			name: "Sad path - User isn't a member of organization",
+			request: &protopackage.CreatePackageRequest{
+				UserId:         fmt.Sprint(userID),
+				Name:           name,
+				Url:            url,
+				OrganizationId: "2",
+			},
+			setup: func() {
+				s.mockUserService.On("GetMember", s.ctx, uint64(2), userID).Return(nil, nil).Once()
+				s.mockLang.On("Get", "forbidden.organization").Return("forbidden.organization").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusUnauthorized, "forbidden.organization"),
+		},
+		{
+			name: "Sad path - AttachTags returns error",
+			request: &protopackage.CreatePackageRequest{
+				UserId:        fmt.Sprint(userID),
+				Name:          name,
+				Url:           url,
+				LastUpdatedAt: lastUpdatedAt,
+				Tags:          []string{"goravel"},
+			},
+			setup: func() {
+				s.expectTransaction()
+				s.mockPackageInterface.On("CreatePackage", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
+					pkg.ID = 1
+					return pkg.Name == name && pkg.UserID == userID && pkg.Link == url && pkg.IsApproved == models.PackageNotApproved
+				})).Return(nil).Once()
+				s.mockPackageInterface.On("AttachTags", s.mockTransaction, mock.AnythingOfType("*models.Package"), []string{"goravel"}).
+					Return(utilserrors.New(http.StatusInternalServerError, "error")).Once()
 			},
 			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
 		},
@@ -330,20 +373,110 @@ func (s *PackageTestSuite) TestCreatePackage() {
 	for _, test := range tests {
 		s.Run(test.name, func() {
 			test.setup()
-			response, err := s.packageImpl.CreatePackage(test.request)
+			response, err := s.packageImpl.CreatePackage(s.ctx, test.request)
 
 			s.Equal(test.expectedResponse, response)
 			s.Equal(test.expectedErr, err)
+
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockUserService.AssertExpectations(s.T())
 		})
 	}
 }
 
-func (s *PackageTestSuite) TestGetPackageByID() {
+func (s *PackageTestSuite) TestDeletePackage() {
 	var (
 		packageID = "1"
 		userID    = uint64(1)
-		fields    = []string{"id", "name", "user_id", "summary", "description", "link", "version", "last_updated_at", "view_count"}
-		user      = &protouser.User{
+		name      = "goravel/gin"
+	)
+
+	tests := []struct {
+		name        string
+		userID      string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name:   "Happy path",
+			userID: fmt.Sprint(userID),
+			setup: func() {
+				pkg := &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, UserID: userID}
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(pkg, nil).Once()
+				s.mockPackageInterface.On("DeletePackage", pkg).Return(nil).Once()
+			},
+		},
+		{
+			name:   "Sad path - GetPackageByID returns error",
+			userID: fmt.Sprint(userID),
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			name:   "Sad path - Package does not exist",
+			userID: fmt.Sprint(userID),
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{}, nil).Once()
+				s.mockLang.On("Get", "not_exist.package").Return("package not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "package not exist"),
+		},
+		{
+			name:   "Sad path - User isn't owner of package",
+			userID: "2",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, UserID: userID}, nil).Once()
+				s.mockMaintainerInterface.On("GetMaintainer", uint64(1), uint64(2)).Return(&models.Maintainer{}, nil).Once()
+				s.mockLang.On("Get", "forbidden.delete_package").Return("forbidden").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusUnauthorized, "forbidden"),
+		},
+		{
+			name:   "Sad path - Maintainer can't delete package",
+			userID: "2",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, UserID: userID}, nil).Once()
+				s.mockMaintainerInterface.On("GetMaintainer", uint64(1), uint64(2)).Return(&models.Maintainer{UUIDModel: models.UUIDModel{ID: 1}, PackageID: 1, UserID: 2, Role: models.MaintainerRoleMaintainer}, nil).Once()
+				s.mockLang.On("Get", "forbidden.delete_package").Return("forbidden").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusUnauthorized, "forbidden"),
+		},
+		{
+			name:   "Sad path - DeletePackage returns error",
+			userID: fmt.Sprint(userID),
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, UserID: userID}, nil).Once()
+				s.mockPackageInterface.On("DeletePackage", mock.AnythingOfType("*models.Package")).Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+
+			err := s.packageImpl.DeletePackage(s.ctx, packageID, test.userID)
+			s.Equal(test.expectedErr, err)
+
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageTestSuite) TestGetPackageByID() {
+	var (
+		packageID       = "1"
+		userID          = uint64(1)
+		description     = "# Gin"
+		descriptionHTML = "<h1 id=\"gin\">Gin</h1>\n"
+		link            = "https://github.com/goravel/gin"
+		fields          = []string{"id", "name", "slug", "user_id", "summary", "description", "link", "cover", "cover_thumbnail", "version", "last_updated_at", "view_count", "stars", "license", "readme", "synced_at", "favorite_count", "rating_count", "rating_sum", "comment_count", "is_approved", "is_public", "revision", "pending_owner_id", "organization_id"}
+		user            = &protouser.User{
 			Id:   "1",
 			Name: "test",
 		}
@@ -358,10 +491,11 @@ func (s *PackageTestSuite) TestGetPackageByID() {
 		{
 			name: "Happy path - GetPackageByID with ID",
 			setup: func() {
-				s.mockPackageInterface.On("GetPackageByID", packageID, fields).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", UserID: userID}, nil).Once()
+				s.mockPackageInterface.On("GetPackageByID", packageID, fields).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", UserID: userID, Description: description, Link: link}, nil).Once()
+				s.mockMarkdownService.On("Render", description, link).Return(descriptionHTML, nil).Once()
 				s.mockUserService.On("GetUser", s.ctx, userID).Return(user, nil).Once()
 			},
-			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", UserID: userID, User: user},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", UserID: userID, Description: description, DescriptionHTML: descriptionHTML, Link: link, User: user},
 			expectedErr:   nil,
 		},
 		{
@@ -371,10 +505,19 @@ func (s *PackageTestSuite) TestGetPackageByID() {
 			},
 			expectedErr: errors.New("error"),
 		},
+		{
+			name: "Sad path - Render returns error",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, fields).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", UserID: userID, Description: description, Link: link}, nil).Once()
+				s.mockMarkdownService.On("Render", description, link).Return("", errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
 		{
 			name: "Sad path - GetUser returns error",
 			setup: func() {
 				s.mockPackageInterface.On("GetPackageByID", packageID, fields).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", UserID: userID}, nil).Once()
+				s.mockMarkdownService.On("Render", "", "").Return("", nil).Once()
 				s.mockUserService.On("GetUser", s.ctx, userID).Return(nil, errors.New("error")).Once()
 			},
 			expectedErr: errors.New("error"),
@@ -397,55 +540,124 @@ func (s *PackageTestSuite) TestGetPackageByID() {
 	}
 }
 
-func (s *PackageTestSuite) TestGetPackages() {
+func (s *PackageTestSuite) TestGetPackageBySlug() {
 	var (
-		name   = "go"
+		slug   = "goravel-gin"
 		userID = uint64(1)
-		fields = []string{"id", "name", "user_id", "summary", "link", "view_count"}
-		users  = []*protouser.User{
-			{
-				Id:   "1",
-				Name: "test",
-			},
+		fields = []string{"id", "name", "slug", "user_id", "summary", "description", "link", "cover", "cover_thumbnail", "version", "last_updated_at", "view_count", "stars", "license", "readme", "synced_at", "favorite_count", "rating_count", "rating_sum", "comment_count", "is_approved", "is_public", "revision", "pending_owner_id", "organization_id"}
+		user   = &protouser.User{
+			Id:   "1",
+			Name: "test",
 		}
-
-		mockOrm      *mocksorm.Orm
-		mockOrmQuery *mocksorm.Query
-		pagination   *protobase.Pagination
-		query        *protopackage.PackagesQuery
 	)
 
-	beforeSetup := func() {
-		mockFactory := testingmock.Factory()
-		mockOrm = mockFactory.Orm()
-		mockFactory.Log()
-		mockOrmQuery = mockFactory.OrmQuery()
-		mockOrm.On("Query").Return(mockOrmQuery).Once()
-	}
-
 	tests := []struct {
-		name           string
-		setup          func()
-		expectPackages []*models.Package
-		expectedTotal  int64
-		expectedErr    error
+		name          string
+		setup         func()
+		expectPackage *models.Package
+		expectedErr   error
 	}{
 		{
-			name: "Happy path - GetPackages with query and pagination",
+			name: "Happy path",
 			setup: func() {
-				pagination = &protobase.Pagination{
-					Page:  1,
-					Limit: 10,
-				}
-				query = &protopackage.PackagesQuery{
-					Name:     name,
-					Category: "hot",
-				}
-
-				beforeSetup()
-				mockOrmQuery.On("OrderByDesc", "view_count").Return(mockOrmQuery).Once()
-				mockOrmQuery.On("With", "Tags", mock.Anything).Return(mockOrmQuery).Once()
-				mockOrmQuery.On("Where", "name LIKE ?", "%"+name+"%").Return(mockOrmQuery).Once()
+				s.mockPackageInterface.On("GetPackageBySlug", slug, fields).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", Slug: slug, UserID: userID, Description: "# Gin"}, nil).Once()
+				s.mockMarkdownService.On("Render", "# Gin", "").Return("<h1 id=\"gin\">Gin</h1>\n", nil).Once()
+				s.mockUserService.On("GetUser", s.ctx, userID).Return(user, nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", Slug: slug, UserID: userID, Description: "# Gin", DescriptionHTML: "<h1 id=\"gin\">Gin</h1>\n", User: user},
+		},
+		{
+			name: "Happy path - Package does not exist",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageBySlug", slug, fields).Return(&models.Package{}, nil).Once()
+			},
+			expectPackage: &models.Package{},
+		},
+		{
+			name: "Sad path - GetPackageBySlug returns error",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageBySlug", slug, fields).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+
+			pkg, err := s.packageImpl.GetPackageBySlug(slug)
+			if test.expectedErr != nil {
+				s.Nil(pkg)
+				s.Equal(test.expectedErr, err)
+			} else {
+				s.Nil(err)
+				s.Equal(test.expectPackage, pkg)
+			}
+
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockMarkdownService.AssertExpectations(s.T())
+			s.mockUserService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageTestSuite) TestGetPackages() {
+	var (
+		name   = "go"
+		userID = uint64(1)
+		fields = []string{"id", "name", "slug", "user_id", "summary", "link", "cover", "cover_thumbnail", "view_count", "stars", "favorite_count", "rating_count", "rating_sum", "comment_count", "last_updated_at", "is_approved", "is_public", "created_at"}
+		users  = []*protouser.User{
+			{
+				Id:   "1",
+				Name: "test",
+			},
+		}
+
+		mockLang     *mockstranslation.Translator
+		mockOrm      *mocksorm.Orm
+		mockOrmQuery *mocksorm.Query
+		pagination   *protobase.Pagination
+		query        *protopackage.PackagesQuery
+		viewerID     string
+	)
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockLang = mockFactory.Lang(s.ctx)
+		mockOrm = mockFactory.Orm()
+		mockFactory.Log()
+		mockOrmQuery = mockFactory.OrmQuery()
+		mockOrm.On("Query").Return(mockOrmQuery).Once()
+		viewerID = ""
+	}
+
+	tests := []struct {
+		name           string
+		setup          func()
+		expectPackages []*models.Package
+		expectedTotal  int64
+		expectedCursor string
+		expectedErr    error
+	}{
+		{
+			name: "Happy path - GetPackages with query and pagination",
+			setup: func() {
+				pagination = &protobase.Pagination{
+					Page:  1,
+					Limit: 10,
+				}
+				query = &protopackage.PackagesQuery{
+					Name:     name,
+					Category: "hot",
+				}
+
+				beforeSetup()
+				mockOrmQuery.On("Scopes", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "COALESCE(view_count, 0) DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "id DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("With", "Tags", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Where", "name LIKE ?", "%"+name+"%").Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Select", fields).Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Paginate", int(pagination.GetPage()), int(pagination.GetLimit()), mock.AnythingOfType("*[]*models.Package"), mock.AnythingOfType("*int64")).
 					Return(nil).
@@ -474,6 +686,8 @@ func (s *PackageTestSuite) TestGetPackages() {
 				query = &protopackage.PackagesQuery{}
 
 				beforeSetup()
+				mockOrmQuery.On("Scopes", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "id DESC").Return(mockOrmQuery).Once()
 
 				mockOrmQuery.On("With", "Tags", mock.Anything).Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Select", fields).Return(mockOrmQuery).Once()
@@ -488,61 +702,531 @@ func (s *PackageTestSuite) TestGetPackages() {
 					}).Once()
 				s.mockUserService.On("GetUsers", s.ctx, []string{fmt.Sprint(userID)}).Return(users, nil).Once()
 			},
-			expectPackages: []*models.Package{{UUIDModel: models.UUIDModel{ID: 3}, Name: "goravel/cloudinary", UserID: userID, User: users[0]}},
-			expectedTotal:  1,
-			expectedErr:    nil,
+			expectPackages: []*models.Package{{UUIDModel: models.UUIDModel{ID: 3}, Name: "goravel/cloudinary", UserID: userID, User: users[0]}},
+			expectedTotal:  1,
+			expectedErr:    nil,
+		},
+		{
+			name: "Happy path - GetPackages by signed-in user",
+			setup: func() {
+				name = ""
+				pagination = &protobase.Pagination{
+					Page:  1,
+					Limit: 10,
+				}
+
+				query = &protopackage.PackagesQuery{}
+
+				beforeSetup()
+				viewerID = fmt.Sprint(userID)
+				s.mockUserService.On("ListUserOrganizations", s.ctx, userID).Return([]uint64{2}, nil).Once()
+				mockOrmQuery.On("Scopes", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "id DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("With", "Tags", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Select", fields).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Paginate", int(pagination.GetPage()), int(pagination.GetLimit()), mock.AnythingOfType("*[]*models.Package"), mock.AnythingOfType("*int64")).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						packagesPtr := args.Get(2).(*[]*models.Package)
+						*packagesPtr = []*models.Package{{UUIDModel: models.UUIDModel{ID: 3}, Name: "goravel/cloudinary", UserID: userID, IsApproved: models.PackageNotApproved}}
+
+						totalPtr := args.Get(3).(*int64)
+						*totalPtr = 1
+					}).Once()
+				s.mockUserService.On("GetUsers", s.ctx, []string{fmt.Sprint(userID)}).Return(users, nil).Once()
+			},
+			expectPackages: []*models.Package{{UUIDModel: models.UUIDModel{ID: 3}, Name: "goravel/cloudinary", UserID: userID, IsApproved: models.PackageNotApproved, User: users[0]}},
+			expectedTotal:  1,
+			expectedErr:    nil,
+		},
+		{
+			name: "Happy path - GetPackages with any of the tags sorted by name",
+			setup: func() {
+				pagination = &protobase.Pagination{
+					Page:  1,
+					Limit: 10,
+				}
+				query = &protopackage.PackagesQuery{
+					TagIds:   []string{"1", "2"},
+					TagNames: []string{"ORM", "database_orm", " orm "},
+					Sort:     "name",
+				}
+
+				beforeSetup()
+				mockOrmQuery.On("Select", fields).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "name ASC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "id ASC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Where", "EXISTS (SELECT 1 FROM package_tags JOIN tags ON tags.id = package_tags.tag_id AND tags.is_show = 1 AND tags.deleted_at IS NULL WHERE package_tags.package_id = packages.id AND (tags.id IN ? OR LOWER(tags.name) IN ? OR tags.id IN (SELECT tag_id FROM tag_aliases WHERE name IN ?)))", []uint64{1, 2}, []string{"orm", "database-orm"}, []string{"orm", "database-orm"}).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Scopes", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("With", "Tags", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Paginate", int(pagination.GetPage()), int(pagination.GetLimit()), mock.AnythingOfType("*[]*models.Package"), mock.AnythingOfType("*int64")).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						packagesPtr := args.Get(2).(*[]*models.Package)
+						*packagesPtr = []*models.Package{{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", UserID: userID}}
+
+						totalPtr := args.Get(3).(*int64)
+						*totalPtr = 1
+					}).Once()
+				s.mockUserService.On("GetUsers", s.ctx, []string{fmt.Sprint(userID)}).Return(users, nil).Once()
+			},
+			expectPackages: []*models.Package{{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", UserID: userID, User: users[0]}},
+			expectedTotal:  1,
+		},
+		{
+			name: "Happy path - GetPackages with all of the tags sorted by last updated time",
+			setup: func() {
+				pagination = &protobase.Pagination{
+					Page:  1,
+					Limit: 10,
+				}
+				query = &protopackage.PackagesQuery{
+					TagIds:    []string{"1"},
+					TagNames:  []string{"ORM"},
+					TagMatch:  "all",
+					Sort:      "updated",
+					Direction: "asc",
+					Category:  "hot",
+				}
+
+				beforeSetup()
+				mockOrmQuery.On("Select", fields).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "COALESCE(last_updated_at, created_at) ASC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "id ASC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Where", "EXISTS (SELECT 1 FROM package_tags JOIN tags ON tags.id = package_tags.tag_id AND tags.is_show = 1 AND tags.deleted_at IS NULL WHERE package_tags.package_id = packages.id AND tags.id = ?)", uint64(1)).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Where", "EXISTS (SELECT 1 FROM package_tags JOIN tags ON tags.id = package_tags.tag_id AND tags.is_show = 1 AND tags.deleted_at IS NULL WHERE package_tags.package_id = packages.id AND (LOWER(tags.name) = ? OR tags.id IN (SELECT tag_id FROM tag_aliases WHERE name = ?)))", "orm", "orm").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Scopes", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("With", "Tags", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Paginate", int(pagination.GetPage()), int(pagination.GetLimit()), mock.AnythingOfType("*[]*models.Package"), mock.AnythingOfType("*int64")).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						packagesPtr := args.Get(2).(*[]*models.Package)
+						*packagesPtr = []*models.Package{}
+
+						totalPtr := args.Get(3).(*int64)
+						*totalPtr = 0
+					}).Once()
+			},
+			expectPackages: []*models.Package{},
+			expectedTotal:  0,
+		},
+		{
+			name: "Happy path - GetPackages with full-text search",
+			setup: func() {
+				pagination = &protobase.Pagination{
+					Page:  1,
+					Limit: 10,
+				}
+				query = &protopackage.PackagesQuery{
+					Search:   " gin router ",
+					Category: "hot",
+				}
+
+				beforeSetup()
+				mockOrmQuery.On("Select", mock.MatchedBy(func(columns string) bool {
+					return strings.HasPrefix(columns, strings.Join(fields, ", ")+", ts_rank_cd(search_vector, ") && strings.Contains(columns, "ts_headline(")
+				}), "gin router", "gin router").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Where", "search_vector @@ websearch_to_tsquery('english', ?)", "gin router").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "search_rank DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "COALESCE(view_count, 0) DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "id DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Scopes", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("With", "Tags", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Paginate", int(pagination.GetPage()), int(pagination.GetLimit()), mock.AnythingOfType("*[]*models.Package"), mock.AnythingOfType("*int64")).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						packagesPtr := args.Get(2).(*[]*models.Package)
+						*packagesPtr = []*models.Package{{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", UserID: userID, Highlight: "[[mark]]Gin[[/mark]] driver <script>"}}
+
+						totalPtr := args.Get(3).(*int64)
+						*totalPtr = 1
+					}).Once()
+				s.mockUserService.On("GetUsers", s.ctx, []string{fmt.Sprint(userID)}).Return(users, nil).Once()
+			},
+			expectPackages: []*models.Package{{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", UserID: userID, Highlight: "<mark>Gin</mark> driver &lt;script&gt;", User: users[0]}},
+			expectedTotal:  1,
+		},
+		{
+			name: "Happy path - GetPackages with a cursor is paginated by keyset",
+			setup: func() {
+				pagination = &protobase.Pagination{
+					Limit:  1,
+					Cursor: utilspagination.EncodeCursor(utilspagination.Cursor{ID: 5, Value: 100, Sort: "COALESCE(view_count, 0)", Desc: true}),
+				}
+				query = &protopackage.PackagesQuery{
+					Sort: "views",
+				}
+
+				beforeSetup()
+				mockOrmQuery.On("Select", fields).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Scopes", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("With", "Tags", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Where", "(COALESCE(view_count, 0) < ? OR (COALESCE(view_count, 0) = ? AND id < ?))", int64(100), int64(100), uint64(5)).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "COALESCE(view_count, 0) DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "id DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Limit", 2).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Package")).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						packagesPtr := args.Get(0).(*[]*models.Package)
+						*packagesPtr = []*models.Package{
+							{UUIDModel: models.UUIDModel{ID: 3}, Name: "goravel/gin", UserID: userID, ViewCount: 100},
+							{UUIDModel: models.UUIDModel{ID: 2}, Name: "goravel/fiber", UserID: userID, ViewCount: 90},
+						}
+					}).Once()
+				s.mockUserService.On("GetUsers", s.ctx, []string{fmt.Sprint(userID)}).Return(users, nil).Once()
+			},
+			expectPackages: []*models.Package{{UUIDModel: models.UUIDModel{ID: 3}, Name: "goravel/gin", UserID: userID, ViewCount: 100, User: users[0]}},
+			expectedCursor: utilspagination.EncodeCursor(utilspagination.Cursor{ID: 3, Value: 100, Sort: "COALESCE(view_count, 0)", Desc: true}),
+		},
+		{
+			name: "Sad path - the cursor of another sort",
+			setup: func() {
+				pagination = &protobase.Pagination{
+					Limit:  1,
+					Cursor: utilspagination.EncodeCursor(utilspagination.Cursor{ID: 5, Value: 100, Sort: "COALESCE(view_count, 0)", Desc: true}),
+				}
+				query = &protopackage.PackagesQuery{
+					Sort: "name",
+				}
+
+				beforeSetup()
+				mockOrmQuery.On("Select", fields).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Scopes", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("With", "Tags", mock.Anything).Return(mockOrmQuery).Once()
+				mockLang.On("Get", "invalid.cursor").Return("Cursor is invalid").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("Cursor is invalid"),
+		},
+		{
+			name: "Happy path - GetPackages without a cursor returns the cursor of the next page",
+			setup: func() {
+				pagination = &protobase.Pagination{
+					Page:  1,
+					Limit: 1,
+				}
+				query = &protopackage.PackagesQuery{}
+
+				beforeSetup()
+				mockOrmQuery.On("Select", fields).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Scopes", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("With", "Tags", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "id DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Paginate", 1, 1, mock.AnythingOfType("*[]*models.Package"), mock.AnythingOfType("*int64")).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						packagesPtr := args.Get(2).(*[]*models.Package)
+						*packagesPtr = []*models.Package{{UUIDModel: models.UUIDModel{ID: 3}, Name: "goravel/gin", UserID: userID}}
+
+						totalPtr := args.Get(3).(*int64)
+						*totalPtr = 2
+					}).Once()
+				s.mockUserService.On("GetUsers", s.ctx, []string{fmt.Sprint(userID)}).Return(users, nil).Once()
+			},
+			expectPackages: []*models.Package{{UUIDModel: models.UUIDModel{ID: 3}, Name: "goravel/gin", UserID: userID, User: users[0]}},
+			expectedTotal:  2,
+			expectedCursor: utilspagination.EncodeCursor(utilspagination.Cursor{ID: 3, Desc: true}),
+		},
+		{
+			name: "Sad path - Paginate return error",
+			setup: func() {
+				name = "go"
+				pagination = &protobase.Pagination{
+					Page:  1,
+					Limit: 10,
+				}
+
+				query = &protopackage.PackagesQuery{
+					Name: name,
+				}
+
+				beforeSetup()
+				mockOrmQuery.On("Scopes", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "id DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("With", "Tags", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Where", "name LIKE ?", "%"+name+"%").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Select", fields).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Paginate", int(pagination.GetPage()), int(pagination.GetLimit()), mock.AnythingOfType("*[]*models.Package"), mock.AnythingOfType("*int64")).
+					Return(errors.New("paginate error")).Once()
+			},
+			expectPackages: nil,
+			expectedTotal:  0,
+			expectedErr:    utilserrors.New(http.StatusInternalServerError, "paginate error"),
+		},
+		{
+			name: "Happy path - GetUsers returns error",
+			setup: func() {
+				name = ""
+				pagination = &protobase.Pagination{
+					Page:  1,
+					Limit: 10,
+				}
+
+				query = &protopackage.PackagesQuery{}
+
+				beforeSetup()
+				mockOrmQuery.On("Scopes", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "id DESC").Return(mockOrmQuery).Once()
+
+				mockOrmQuery.On("With", "Tags", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Select", fields).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Paginate", int(pagination.GetPage()), int(pagination.GetLimit()), mock.AnythingOfType("*[]*models.Package"), mock.AnythingOfType("*int64")).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						packagesPtr := args.Get(2).(*[]*models.Package)
+						*packagesPtr = []*models.Package{{UUIDModel: models.UUIDModel{ID: 3}, Name: "goravel/cloudinary", UserID: userID}}
+
+						totalPtr := args.Get(3).(*int64)
+						*totalPtr = 1
+					}).Once()
+				s.mockUserService.On("GetUsers", s.ctx, []string{fmt.Sprint(userID)}).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+
+			packages, total, nextCursor, err := s.packageImpl.GetPackages(s.ctx, viewerID, query, pagination)
+			if test.expectedErr != nil {
+				s.Nil(packages)
+				s.Equal(int64(0), total)
+				s.Empty(nextCursor)
+				s.Equal(test.expectedErr, err)
+			} else {
+				s.Nil(err)
+				s.Equal(test.expectPackages, packages)
+				s.Equal(test.expectedTotal, total)
+				s.Equal(test.expectedCursor, nextCursor)
+			}
+
+			mockLang.AssertExpectations(s.T())
+			mockOrm.AssertExpectations(s.T())
+			mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageTestSuite) TestGetPendingPackages() {
+	var (
+		userID = uint64(1)
+		users  = []*protouser.User{
+			{
+				Id:   "1",
+				Name: "test",
+			},
+		}
+		pagination = &protobase.Pagination{
+			Page:  1,
+			Limit: 10,
+		}
+
+		mockOrm      *mocksorm.Orm
+		mockOrmQuery *mocksorm.Query
+	)
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockOrm = mockFactory.Orm()
+		mockFactory.Log()
+		mockOrmQuery = mockFactory.OrmQuery()
+		mockOrm.On("Query").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Where", "is_approved = ?", models.PackageNotApproved).Return(mockOrmQuery).Once()
+		mockOrmQuery.On("With", "Tags", mock.Anything).Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Order", "id ASC").Return(mockOrmQuery).Once()
+	}
+
+	tests := []struct {
+		name           string
+		setup          func()
+		expectPackages []*models.Package
+		expectedTotal  int64
+		expectedErr    error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				mockOrmQuery.On("Paginate", int(pagination.GetPage()), int(pagination.GetLimit()), mock.AnythingOfType("*[]*models.Package"), mock.AnythingOfType("*int64")).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						packagesPtr := args.Get(2).(*[]*models.Package)
+						*packagesPtr = []*models.Package{{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", UserID: userID, IsApproved: models.PackageNotApproved}}
+
+						totalPtr := args.Get(3).(*int64)
+						*totalPtr = 1
+					}).Once()
+				s.mockUserService.On("GetUsers", s.ctx, []string{fmt.Sprint(userID)}).Return(users, nil).Once()
+			},
+			expectPackages: []*models.Package{{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", UserID: userID, IsApproved: models.PackageNotApproved, User: users[0]}},
+			expectedTotal:  1,
+		},
+		{
+			name: "Sad path - Paginate returns error",
+			setup: func() {
+				mockOrmQuery.On("Paginate", int(pagination.GetPage()), int(pagination.GetLimit()), mock.AnythingOfType("*[]*models.Package"), mock.AnythingOfType("*int64")).
+					Return(errors.New("paginate error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "paginate error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			beforeSetup()
+			test.setup()
+
+			packages, total, nextCursor, err := s.packageImpl.GetPendingPackages(s.ctx, pagination)
+			s.Empty(nextCursor)
+			if test.expectedErr != nil {
+				s.Nil(packages)
+				s.Equal(int64(0), total)
+				s.Equal(test.expectedErr, err)
+			} else {
+				s.Nil(err)
+				s.Equal(test.expectPackages, packages)
+				s.Equal(test.expectedTotal, total)
+			}
+
+			mockOrm.AssertExpectations(s.T())
+			mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageTestSuite) TestRejectPackage() {
+	var (
+		packageID = "1"
+		userID    = uint64(1)
+		name      = "goravel/gin"
+		reason    = "The link is broken"
+	)
+
+	tests := []struct {
+		name          string
+		setup         func()
+		expectPackage *models.Package
+		expectedErr   error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, UserID: userID, IsApproved: models.PackageNotApproved}, nil).Once()
+				s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+				s.mockPackageInterface.On("UpdatePackage", s.mockOrmQuery, mock.MatchedBy(func(pkg *models.Package) bool {
+					return pkg.IsApproved == models.PackageRejected && pkg.RejectReason == reason
+				})).Return(nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, UserID: userID, IsApproved: models.PackageRejected, RejectReason: reason},
+		},
+		{
+			name: "Sad path - Package does not exist",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{}, nil).Once()
+				s.mockLang.On("Get", "not_exist.package").Return("package not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "package not exist"),
+		},
+		{
+			name: "Sad path - UpdatePackage returns error",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, UserID: userID, IsApproved: models.PackageNotApproved}, nil).Once()
+				s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+				s.mockPackageInterface.On("UpdatePackage", s.mockOrmQuery, mock.AnythingOfType("*models.Package")).Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+
+			pkg, err := s.packageImpl.RejectPackage(s.ctx, packageID, reason)
+			if test.expectedErr != nil {
+				s.Nil(pkg)
+				s.Equal(test.expectedErr, err)
+			} else {
+				s.Nil(err)
+				s.Equal(test.expectPackage, pkg)
+			}
+
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageTestSuite) TestRestorePackage() {
+	var (
+		packageID = "1"
+		userID    = uint64(1)
+		name      = "goravel/gin"
+	)
+
+	tests := []struct {
+		name          string
+		userID        string
+		setup         func()
+		expectPackage *models.Package
+		expectedErr   error
+	}{
+		{
+			name:   "Happy path",
+			userID: fmt.Sprint(userID),
+			setup: func() {
+				pkg := &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, UserID: userID}
+				s.mockPackageInterface.On("GetDeletedPackageByID", packageID).Return(pkg, nil).Once()
+				s.mockPackageInterface.On("RestorePackage", pkg).Return(nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, UserID: userID},
+		},
+		{
+			name:   "Sad path - GetDeletedPackageByID returns error",
+			userID: fmt.Sprint(userID),
+			setup: func() {
+				s.mockPackageInterface.On("GetDeletedPackageByID", packageID).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			name:   "Sad path - Deleted package does not exist",
+			userID: fmt.Sprint(userID),
+			setup: func() {
+				s.mockPackageInterface.On("GetDeletedPackageByID", packageID).Return(&models.Package{}, nil).Once()
+				s.mockLang.On("Get", "not_exist.package").Return("package not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "package not exist"),
 		},
 		{
-			name: "Sad path - Paginate return error",
+			name:   "Sad path - User isn't owner of package",
+			userID: "2",
 			setup: func() {
-				name = "go"
-				pagination = &protobase.Pagination{
-					Page:  1,
-					Limit: 10,
-				}
-
-				query = &protopackage.PackagesQuery{
-					Name: name,
-				}
-
-				beforeSetup()
-				mockOrmQuery.On("With", "Tags", mock.Anything).Return(mockOrmQuery).Once()
-				mockOrmQuery.On("Where", "name LIKE ?", "%"+name+"%").Return(mockOrmQuery).Once()
-				mockOrmQuery.On("Select", fields).Return(mockOrmQuery).Once()
-				mockOrmQuery.On("Paginate", int(pagination.GetPage()), int(pagination.GetLimit()), mock.AnythingOfType("*[]*models.Package"), mock.AnythingOfType("*int64")).
-					Return(errors.New("paginate error")).Once()
+				s.mockPackageInterface.On("GetDeletedPackageByID", packageID).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, UserID: userID}, nil).Once()
+				s.mockMaintainerInterface.On("GetMaintainer", uint64(1), uint64(2)).Return(&models.Maintainer{}, nil).Once()
+				s.mockLang.On("Get", "forbidden.restore_package").Return("forbidden").Once()
 			},
-			expectPackages: nil,
-			expectedTotal:  0,
-			expectedErr:    utilserrors.New(http.StatusInternalServerError, "paginate error"),
+			expectedErr: utilserrors.New(http.StatusUnauthorized, "forbidden"),
 		},
 		{
-			name: "Happy path - GetUsers returns error",
+			name:   "Sad path - RestorePackage returns error",
+			userID: fmt.Sprint(userID),
 			setup: func() {
-				name = ""
-				pagination = &protobase.Pagination{
-					Page:  1,
-					Limit: 10,
-				}
-
-				query = &protopackage.PackagesQuery{}
-
-				beforeSetup()
-
-				mockOrmQuery.On("With", "Tags", mock.Anything).Return(mockOrmQuery).Once()
-				mockOrmQuery.On("Select", fields).Return(mockOrmQuery).Once()
-				mockOrmQuery.On("Paginate", int(pagination.GetPage()), int(pagination.GetLimit()), mock.AnythingOfType("*[]*models.Package"), mock.AnythingOfType("*int64")).
-					Return(nil).
-					Run(func(args mock.Arguments) {
-						packagesPtr := args.Get(2).(*[]*models.Package)
-						*packagesPtr = []*models.Package{{UUIDModel: models.UUIDModel{ID: 3}, Name: "goravel/cloudinary", UserID: userID}}
-
-						totalPtr := args.Get(3).(*int64)
-						*totalPtr = 1
-					}).Once()
-				s.mockUserService.On("GetUsers", s.ctx, []string{fmt.Sprint(userID)}).Return(nil, errors.New("error")).Once()
+				s.mockPackageInterface.On("GetDeletedPackageByID", packageID).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, UserID: userID}, nil).Once()
+				s.mockPackageInterface.On("RestorePackage", mock.AnythingOfType("*models.Package")).Return(errors.New("error")).Once()
 			},
-			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+			expectedErr: errors.New("error"),
+		},
+		{
+			name:   "Sad path - Package name is taken by another package",
+			userID: fmt.Sprint(userID),
+			setup: func() {
+				s.mockPackageInterface.On("GetDeletedPackageByID", packageID).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, UserID: userID}, nil).Once()
+				s.mockPackageInterface.On("RestorePackage", mock.AnythingOfType("*models.Package")).Return(models.ErrDuplicatePackageName).Once()
+				s.mockLang.On("Get", "exist.package").Return("package exists").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusConflict, "package exists"),
 		},
 	}
 
@@ -550,19 +1234,17 @@ func (s *PackageTestSuite) TestGetPackages() {
 		s.Run(test.name, func() {
 			test.setup()
 
-			packages, total, err := s.packageImpl.GetPackages(query, pagination)
+			pkg, err := s.packageImpl.RestorePackage(s.ctx, packageID, test.userID)
 			if test.expectedErr != nil {
-				s.Nil(packages)
-				s.Equal(int64(0), total)
+				s.Nil(pkg)
 				s.Equal(test.expectedErr, err)
 			} else {
 				s.Nil(err)
-				s.Equal(test.expectPackages, packages)
-				s.Equal(test.expectedTotal, total)
+				s.Equal(test.expectPackage, pkg)
 			}
 
-			mockOrm.AssertExpectations(s.T())
-			mockOrmQuery.AssertExpectations(s.T())
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
 		})
 	}
 }
@@ -594,12 +1276,321 @@ func (s *PackageTestSuite) TestUpdatePackage() {
 				LastUpdatedAt: lastUpdatedAt,
 			},
 			setup: func() {
-				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", UserID: userID}, nil).Once()
-				s.mockPackageInterface.On("UpdatePackage", mock.MatchedBy(func(pkg *models.Package) bool {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", Slug: "goravel-gin", UserID: userID}, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{}, nil).Once()
+				s.expectTransaction()
+				s.mockPackageInterface.On("UpdatePackage", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
 					return pkg.Name == name && pkg.Link == url
 				})).Return(nil).Once()
 			},
-			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, UserID: userID, Link: url, LastUpdatedAt: carbon.DateTime{Carbon: carbon.Parse(lastUpdatedAt)}},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, Slug: "goravel-gin", UserID: userID, Link: url, IsPublic: models.PackagePrivate, LastUpdatedAt: carbon.DateTime{Carbon: carbon.Parse(lastUpdatedAt)}},
+		},
+		{
+			name: "Happy path - Maintainer updates package",
+			request: &protopackage.UpdatePackageRequest{
+				Id:            packageID,
+				Name:          name,
+				Url:           url,
+				UserId:        "2",
+				LastUpdatedAt: lastUpdatedAt,
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", Slug: "goravel-gin", UserID: userID}, nil).Once()
+				s.mockMaintainerInterface.On("GetMaintainer", uint64(1), uint64(2)).Return(&models.Maintainer{UUIDModel: models.UUIDModel{ID: 1}, PackageID: 1, UserID: 2, Role: models.MaintainerRoleMaintainer}, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{}, nil).Once()
+				s.expectTransaction()
+				s.mockPackageInterface.On("UpdatePackage", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
+					return pkg.Name == name && pkg.Link == url && pkg.UserID == userID
+				})).Return(nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, Slug: "goravel-gin", UserID: userID, Link: url, IsPublic: models.PackagePrivate, LastUpdatedAt: carbon.DateTime{Carbon: carbon.Parse(lastUpdatedAt)}},
+		},
+		{
+			name: "Happy path - Organization member updates package",
+			request: &protopackage.UpdatePackageRequest{
+				Id:            packageID,
+				Name:          name,
+				Url:           url,
+				UserId:        "2",
+				LastUpdatedAt: lastUpdatedAt,
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", Slug: "goravel-gin", UserID: userID, OrganizationID: 3}, nil).Once()
+				s.mockMaintainerInterface.On("GetMaintainer", uint64(1), uint64(2)).Return(&models.Maintainer{}, nil).Once()
+				s.mockUserService.On("GetMember", s.ctx, uint64(3), uint64(2)).Return(&protouser.OrganizationMember{OrganizationId: "3", UserId: "2", Role: "member"}, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{}, nil).Once()
+				s.expectTransaction()
+				s.mockPackageInterface.On("UpdatePackage", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
+					return pkg.Name == name && pkg.Link == url && pkg.OrganizationID == 3
+				})).Return(nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, Slug: "goravel-gin", UserID: userID, Link: url, IsPublic: models.PackagePrivate, LastUpdatedAt: carbon.DateTime{Carbon: carbon.Parse(lastUpdatedAt)}, OrganizationID: 3},
+		},
+		{
+			name: "Happy path - Owner moves package into organization",
+			request: &protopackage.UpdatePackageRequest{
+				Id:             packageID,
+				UserId:         fmt.Sprint(userID),
+				OrganizationId: "3",
+				UpdateMask:     &fieldmaskpb.FieldMask{Paths: []string{"organization_id"}},
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, Slug: "goravel-gin", UserID: userID}, nil).Once()
+				s.mockUserService.On("GetMember", s.ctx, uint64(3), userID).Return(&protouser.OrganizationMember{OrganizationId: "3", UserId: "1", Role: "owner"}, nil).Once()
+				s.expectTransaction()
+				s.mockPackageInterface.On("UpdatePackage", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
+					return pkg.OrganizationID == 3
+				})).Return(nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, Slug: "goravel-gin", UserID: userID, OrganizationID: 3},
+		},
+		{
+			name: "Sad path - Owner isn't a member of organization",
+			request: &protopackage.UpdatePackageRequest{
+				Id:             packageID,
+				UserId:         fmt.Sprint(userID),
+				OrganizationId: "3",
+				UpdateMask:     &fieldmaskpb.FieldMask{Paths: []string{"organization_id"}},
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, Slug: "goravel-gin", UserID: userID}, nil).Once()
+				s.mockUserService.On("GetMember", s.ctx, uint64(3), userID).Return(nil, nil).Once()
+				s.mockLang.On("Get", "forbidden.organization").Return("forbidden.organization").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusUnauthorized, "forbidden.organization"),
+		},
+		{
+			name: "Sad path - Maintainer can't move package out of organization",
+			request: &protopackage.UpdatePackageRequest{
+				Id:         packageID,
+				UserId:     "2",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"organization_id"}},
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, Slug: "goravel-gin", UserID: userID, OrganizationID: 3}, nil).Once()
+				s.mockMaintainerInterface.On("GetMaintainer", uint64(1), uint64(2)).Return(&models.Maintainer{UUIDModel: models.UUIDModel{ID: 1}, PackageID: 1, UserID: 2, Role: models.MaintainerRoleMaintainer}, nil).Once()
+				s.mockLang.On("Get", "forbidden.update_package").Return("forbidden.update_package").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusUnauthorized, "forbidden.update_package"),
+		},
+		{
+			name: "Happy path - Replaced uploaded cover is deleted",
+			request: &protopackage.UpdatePackageRequest{
+				Id:            packageID,
+				Name:          name,
+				Url:           url,
+				Cover:         "https://goravel.dev/cover.png",
+				UserId:        fmt.Sprint(userID),
+				LastUpdatedAt: lastUpdatedAt,
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", Slug: "goravel-gin", UserID: userID, Cover: "https://goravel.dev/storage/covers/1/a.png", CoverThumbnail: "https://goravel.dev/storage/covers/1/a_thumb.png"}, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{}, nil).Once()
+				s.expectTransaction()
+				s.mockPackageInterface.On("UpdatePackage", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
+					return pkg.Cover == "https://goravel.dev/cover.png" && pkg.CoverThumbnail == ""
+				})).Return(nil).Once()
+				s.mockConfig.On("GetString", "package.cover.disk", "public").Return("public").Once()
+				s.mockStorage.On("Disk", "public").Return(s.mockDisk).Once()
+				s.mockDisk.On("Url", "covers/1").Return("https://goravel.dev/storage/covers/1").Once()
+				s.mockDisk.On("Delete", "covers/1/a.png", "covers/1/a_thumb.png").Return(nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, Slug: "goravel-gin", UserID: userID, Link: url, Cover: "https://goravel.dev/cover.png", IsPublic: models.PackagePrivate, LastUpdatedAt: carbon.DateTime{Carbon: carbon.Parse(lastUpdatedAt)}},
+		},
+		{
+			name: "Happy path - Omitted cover is kept",
+			request: &protopackage.UpdatePackageRequest{
+				Id:            packageID,
+				Name:          name,
+				Url:           url,
+				UserId:        fmt.Sprint(userID),
+				LastUpdatedAt: lastUpdatedAt,
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, Slug: "goravel-gin", UserID: userID, Cover: "https://goravel.dev/storage/covers/1/a.png", CoverThumbnail: "https://goravel.dev/storage/covers/1/a_thumb.png"}, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{}, nil).Once()
+				s.expectTransaction()
+				s.mockPackageInterface.On("UpdatePackage", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
+					return pkg.Cover == "https://goravel.dev/storage/covers/1/a.png" && pkg.CoverThumbnail == "https://goravel.dev/storage/covers/1/a_thumb.png"
+				})).Return(nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, Slug: "goravel-gin", UserID: userID, Link: url, Cover: "https://goravel.dev/storage/covers/1/a.png", CoverThumbnail: "https://goravel.dev/storage/covers/1/a_thumb.png", IsPublic: models.PackagePrivate, LastUpdatedAt: carbon.DateTime{Carbon: carbon.Parse(lastUpdatedAt)}},
+		},
+		{
+			name: "Happy path - Only the masked fields are updated",
+			request: &protopackage.UpdatePackageRequest{
+				Id:         packageID,
+				Summary:    "A Gin driver",
+				UserId:     fmt.Sprint(userID),
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"summary"}},
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, Slug: "goravel-gin", UserID: userID, Link: url, Cover: "https://goravel.dev/cover.png", Version: "v1.0.0", IsPublic: models.PackagePublic}, nil).Once()
+				s.expectTransaction()
+				s.mockPackageInterface.On("UpdatePackage", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
+					return pkg.Summary == "A Gin driver" && pkg.Name == name && pkg.Link == url && pkg.Cover == "https://goravel.dev/cover.png" && pkg.Version == "v1.0.0"
+				})).Return(nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, Slug: "goravel-gin", UserID: userID, Summary: "A Gin driver", Link: url, Cover: "https://goravel.dev/cover.png", Version: "v1.0.0", IsPublic: models.PackagePublic},
+		},
+		{
+			name: "Happy path - Masked empty tags are cleared",
+			request: &protopackage.UpdatePackageRequest{
+				Id:         packageID,
+				UserId:     fmt.Sprint(userID),
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tags"}},
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, Slug: "goravel-gin", UserID: userID, Link: url}, nil).Once()
+				s.expectTransaction()
+				s.mockPackageInterface.On("UpdatePackage", s.mockTransaction, mock.Anything).Return(nil).Once()
+				s.mockPackageInterface.On("AttachTags", s.mockTransaction, mock.Anything, []string(nil)).Return(nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, Slug: "goravel-gin", UserID: userID, Link: url},
+		},
+		{
+			name: "Happy path - Renamed package gets a new slug",
+			request: &protopackage.UpdatePackageRequest{
+				Id:            packageID,
+				Name:          "goravel/fiber",
+				Url:           url,
+				UserId:        fmt.Sprint(userID),
+				LastUpdatedAt: lastUpdatedAt,
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", Slug: "goravel-gin", UserID: userID}, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{}, nil).Once()
+				s.expectTransaction()
+				s.mockPackageInterface.On("UpdateSlug", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
+					return pkg.Name == "goravel/fiber"
+				})).Run(func(args mock.Arguments) {
+					args.Get(1).(*models.Package).Slug = "goravel-fiber"
+				}).Return(nil).Once()
+				s.mockPackageInterface.On("UpdatePackage", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
+					return pkg.Name == "goravel/fiber" && pkg.Slug == "goravel-fiber"
+				})).Return(nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/fiber", Slug: "goravel-fiber", UserID: userID, Link: url, IsPublic: models.PackagePrivate, LastUpdatedAt: carbon.DateTime{Carbon: carbon.Parse(lastUpdatedAt)}},
+		},
+		{
+			name: "Happy path - New slug of the renamed package is taken concurrently",
+			request: &protopackage.UpdatePackageRequest{
+				Id:            packageID,
+				Name:          "goravel/fiber",
+				Url:           url,
+				UserId:        fmt.Sprint(userID),
+				LastUpdatedAt: lastUpdatedAt,
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", Slug: "goravel-gin", UserID: userID}, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{}, nil).Once()
+				s.expectTransaction()
+				s.mockPackageInterface.On("UpdateSlug", s.mockTransaction, mock.AnythingOfType("*models.Package")).Run(func(args mock.Arguments) {
+					args.Get(1).(*models.Package).Slug = "goravel-fiber"
+				}).Return(nil).Once()
+				s.mockPackageInterface.On("UpdatePackage", s.mockTransaction, mock.AnythingOfType("*models.Package")).Return(models.ErrDuplicatePackageSlug).Once()
+				s.expectTransaction()
+				s.mockPackageInterface.On("UpdateSlug", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
+					return pkg.Slug == "goravel-gin"
+				})).Run(func(args mock.Arguments) {
+					args.Get(1).(*models.Package).Slug = "goravel-fiber-1"
+				}).Return(nil).Once()
+				s.mockPackageInterface.On("UpdatePackage", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
+					return pkg.Slug == "goravel-fiber-1"
+				})).Return(nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/fiber", Slug: "goravel-fiber-1", UserID: userID, Link: url, IsPublic: models.PackagePrivate, LastUpdatedAt: carbon.DateTime{Carbon: carbon.Parse(lastUpdatedAt)}},
+		},
+		{
+			name: "Happy path - Package without slug gets a slug",
+			request: &protopackage.UpdatePackageRequest{
+				Id:            packageID,
+				Name:          name,
+				Url:           url,
+				UserId:        fmt.Sprint(userID),
+				LastUpdatedAt: lastUpdatedAt,
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", UserID: userID}, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{}, nil).Once()
+				s.expectTransaction()
+				s.mockPackageInterface.On("UpdateSlug", s.mockTransaction, mock.AnythingOfType("*models.Package")).Run(func(args mock.Arguments) {
+					args.Get(1).(*models.Package).Slug = "goravel-gin"
+				}).Return(nil).Once()
+				s.mockPackageInterface.On("UpdatePackage", s.mockTransaction, mock.AnythingOfType("*models.Package")).Return(nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, Slug: "goravel-gin", UserID: userID, Link: url, IsPublic: models.PackagePrivate, LastUpdatedAt: carbon.DateTime{Carbon: carbon.Parse(lastUpdatedAt)}},
+		},
+		{
+			name: "Sad path - UpdateSlug returns error",
+			request: &protopackage.UpdatePackageRequest{
+				Id:            packageID,
+				Name:          "goravel/fiber",
+				Url:           url,
+				UserId:        fmt.Sprint(userID),
+				LastUpdatedAt: lastUpdatedAt,
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", Slug: "goravel-gin", UserID: userID}, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{}, nil).Once()
+				s.expectTransaction()
+				s.mockPackageInterface.On("UpdateSlug", s.mockTransaction, mock.AnythingOfType("*models.Package")).Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			name: "Happy path - Rejected package goes back to moderation",
+			request: &protopackage.UpdatePackageRequest{
+				Id:            packageID,
+				Name:          name,
+				Url:           url,
+				UserId:        fmt.Sprint(userID),
+				LastUpdatedAt: lastUpdatedAt,
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", Slug: "goravel-gin", UserID: userID, IsApproved: models.PackageRejected, RejectReason: "reason"}, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{}, nil).Once()
+				s.expectTransaction()
+				s.mockPackageInterface.On("UpdatePackage", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
+					return pkg.IsApproved == models.PackageNotApproved && pkg.RejectReason == ""
+				})).Return(nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, Slug: "goravel-gin", UserID: userID, Link: url, IsPublic: models.PackagePrivate, IsApproved: models.PackageNotApproved, LastUpdatedAt: carbon.DateTime{Carbon: carbon.Parse(lastUpdatedAt)}},
+		},
+		{
+			name: "Happy path - Version of the package with releases is kept",
+			request: &protopackage.UpdatePackageRequest{
+				Id:            packageID,
+				Name:          name,
+				Url:           url,
+				UserId:        fmt.Sprint(userID),
+				Version:       "v2.0.0",
+				LastUpdatedAt: lastUpdatedAt,
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", Slug: "goravel-gin", UserID: userID, Version: "v1.0.0"}, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{{UUIDModel: models.UUIDModel{ID: 1}, Version: "v1.0.0"}}, nil).Once()
+				s.expectTransaction()
+				s.mockPackageInterface.On("UpdatePackage", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
+					return pkg.Version == "v1.0.0"
+				})).Return(nil).Once()
+			},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, Slug: "goravel-gin", UserID: userID, Link: url, Version: "v1.0.0", IsPublic: models.PackagePrivate},
+		},
+		{
+			name: "Sad path - GetReleases returns error",
+			request: &protopackage.UpdatePackageRequest{
+				Id:            packageID,
+				Name:          name,
+				Url:           url,
+				UserId:        fmt.Sprint(userID),
+				LastUpdatedAt: lastUpdatedAt,
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", Slug: "goravel-gin", UserID: userID}, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
 		},
 		{
 			name: "Sad path - GetPackageByID returns error",
@@ -641,6 +1632,7 @@ func (s *PackageTestSuite) TestUpdatePackage() {
 			},
 			setup: func() {
 				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", UserID: 2}, nil).Once()
+				s.mockMaintainerInterface.On("GetMaintainer", uint64(1), userID).Return(&models.Maintainer{}, nil).Once()
 				s.mockLang.On("Get", "forbidden.update_package").Return("forbidden.update_package").Once()
 			},
 			expectedErr: utilserrors.New(http.StatusUnauthorized, "forbidden.update_package"),
@@ -656,15 +1648,17 @@ func (s *PackageTestSuite) TestUpdatePackage() {
 				Tags:          tags,
 			},
 			setup: func() {
-				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", UserID: userID}, nil).Once()
-				s.mockPackageInterface.On("UpdatePackage", mock.MatchedBy(func(pkg *models.Package) bool {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", Slug: "goravel-gin", UserID: userID}, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{}, nil).Once()
+				s.expectTransaction()
+				s.mockPackageInterface.On("UpdatePackage", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
 					return pkg.Name == name && pkg.Link == url
 				})).Return(nil).Once()
-				s.mockPackageInterface.On("AttachTags", mock.MatchedBy(func(pkg *models.Package) bool {
+				s.mockPackageInterface.On("AttachTags", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
 					return pkg.Name == name && pkg.Link == url
 				}), tags).Return(nil).Once()
 			},
-			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, UserID: userID, Link: url, LastUpdatedAt: carbon.DateTime{Carbon: carbon.Parse(lastUpdatedAt)}},
+			expectPackage: &models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: name, Slug: "goravel-gin", UserID: userID, Link: url, IsPublic: models.PackagePrivate, LastUpdatedAt: carbon.DateTime{Carbon: carbon.Parse(lastUpdatedAt)}},
 		},
 		{
 			name: "Sad path - UpdatePackage returns error",
@@ -677,13 +1671,69 @@ func (s *PackageTestSuite) TestUpdatePackage() {
 				Tags:          tags,
 			},
 			setup: func() {
-				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", UserID: userID}, nil).Once()
-				s.mockPackageInterface.On("UpdatePackage", mock.MatchedBy(func(pkg *models.Package) bool {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", Slug: "goravel-gin", UserID: userID}, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{}, nil).Once()
+				s.expectTransaction()
+				s.mockPackageInterface.On("UpdatePackage", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
 					return pkg.Name == name && pkg.Link == url
 				})).Return(errors.New("error")).Once()
 			},
 			expectedErr: errors.New("error"),
 		},
+		{
+			name: "Sad path - Revision is outdated",
+			request: &protopackage.UpdatePackageRequest{
+				Id:       packageID,
+				Name:     name,
+				Url:      url,
+				UserId:   fmt.Sprint(userID),
+				Revision: 1,
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", Slug: "goravel-gin", UserID: userID, Revision: 2}, nil).Once()
+				s.mockLang.On("Get", "conflict.package").Return("package changed").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusConflict, "package changed"),
+		},
+		{
+			name: "Sad path - Package is changed during the update",
+			request: &protopackage.UpdatePackageRequest{
+				Id:       packageID,
+				Name:     name,
+				Url:      url,
+				UserId:   fmt.Sprint(userID),
+				Revision: 2,
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", Slug: "goravel-gin", UserID: userID, Revision: 2}, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{}, nil).Once()
+				s.expectTransaction()
+				s.mockPackageInterface.On("UpdatePackage", s.mockTransaction, mock.AnythingOfType("*models.Package")).Return(models.ErrPackageChanged).Once()
+				s.mockLang.On("Get", "conflict.package").Return("package changed").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusConflict, "package changed"),
+		},
+		{
+			name: "Sad path - Package name is duplicate",
+			request: &protopackage.UpdatePackageRequest{
+				Id:            packageID,
+				Name:          name,
+				Url:           url,
+				UserId:        fmt.Sprint(userID),
+				LastUpdatedAt: lastUpdatedAt,
+				Tags:          tags,
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", Slug: "goravel-gin", UserID: userID}, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{}, nil).Once()
+				s.expectTransaction()
+				s.mockPackageInterface.On("UpdatePackage", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
+					return pkg.Name == name && pkg.Link == url
+				})).Return(models.ErrDuplicatePackageName).Once()
+				s.mockLang.On("Get", "exist.package").Return("package exists").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusConflict, "package exists"),
+		},
 		{
 			name: "Sad path - AttachTags returns error",
 			request: &protopackage.UpdatePackageRequest{
@@ -695,11 +1745,13 @@ func (s *PackageTestSuite) TestUpdatePackage() {
 				Tags:          tags,
 			},
 			setup: func() {
-				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", UserID: userID}, nil).Once()
-				s.mockPackageInterface.On("UpdatePackage", mock.MatchedBy(func(pkg *models.Package) bool {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, Name: "goravel/gin", Slug: "goravel-gin", UserID: userID}, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{}, nil).Once()
+				s.expectTransaction()
+				s.mockPackageInterface.On("UpdatePackage", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
 					return pkg.Name == name && pkg.Link == url
 				})).Return(nil).Once()
-				s.mockPackageInterface.On("AttachTags", mock.MatchedBy(func(pkg *models.Package) bool {
+				s.mockPackageInterface.On("AttachTags", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
 					return pkg.Name == name && pkg.Link == url
 				}), tags).Return(errors.New("error")).Once()
 			},
@@ -720,8 +1772,13 @@ func (s *PackageTestSuite) TestUpdatePackage() {
 				s.Equal(test.expectPackage, pkg)
 			}
 
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockMaintainerInterface.AssertExpectations(s.T())
+			s.mockUserService.AssertExpectations(s.T())
 			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockReleaseInterface.AssertExpectations(s.T())
 			s.mockLang.AssertExpectations(s.T())
+			s.mockDisk.AssertExpectations(s.T())
 		})
 	}
 }
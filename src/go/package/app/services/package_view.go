@@ -0,0 +1,131 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/goravel/framework/facades"
+
+	"market.goravel.dev/package/app/models"
+	"market.goravel.dev/utils/errors"
+)
+
+const (
+	packageViewCountKey   = "package:views:%s"
+	packageViewPendingKey = "package:views:pending"
+	packageViewLockKey    = "package:views:lock"
+	packageViewViewerKey  = "package:views:%s:viewer:%s"
+)
+
+// PackageView buffers the package views in the cache, they are written to the database by FlushViews in batches, so
+// reading a package never locks the package row.
+type PackageView interface {
+	FlushViews() (int, error)
+	RecordView(packageID, viewerID string) error
+}
+
+type PackageViewImpl struct {
+	packageModel models.PackageInterface
+}
+
+func NewPackageViewImpl() *PackageViewImpl {
+	return &PackageViewImpl{
+		packageModel: models.NewPackage(),
+	}
+}
+
+// FlushViews writes the buffered views to the database and returns the number of updated packages.
+func (r *PackageViewImpl) FlushViews() (int, error) {
+	lock := facades.Cache().Lock(packageViewLockKey, time.Minute)
+	if !lock.Block(10 * time.Second) {
+		return 0, errors.NewInternalServerError(fmt.Errorf("acquire lock %s timeout", packageViewLockKey))
+	}
+	defer lock.Release()
+
+	packageIDs := r.pendingPackageIDs()
+	if len(packageIDs) == 0 {
+		return 0, nil
+	}
+
+	counts := make(map[string]int, len(packageIDs))
+	for _, packageID := range packageIDs {
+		if count := facades.Cache().GetInt(fmt.Sprintf(packageViewCountKey, packageID)); count > 0 {
+			counts[packageID] = count
+		}
+	}
+
+	if err := r.packageModel.IncrementViewCounts(counts); err != nil {
+		return 0, err
+	}
+
+	// Views recorded during the flush stay in the buffer, the package will be flushed next time.
+	var remainingIDs []string
+	for _, packageID := range packageIDs {
+		remaining, err := facades.Cache().Decrement(fmt.Sprintf(packageViewCountKey, packageID), counts[packageID])
+		if err != nil {
+			return 0, errors.NewInternalServerError(err)
+		}
+		if remaining > 0 {
+			remainingIDs = append(remainingIDs, packageID)
+		}
+	}
+
+	if err := r.putPendingPackageIDs(remainingIDs); err != nil {
+		return 0, err
+	}
+
+	return len(counts), nil
+}
+
+// RecordView counts a view of the package, repeat views from the same viewer (user ID or client fingerprint) are
+// only counted once within the deduplication window.
+func (r *PackageViewImpl) RecordView(packageID, viewerID string) error {
+	if viewerID != "" {
+		window := time.Duration(facades.Config().GetInt("package.view_dedup_minutes", 30)) * time.Minute
+		if !facades.Cache().Add(fmt.Sprintf(packageViewViewerKey, packageID, viewerID), true, window) {
+			return nil
+		}
+	}
+
+	count, err := facades.Cache().Increment(fmt.Sprintf(packageViewCountKey, packageID))
+	if err != nil {
+		return errors.NewInternalServerError(err)
+	}
+
+	// The package has been pending already if it has views that weren't flushed.
+	if count > 1 {
+		return nil
+	}
+
+	lock := facades.Cache().Lock(packageViewLockKey, time.Minute)
+	if !lock.Block(10 * time.Second) {
+		return errors.NewInternalServerError(fmt.Errorf("acquire lock %s timeout", packageViewLockKey))
+	}
+	defer lock.Release()
+
+	return r.putPendingPackageIDs(append(r.pendingPackageIDs(), packageID))
+}
+
+func (r *PackageViewImpl) pendingPackageIDs() []string {
+	pending := facades.Cache().GetString(packageViewPendingKey)
+	if pending == "" {
+		return nil
+	}
+
+	return strings.Split(pending, ",")
+}
+
+func (r *PackageViewImpl) putPendingPackageIDs(packageIDs []string) error {
+	if len(packageIDs) == 0 {
+		facades.Cache().Forget(packageViewPendingKey)
+
+		return nil
+	}
+
+	if !facades.Cache().Forever(packageViewPendingKey, strings.Join(packageIDs, ",")) {
+		return errors.NewInternalServerError(fmt.Errorf("put %s failed", packageViewPendingKey))
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goravel/framework/contracts/http"
+	mockscache "github.com/goravel/framework/mocks/cache"
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/suite"
+
+	mocksmodels "market.goravel.dev/package/app/mocks/models"
+	utilserrors "market.goravel.dev/utils/errors"
+)
+
+type PackageViewTestSuite struct {
+	suite.Suite
+	mockCache            *mockscache.Cache
+	mockConfig           *mocksconfig.Config
+	mockLock             *mockscache.Lock
+	mockPackageInterface *mocksmodels.PackageInterface
+	packageViewImpl      *PackageViewImpl
+}
+
+func TestPackageViewTestSuite(t *testing.T) {
+	suite.Run(t, new(PackageViewTestSuite))
+}
+
+func (s *PackageViewTestSuite) SetupTest() {
+	mockFactory := testingmock.Factory()
+	s.mockCache = mockFactory.Cache()
+	s.mockConfig = mockFactory.Config()
+	s.mockLock = mockFactory.CacheLock()
+	mockFactory.Log()
+	s.mockPackageInterface = &mocksmodels.PackageInterface{}
+	s.packageViewImpl = &PackageViewImpl{
+		packageModel: s.mockPackageInterface,
+	}
+}
+
+func (s *PackageViewTestSuite) TestFlushViews() {
+	tests := []struct {
+		name        string
+		setup       func()
+		expectCount int
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockCache.On("Lock", packageViewLockKey, time.Minute).Return(s.mockLock).Once()
+				s.mockLock.On("Block", 10*time.Second).Return(true).Once()
+				s.mockLock.On("Release").Return(true).Once()
+				s.mockCache.On("GetString", packageViewPendingKey).Return("1,2").Once()
+				s.mockCache.On("GetInt", "package:views:1").Return(3).Once()
+				s.mockCache.On("GetInt", "package:views:2").Return(1).Once()
+				s.mockPackageInterface.On("IncrementViewCounts", map[string]int{"1": 3, "2": 1}).Return(nil).Once()
+				s.mockCache.On("Decrement", "package:views:1", 3).Return(0, nil).Once()
+				// A view of package 2 is recorded during the flush.
+				s.mockCache.On("Decrement", "package:views:2", 1).Return(1, nil).Once()
+				s.mockCache.On("Forever", packageViewPendingKey, "2").Return(true).Once()
+			},
+			expectCount: 2,
+		},
+		{
+			name: "Happy path - no pending views",
+			setup: func() {
+				s.mockCache.On("Lock", packageViewLockKey, time.Minute).Return(s.mockLock).Once()
+				s.mockLock.On("Block", 10*time.Second).Return(true).Once()
+				s.mockLock.On("Release").Return(true).Once()
+				s.mockCache.On("GetString", packageViewPendingKey).Return("").Once()
+			},
+		},
+		{
+			name: "Happy path - all views are flushed",
+			setup: func() {
+				s.mockCache.On("Lock", packageViewLockKey, time.Minute).Return(s.mockLock).Once()
+				s.mockLock.On("Block", 10*time.Second).Return(true).Once()
+				s.mockLock.On("Release").Return(true).Once()
+				s.mockCache.On("GetString", packageViewPendingKey).Return("1").Once()
+				s.mockCache.On("GetInt", "package:views:1").Return(2).Once()
+				s.mockPackageInterface.On("IncrementViewCounts", map[string]int{"1": 2}).Return(nil).Once()
+				s.mockCache.On("Decrement", "package:views:1", 2).Return(0, nil).Once()
+				s.mockCache.On("Forget", packageViewPendingKey).Return(true).Once()
+			},
+			expectCount: 1,
+		},
+		{
+			name: "Sad path - Lock timeout",
+			setup: func() {
+				s.mockCache.On("Lock", packageViewLockKey, time.Minute).Return(s.mockLock).Once()
+				s.mockLock.On("Block", 10*time.Second).Return(false).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "acquire lock package:views:lock timeout"),
+		},
+		{
+			name: "Sad path - IncrementViewCounts returns error",
+			setup: func() {
+				s.mockCache.On("Lock", packageViewLockKey, time.Minute).Return(s.mockLock).Once()
+				s.mockLock.On("Block", 10*time.Second).Return(true).Once()
+				s.mockLock.On("Release").Return(true).Once()
+				s.mockCache.On("GetString", packageViewPendingKey).Return("1").Once()
+				s.mockCache.On("GetInt", "package:views:1").Return(2).Once()
+				s.mockPackageInterface.On("IncrementViewCounts", map[string]int{"1": 2}).Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			test.setup()
+
+			count, err := s.packageViewImpl.FlushViews()
+			s.Equal(test.expectCount, count)
+			s.Equal(test.expectedErr, err)
+
+			s.mockCache.AssertExpectations(s.T())
+			s.mockLock.AssertExpectations(s.T())
+			s.mockPackageInterface.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *PackageViewTestSuite) TestRecordView() {
+	var (
+		packageID = "1"
+		viewerID  = "user:1"
+	)
+
+	tests := []struct {
+		name        string
+		viewerID    string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name:     "Happy path - first view of the package",
+			viewerID: viewerID,
+			setup: func() {
+				s.mockConfig.On("GetInt", "package.view_dedup_minutes", 30).Return(30).Once()
+				s.mockCache.On("Add", "package:views:1:viewer:user:1", true, 30*time.Minute).Return(true).Once()
+				s.mockCache.On("Increment", "package:views:1").Return(1, nil).Once()
+				s.mockCache.On("Lock", packageViewLockKey, time.Minute).Return(s.mockLock).Once()
+				s.mockLock.On("Block", 10*time.Second).Return(true).Once()
+				s.mockLock.On("Release").Return(true).Once()
+				s.mockCache.On("GetString", packageViewPendingKey).Return("2").Once()
+				s.mockCache.On("Forever", packageViewPendingKey, "2,1").Return(true).Once()
+			},
+		},
+		{
+			name:     "Happy path - package is pending already",
+			viewerID: viewerID,
+			setup: func() {
+				s.mockConfig.On("GetInt", "package.view_dedup_minutes", 30).Return(30).Once()
+				s.mockCache.On("Add", "package:views:1:viewer:user:1", true, 30*time.Minute).Return(true).Once()
+				s.mockCache.On("Increment", "package:views:1").Return(2, nil).Once()
+			},
+		},
+		{
+			name:     "Happy path - repeat view is deduplicated",
+			viewerID: viewerID,
+			setup: func() {
+				s.mockConfig.On("GetInt", "package.view_dedup_minutes", 30).Return(30).Once()
+				s.mockCache.On("Add", "package:views:1:viewer:user:1", true, 30*time.Minute).Return(false).Once()
+			},
+		},
+		{
+			name: "Happy path - unknown viewer",
+			setup: func() {
+				s.mockCache.On("Increment", "package:views:1").Return(3, nil).Once()
+			},
+		},
+		{
+			name:     "Sad path - Increment returns error",
+			viewerID: viewerID,
+			setup: func() {
+				s.mockConfig.On("GetInt", "package.view_dedup_minutes", 30).Return(30).Once()
+				s.mockCache.On("Add", "package:views:1:viewer:user:1", true, 30*time.Minute).Return(true).Once()
+				s.mockCache.On("Increment", "package:views:1").Return(0, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			test.setup()
+
+			s.Equal(test.expectedErr, s.packageViewImpl.RecordView(packageID, test.viewerID))
+
+			s.mockCache.AssertExpectations(s.T())
+			s.mockConfig.AssertExpectations(s.T())
+			s.mockLock.AssertExpectations(s.T())
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+
+	"github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/support/carbon"
+	"github.com/spf13/cast"
+	"golang.org/x/mod/semver"
+
+	"market.goravel.dev/package/app/models"
+	protobase "market.goravel.dev/proto/base"
+	protopackage "market.goravel.dev/proto/package"
+	"market.goravel.dev/utils/errors"
+	utilspagination "market.goravel.dev/utils/pagination"
+)
+
+type Release interface {
+	CreateRelease(ctx context.Context, req *protopackage.CreateReleaseRequest) (*models.Release, error)
+	GetRelease(ctx context.Context, userID, packageID, version string) (*models.Release, error)
+	ListReleases(ctx context.Context, userID, packageID string, query *protopackage.ReleasesQuery, pagination *protobase.Pagination) ([]*models.Release, int64, string, error)
+}
+
+type ReleaseImpl struct {
+	maintainerModel models.MaintainerInterface
+	packageModel    models.PackageInterface
+	releaseModel    models.ReleaseInterface
+	userService     User
+}
+
+func NewReleaseImpl() *ReleaseImpl {
+	return &ReleaseImpl{
+		maintainerModel: models.NewMaintainer(),
+		packageModel:    models.NewPackage(),
+		releaseModel:    models.NewRelease(),
+		userService:     NewUserImpl(),
+	}
+}
+
+func (r *ReleaseImpl) CreateRelease(ctx context.Context, req *protopackage.CreateReleaseRequest) (*models.Release, error) {
+	pkg, err := r.packageModel.GetPackageByID(req.GetPackageId(), []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	if pkg.ID == 0 {
+		return nil, errors.NewNotFound(facades.Lang(ctx).Get("not_exist.package"))
+	}
+
+	role, err := maintainerRole(ctx, r.maintainerModel, r.userService, pkg, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if role == "" {
+		return nil, errors.NewUnauthorized(facades.Lang(ctx).Get("forbidden.create_release"))
+	}
+
+	version := models.NormalizeVersion(req.GetVersion())
+	existRelease, err := r.releaseModel.GetRelease(req.GetPackageId(), version)
+	if err != nil {
+		return nil, err
+	}
+
+	if existRelease.ID != 0 {
+		return nil, errors.NewConflict(facades.Lang(ctx).Get("exist.release"))
+	}
+
+	frameworkVersions := make([]string, len(req.GetFrameworkVersions()))
+	for i, frameworkVersion := range req.GetFrameworkVersions() {
+		frameworkVersions[i] = models.NormalizeVersion(frameworkVersion)
+	}
+
+	publishedAt := carbon.Now()
+	if req.GetPublishedAt() != "" {
+		publishedAt = carbon.Parse(req.GetPublishedAt())
+	}
+
+	release := &models.Release{
+		PackageID:         pkg.ID,
+		UserID:            cast.ToUint64(req.GetUserId()),
+		Version:           version,
+		Changelog:         req.GetChangelog(),
+		FrameworkVersions: strings.Join(frameworkVersions, ","),
+		PublishedAt:       carbon.DateTime{Carbon: publishedAt},
+	}
+
+	// The version of the package is always the one of the latest release, a release of an older version (e.g. a
+	// patch of a previous major version) doesn't change it.
+	releases, err := r.releaseModel.GetReleases(req.GetPackageId())
+	if err != nil {
+		return nil, err
+	}
+
+	latestRelease := release
+	if len(releases) > 0 && semver.Compare(releases[0].Version, release.Version) > 0 {
+		latestRelease = releases[0]
+	}
+
+	// The release and the package are saved together, a failed package update leaves no release behind.
+	if err := facades.Orm().Transaction(func(tx orm.Transaction) error {
+		if err := r.releaseModel.CreateRelease(tx, release); err != nil {
+			return err
+		}
+
+		if latestRelease.Version == pkg.Version {
+			return nil
+		}
+
+		pkg.Version = latestRelease.Version
+		pkg.LastUpdatedAt = latestRelease.PublishedAt
+
+		return r.packageModel.UpdateVersion(tx, pkg)
+	}); err != nil {
+		// The same version may be created concurrently after the check above.
+		if stderrors.Is(err, models.ErrDuplicateRelease) {
+			return nil, errors.NewConflict(facades.Lang(ctx).Get("exist.release"))
+		}
+
+		return nil, err
+	}
+
+	return release, nil
+}
+
+func (r *ReleaseImpl) GetRelease(ctx context.Context, userID, packageID, version string) (*models.Release, error) {
+	if _, err := getVisiblePackage(ctx, r.packageModel, r.userService, userID, packageID); err != nil {
+		return nil, err
+	}
+
+	release, err := r.releaseModel.GetRelease(packageID, models.NormalizeVersion(version))
+	if err != nil {
+		return nil, err
+	}
+
+	if release.ID == 0 {
+		return nil, errors.NewNotFound(facades.Lang(ctx).Get("not_exist.release"))
+	}
+
+	return release, nil
+}
+
+func (r *ReleaseImpl) ListReleases(ctx context.Context, userID, packageID string, query *protopackage.ReleasesQuery, pagination *protobase.Pagination) ([]*models.Release, int64, string, error) {
+	if _, err := getVisiblePackage(ctx, r.packageModel, r.userService, userID, packageID); err != nil {
+		return nil, 0, "", err
+	}
+
+	releases, err := r.releaseModel.GetReleases(packageID)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	// Releases are compared by semantic version, so the range is filtered here instead of in the database.
+	fromVersion := models.NormalizeVersion(query.GetFromVersion())
+	toVersion := models.NormalizeVersion(query.GetToVersion())
+	filteredReleases := make([]*models.Release, 0, len(releases))
+	for _, release := range releases {
+		if fromVersion != "" && semver.Compare(release.Version, fromVersion) <= 0 {
+			continue
+		}
+		if toVersion != "" && semver.Compare(release.Version, toVersion) > 0 {
+			continue
+		}
+
+		filteredReleases = append(filteredReleases, release)
+	}
+
+	// All the releases are loaded anyway, so the total is always counted and the cursor only holds the offset.
+	total := int64(len(filteredReleases))
+	start := int64(pagination.GetPage()-1) * int64(pagination.GetLimit())
+	if pagination.GetCursor() != "" {
+		cursor, err := utilspagination.DecodeCursor(pagination.GetCursor())
+		if err != nil {
+			return nil, 0, "", errors.NewBadRequest(facades.Lang(ctx).Get("invalid.cursor"))
+		}
+
+		start = int64(cursor.Offset)
+	}
+	if start >= total {
+		return []*models.Release{}, total, "", nil
+	}
+
+	end := min(start+int64(pagination.GetLimit()), total)
+
+	var nextCursor string
+	if end < total {
+		nextCursor = utilspagination.EncodeCursor(utilspagination.Cursor{Offset: int(end)})
+	}
+
+	return filteredReleases[start:end], total, nextCursor, nil
+}
+
+// getVisiblePackage gets the ID and the author of the package, it returns a not found error if the package doesn't
+// exist or the user can't read it.
+func getVisiblePackage(ctx context.Context, packageModel models.PackageInterface, userService User, userID, packageID string) (*models.Package, error) {
+	pkg, err := packageModel.GetPackageByID(packageID, []string{"id", "user_id", "is_approved", "is_public", "organization_id"})
+	if err != nil {
+		return nil, err
+	}
+
+	if pkg.ID == 0 {
+		return nil, errors.NewNotFound(facades.Lang(ctx).Get("not_exist.package"))
+	}
+
+	visible, err := isPackageVisible(ctx, userService, pkg, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, errors.NewNotFound(facades.Lang(ctx).Get("not_exist.package"))
+	}
+
+	return pkg, nil
+}
@@ -0,0 +1,445 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/contracts/http"
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	mockstranslation "github.com/goravel/framework/mocks/translation"
+	"github.com/goravel/framework/support/carbon"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	mocks "market.goravel.dev/package/app/mocks/models"
+	"market.goravel.dev/package/app/models"
+	protobase "market.goravel.dev/proto/base"
+	protopackage "market.goravel.dev/proto/package"
+	utilserrors "market.goravel.dev/utils/errors"
+	utilspagination "market.goravel.dev/utils/pagination"
+)
+
+type ReleaseTestSuite struct {
+	suite.Suite
+	ctx                     context.Context
+	releaseImpl             *ReleaseImpl
+	mockMaintainerInterface *mocks.MaintainerInterface
+	mockPackageInterface    *mocks.PackageInterface
+	mockReleaseInterface    *mocks.ReleaseInterface
+	mockLang                *mockstranslation.Translator
+	mockOrm                 *mocksorm.Orm
+	mockOrmQuery            *mocksorm.Query
+	mockTransaction         *mocksorm.Transaction
+}
+
+func TestReleaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ReleaseTestSuite))
+}
+
+func (s *ReleaseTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.mockMaintainerInterface = &mocks.MaintainerInterface{}
+	s.mockPackageInterface = &mocks.PackageInterface{}
+	s.mockReleaseInterface = &mocks.ReleaseInterface{}
+	mockFactory := testingmock.Factory()
+	s.mockOrm = mockFactory.Orm()
+	s.mockOrmQuery = mockFactory.OrmQuery()
+	s.mockTransaction = mockFactory.OrmTransaction()
+	s.mockLang = mockFactory.Lang(s.ctx)
+	s.releaseImpl = &ReleaseImpl{
+		maintainerModel: s.mockMaintainerInterface,
+		packageModel:    s.mockPackageInterface,
+		releaseModel:    s.mockReleaseInterface,
+	}
+}
+
+// expectTransaction runs the function of the next transaction with the mocked transaction, the error of the function
+// is returned as if the transaction was rolled back.
+func (s *ReleaseTestSuite) expectTransaction() {
+	s.mockOrm.On("Transaction", mock.Anything).Return(func(txFunc func(contractsorm.Transaction) error) error {
+		return txFunc(s.mockTransaction)
+	}).Once()
+}
+
+func (s *ReleaseTestSuite) TestCreateRelease() {
+	var (
+		packageID   = "1"
+		userID      = uint64(1)
+		publishedAt = "2024-01-01 00:00:00"
+	)
+
+	tests := []struct {
+		name          string
+		request       *protopackage.CreateReleaseRequest
+		setup         func()
+		expectRelease *models.Release
+		expectedErr   error
+	}{
+		{
+			name: "Happy path - latest release updates the package",
+			request: &protopackage.CreateReleaseRequest{
+				UserId:            fmt.Sprint(userID),
+				PackageId:         packageID,
+				Version:           "1.1.0",
+				Changelog:         "changelog",
+				FrameworkVersions: []string{"1.14", "v1.15.0"},
+				PublishedAt:       publishedAt,
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: userID, Version: "v1.0.0"}, nil).Once()
+				s.mockReleaseInterface.On("GetRelease", packageID, "v1.1.0").Return(&models.Release{}, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{{Version: "v1.0.0"}, {Version: "v0.9.0"}}, nil).Once()
+				s.expectTransaction()
+				s.mockReleaseInterface.On("CreateRelease", s.mockTransaction, mock.MatchedBy(func(release *models.Release) bool {
+					return release.PackageID == 1 && release.UserID == userID && release.Version == "v1.1.0" && release.FrameworkVersions == "v1.14,v1.15.0"
+				})).Return(nil).Once()
+				s.mockPackageInterface.On("UpdateVersion", s.mockTransaction, mock.MatchedBy(func(pkg *models.Package) bool {
+					return pkg.Version == "v1.1.0" && pkg.LastUpdatedAt.ToDateTimeString() == publishedAt
+				})).Return(nil).Once()
+			},
+			expectRelease: &models.Release{
+				PackageID:         1,
+				UserID:            userID,
+				Version:           "v1.1.0",
+				Changelog:         "changelog",
+				FrameworkVersions: "v1.14,v1.15.0",
+				PublishedAt:       carbon.DateTime{Carbon: carbon.Parse(publishedAt)},
+			},
+		},
+		{
+			name: "Happy path - older release doesn't update the package",
+			request: &protopackage.CreateReleaseRequest{
+				UserId:      fmt.Sprint(userID),
+				PackageId:   packageID,
+				Version:     "v0.9.1",
+				PublishedAt: publishedAt,
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: userID, Version: "v1.0.0"}, nil).Once()
+				s.mockReleaseInterface.On("GetRelease", packageID, "v0.9.1").Return(&models.Release{}, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{{Version: "v1.0.0"}}, nil).Once()
+				s.expectTransaction()
+				s.mockReleaseInterface.On("CreateRelease", s.mockTransaction, mock.AnythingOfType("*models.Release")).Return(nil).Once()
+			},
+			expectRelease: &models.Release{
+				PackageID:   1,
+				UserID:      userID,
+				Version:     "v0.9.1",
+				PublishedAt: carbon.DateTime{Carbon: carbon.Parse(publishedAt)},
+			},
+		},
+		{
+			name: "Sad path - Package does not exist",
+			request: &protopackage.CreateReleaseRequest{
+				UserId:    fmt.Sprint(userID),
+				PackageId: packageID,
+				Version:   "v1.1.0",
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{}, nil).Once()
+				s.mockLang.On("Get", "not_exist.package").Return("package not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "package not exist"),
+		},
+		{
+			name: "Sad path - User isn't owner of package",
+			request: &protopackage.CreateReleaseRequest{
+				UserId:    "2",
+				PackageId: packageID,
+				Version:   "v1.1.0",
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: userID}, nil).Once()
+				s.mockMaintainerInterface.On("GetMaintainer", uint64(1), uint64(2)).Return(&models.Maintainer{}, nil).Once()
+				s.mockLang.On("Get", "forbidden.create_release").Return("forbidden").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusUnauthorized, "forbidden"),
+		},
+		{
+			name: "Sad path - Release exists",
+			request: &protopackage.CreateReleaseRequest{
+				UserId:    fmt.Sprint(userID),
+				PackageId: packageID,
+				Version:   "v1.1.0",
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: userID}, nil).Once()
+				s.mockReleaseInterface.On("GetRelease", packageID, "v1.1.0").Return(&models.Release{UUIDModel: models.UUIDModel{ID: 1}}, nil).Once()
+				s.mockLang.On("Get", "exist.release").Return("release exists").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusConflict, "release exists"),
+		},
+		{
+			name: "Sad path - CreateRelease returns error",
+			request: &protopackage.CreateReleaseRequest{
+				UserId:    fmt.Sprint(userID),
+				PackageId: packageID,
+				Version:   "v1.1.0",
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: userID}, nil).Once()
+				s.mockReleaseInterface.On("GetRelease", packageID, "v1.1.0").Return(&models.Release{}, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{}, nil).Once()
+				s.expectTransaction()
+				s.mockReleaseInterface.On("CreateRelease", s.mockTransaction, mock.AnythingOfType("*models.Release")).Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			name: "Sad path - release is created concurrently",
+			request: &protopackage.CreateReleaseRequest{
+				UserId:      fmt.Sprint(userID),
+				PackageId:   packageID,
+				Version:     "v1.1.0",
+				PublishedAt: publishedAt,
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: userID}, nil).Once()
+				s.mockReleaseInterface.On("GetRelease", packageID, "v1.1.0").Return(&models.Release{}, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{}, nil).Once()
+				s.expectTransaction()
+				s.mockReleaseInterface.On("CreateRelease", s.mockTransaction, mock.AnythingOfType("*models.Release")).Return(models.ErrDuplicateRelease).Once()
+				s.mockLang.On("Get", "exist.release").Return("release exists").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusConflict, "release exists"),
+		},
+		{
+			name: "Sad path - UpdateVersion returns error",
+			request: &protopackage.CreateReleaseRequest{
+				UserId:      fmt.Sprint(userID),
+				PackageId:   packageID,
+				Version:     "v1.1.0",
+				PublishedAt: publishedAt,
+			},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, []string{}).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: userID}, nil).Once()
+				s.mockReleaseInterface.On("GetRelease", packageID, "v1.1.0").Return(&models.Release{}, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return([]*models.Release{}, nil).Once()
+				s.expectTransaction()
+				s.mockReleaseInterface.On("CreateRelease", s.mockTransaction, mock.AnythingOfType("*models.Release")).Return(nil).Once()
+				s.mockPackageInterface.On("UpdateVersion", s.mockTransaction, mock.AnythingOfType("*models.Package")).Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			test.setup()
+
+			release, err := s.releaseImpl.CreateRelease(s.ctx, test.request)
+			if test.expectedErr != nil {
+				s.Nil(release)
+				s.Equal(test.expectedErr, err)
+			} else {
+				s.Nil(err)
+				s.Equal(test.expectRelease, release)
+			}
+
+			s.mockMaintainerInterface.AssertExpectations(s.T())
+			s.mockOrm.AssertExpectations(s.T())
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockReleaseInterface.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *ReleaseTestSuite) TestGetRelease() {
+	var (
+		packageID = "1"
+		fields    = []string{"id", "user_id", "is_approved", "is_public", "organization_id"}
+		visible   = &models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, IsApproved: models.PackageApproved, IsPublic: models.PackagePublic}
+	)
+
+	tests := []struct {
+		name          string
+		userID        string
+		version       string
+		setup         func()
+		expectRelease *models.Release
+		expectedErr   error
+	}{
+		{
+			name:    "Happy path",
+			version: "1.0.0",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, fields).Return(visible, nil).Once()
+				s.mockReleaseInterface.On("GetRelease", packageID, "v1.0.0").Return(&models.Release{UUIDModel: models.UUIDModel{ID: 1}, Version: "v1.0.0"}, nil).Once()
+			},
+			expectRelease: &models.Release{UUIDModel: models.UUIDModel{ID: 1}, Version: "v1.0.0"},
+		},
+		{
+			name:    "Sad path - Package is invisible",
+			userID:  "2",
+			version: "v1.0.0",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, fields).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, IsApproved: models.PackageNotApproved}, nil).Once()
+				s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("Where", "package_id = ? AND user_id = ?", uint64(1), uint64(2)).Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("First", mock.AnythingOfType("*models.Maintainer")).Return(nil).Once()
+				s.mockLang.On("Get", "not_exist.package").Return("package not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "package not exist"),
+		},
+		{
+			name:    "Sad path - Release does not exist",
+			version: "v1.0.0",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, fields).Return(visible, nil).Once()
+				s.mockReleaseInterface.On("GetRelease", packageID, "v1.0.0").Return(&models.Release{}, nil).Once()
+				s.mockLang.On("Get", "not_exist.release").Return("release not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "release not exist"),
+		},
+		{
+			name:    "Sad path - GetRelease returns error",
+			version: "v1.0.0",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, fields).Return(visible, nil).Once()
+				s.mockReleaseInterface.On("GetRelease", packageID, "v1.0.0").Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			test.setup()
+
+			release, err := s.releaseImpl.GetRelease(s.ctx, test.userID, packageID, test.version)
+			if test.expectedErr != nil {
+				s.Nil(release)
+				s.Equal(test.expectedErr, err)
+			} else {
+				s.Nil(err)
+				s.Equal(test.expectRelease, release)
+			}
+
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockReleaseInterface.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *ReleaseTestSuite) TestListReleases() {
+	var (
+		packageID = "1"
+		fields    = []string{"id", "user_id", "is_approved", "is_public", "organization_id"}
+		visible   = &models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, IsApproved: models.PackageApproved, IsPublic: models.PackagePublic}
+		releases  = []*models.Release{{Version: "v1.2.0"}, {Version: "v1.1.0"}, {Version: "v1.0.0"}}
+	)
+
+	tests := []struct {
+		name           string
+		query          *protopackage.ReleasesQuery
+		pagination     *protobase.Pagination
+		setup          func()
+		expectReleases []*models.Release
+		expectTotal    int64
+		expectCursor   string
+		expectedErr    error
+	}{
+		{
+			name:       "Happy path",
+			pagination: &protobase.Pagination{Page: 1, Limit: 2},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, fields).Return(visible, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return(releases, nil).Once()
+			},
+			expectReleases: []*models.Release{{Version: "v1.2.0"}, {Version: "v1.1.0"}},
+			expectTotal:    3,
+			expectCursor:   utilspagination.EncodeCursor(utilspagination.Cursor{Offset: 2}),
+		},
+		{
+			name:       "Happy path - with a cursor",
+			pagination: &protobase.Pagination{Limit: 2, Cursor: utilspagination.EncodeCursor(utilspagination.Cursor{Offset: 2})},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, fields).Return(visible, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return(releases, nil).Once()
+			},
+			expectReleases: []*models.Release{{Version: "v1.0.0"}},
+			expectTotal:    3,
+		},
+		{
+			name:       "Happy path - releases between versions",
+			query:      &protopackage.ReleasesQuery{FromVersion: "1.0.0", ToVersion: "v1.1.0"},
+			pagination: &protobase.Pagination{Page: 1, Limit: 10},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, fields).Return(visible, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return(releases, nil).Once()
+			},
+			expectReleases: []*models.Release{{Version: "v1.1.0"}},
+			expectTotal:    1,
+		},
+		{
+			name:       "Happy path - page out of range",
+			pagination: &protobase.Pagination{Page: 3, Limit: 2},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, fields).Return(visible, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return(releases, nil).Once()
+			},
+			expectReleases: []*models.Release{},
+			expectTotal:    3,
+		},
+		{
+			name:       "Sad path - Cursor is invalid",
+			pagination: &protobase.Pagination{Limit: 2, Cursor: "invalid"},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, fields).Return(visible, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return(releases, nil).Once()
+				s.mockLang.On("Get", "invalid.cursor").Return("invalid cursor").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusBadRequest, "invalid cursor"),
+		},
+		{
+			name:       "Sad path - Package does not exist",
+			pagination: &protobase.Pagination{Page: 1, Limit: 10},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, fields).Return(&models.Package{}, nil).Once()
+				s.mockLang.On("Get", "not_exist.package").Return("package not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "package not exist"),
+		},
+		{
+			name:       "Sad path - GetReleases returns error",
+			pagination: &protobase.Pagination{Page: 1, Limit: 10},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", packageID, fields).Return(visible, nil).Once()
+				s.mockReleaseInterface.On("GetReleases", packageID).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			test.setup()
+
+			releases, total, nextCursor, err := s.releaseImpl.ListReleases(s.ctx, "", packageID, test.query, test.pagination)
+			if test.expectedErr != nil {
+				s.Nil(releases)
+				s.Equal(int64(0), total)
+				s.Empty(nextCursor)
+				s.Equal(test.expectedErr, err)
+			} else {
+				s.Nil(err)
+				s.Equal(test.expectReleases, releases)
+				s.Equal(test.expectTotal, total)
+				s.Equal(test.expectCursor, nextCursor)
+			}
+
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockReleaseInterface.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
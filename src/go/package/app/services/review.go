@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+
+	"github.com/goravel/framework/facades"
+	"github.com/spf13/cast"
+
+	"market.goravel.dev/package/app/models"
+	protobase "market.goravel.dev/proto/base"
+	protopackage "market.goravel.dev/proto/package"
+	protouser "market.goravel.dev/proto/user"
+	"market.goravel.dev/utils/errors"
+	utilspagination "market.goravel.dev/utils/pagination"
+)
+
+type Review interface {
+	CreateReview(ctx context.Context, req *protopackage.CreateReviewRequest) (*models.Review, error)
+	DeleteReview(ctx context.Context, userID, packageID, id string) error
+	ListReviews(ctx context.Context, userID, packageID string, pagination *protobase.Pagination) ([]*models.Review, int64, string, error)
+	UpdateReview(ctx context.Context, req *protopackage.UpdateReviewRequest) (*models.Review, error)
+}
+
+type ReviewImpl struct {
+	packageModel models.PackageInterface
+	reviewModel  models.ReviewInterface
+	userService  User
+}
+
+func NewReviewImpl() *ReviewImpl {
+	return &ReviewImpl{
+		packageModel: models.NewPackage(),
+		reviewModel:  models.NewReview(),
+		userService:  NewUserImpl(),
+	}
+}
+
+// CreateReview reviews the package, a user can only review a package once.
+func (r *ReviewImpl) CreateReview(ctx context.Context, req *protopackage.CreateReviewRequest) (*models.Review, error) {
+	if _, err := getVisiblePackage(ctx, r.packageModel, r.userService, req.GetUserId(), req.GetPackageId()); err != nil {
+		return nil, err
+	}
+
+	review := &models.Review{
+		PackageID: cast.ToUint64(req.GetPackageId()),
+		UserID:    cast.ToUint64(req.GetUserId()),
+		Rating:    req.GetRating(),
+		Content:   req.GetContent(),
+	}
+
+	created, err := r.reviewModel.CreateReview(review)
+	if err != nil {
+		return nil, err
+	}
+
+	if !created {
+		return nil, errors.NewBadRequest(facades.Lang(ctx).Get("exist.review"))
+	}
+
+	return review, nil
+}
+
+func (r *ReviewImpl) DeleteReview(ctx context.Context, userID, packageID, id string) error {
+	review, err := r.getReview(ctx, packageID, id)
+	if err != nil {
+		return err
+	}
+
+	if review.UserID != cast.ToUint64(userID) {
+		return errors.NewUnauthorized(facades.Lang(ctx).Get("forbidden.delete_review"))
+	}
+
+	return r.reviewModel.DeleteReview(review)
+}
+
+// ListReviews gets the reviews of the package, the newest first, together with the reviewers.
+func (r *ReviewImpl) ListReviews(ctx context.Context, userID, packageID string, pagination *protobase.Pagination) (reviews []*models.Review, total int64, nextCursor string, err error) {
+	if _, err := getVisiblePackage(ctx, r.packageModel, r.userService, userID, packageID); err != nil {
+		return nil, 0, "", err
+	}
+
+	ormQuery := facades.Orm().Query().Where("package_id = ?", packageID)
+	nextCursor, err = utilspagination.Paginate(ormQuery, pagination, &utilspagination.Order{Desc: true}, func(review *models.Review) (any, uint64) {
+		return nil, review.ID
+	}, &reviews, &total)
+	if err != nil {
+		return nil, 0, "", paginationError(ctx, err)
+	}
+
+	if err := r.fillUsers(ctx, reviews); err != nil {
+		return nil, 0, "", err
+	}
+
+	return reviews, total, nextCursor, nil
+}
+
+func (r *ReviewImpl) UpdateReview(ctx context.Context, req *protopackage.UpdateReviewRequest) (*models.Review, error) {
+	review, err := r.getReview(ctx, req.GetPackageId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if review.UserID != cast.ToUint64(req.GetUserId()) {
+		return nil, errors.NewUnauthorized(facades.Lang(ctx).Get("forbidden.update_review"))
+	}
+
+	review.Rating = req.GetRating()
+	review.Content = req.GetContent()
+
+	if err := r.reviewModel.UpdateReview(review); err != nil {
+		return nil, err
+	}
+
+	return review, nil
+}
+
+func (r *ReviewImpl) fillUsers(ctx context.Context, reviews []*models.Review) error {
+	if len(reviews) == 0 {
+		return nil
+	}
+
+	userIDs := make([]string, len(reviews))
+	for i, review := range reviews {
+		userIDs[i] = cast.ToString(review.UserID)
+	}
+
+	users, err := r.userService.GetUsers(ctx, userIDs)
+	if err != nil {
+		return errors.NewInternalServerError(err)
+	}
+
+	userMap := make(map[string]*protouser.User)
+	for _, user := range users {
+		userMap[user.GetId()] = user
+	}
+
+	for _, review := range reviews {
+		review.User = userMap[cast.ToString(review.UserID)]
+	}
+
+	return nil
+}
+
+// getReview gets the review of the package, it returns a not found error if the review doesn't belong to the package.
+func (r *ReviewImpl) getReview(ctx context.Context, packageID, id string) (*models.Review, error) {
+	review, err := r.reviewModel.GetReviewByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if review.ID == 0 || review.PackageID != cast.ToUint64(packageID) {
+		return nil, errors.NewNotFound(facades.Lang(ctx).Get("not_exist.review"))
+	}
+
+	return review, nil
+}
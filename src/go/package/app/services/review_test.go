@@ -0,0 +1,387 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goravel/framework/contracts/http"
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	mockstranslation "github.com/goravel/framework/mocks/translation"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	mocksmodels "market.goravel.dev/package/app/mocks/models"
+	mocksservice "market.goravel.dev/package/app/mocks/services"
+	"market.goravel.dev/package/app/models"
+	protobase "market.goravel.dev/proto/base"
+	protopackage "market.goravel.dev/proto/package"
+	protouser "market.goravel.dev/proto/user"
+	utilserrors "market.goravel.dev/utils/errors"
+	utilspagination "market.goravel.dev/utils/pagination"
+)
+
+type ReviewTestSuite struct {
+	suite.Suite
+	ctx                  context.Context
+	mockLang             *mockstranslation.Translator
+	mockOrm              *mocksorm.Orm
+	mockOrmQuery         *mocksorm.Query
+	mockPackageInterface *mocksmodels.PackageInterface
+	mockReviewInterface  *mocksmodels.ReviewInterface
+	mockUserService      *mocksservice.User
+	reviewImpl           *ReviewImpl
+}
+
+func TestReviewTestSuite(t *testing.T) {
+	suite.Run(t, new(ReviewTestSuite))
+}
+
+func (s *ReviewTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	mockFactory := testingmock.Factory()
+	s.mockLang = mockFactory.Lang(s.ctx)
+	s.mockOrm = mockFactory.Orm()
+	s.mockOrmQuery = mockFactory.OrmQuery()
+	s.mockPackageInterface = &mocksmodels.PackageInterface{}
+	s.mockReviewInterface = &mocksmodels.ReviewInterface{}
+	s.mockUserService = &mocksservice.User{}
+	s.reviewImpl = &ReviewImpl{
+		packageModel: s.mockPackageInterface,
+		reviewModel:  s.mockReviewInterface,
+		userService:  s.mockUserService,
+	}
+}
+
+func (s *ReviewTestSuite) TestCreateReview() {
+	var (
+		req = &protopackage.CreateReviewRequest{
+			UserId:    "2",
+			PackageId: "1",
+			Rating:    5,
+			Content:   "Great",
+		}
+		visibleFields = []string{"id", "user_id", "is_approved", "is_public", "organization_id"}
+		publicPackage = &models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 3, IsApproved: models.PackageApproved, IsPublic: models.PackagePublic}
+		review        = &models.Review{PackageID: 1, UserID: 2, Rating: 5, Content: "Great"}
+	)
+
+	tests := []struct {
+		name         string
+		setup        func()
+		expectReview *models.Review
+		expectedErr  error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", "1", visibleFields).Return(publicPackage, nil).Once()
+				s.mockReviewInterface.On("CreateReview", review).Return(true, nil).Once()
+			},
+			expectReview: review,
+		},
+		{
+			name: "Sad path - reviewed already",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", "1", visibleFields).Return(publicPackage, nil).Once()
+				s.mockReviewInterface.On("CreateReview", review).Return(false, nil).Once()
+				s.mockLang.On("Get", "exist.review").Return("reviewed already").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusBadRequest, "reviewed already"),
+		},
+		{
+			name: "Sad path - package is private",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", "1", visibleFields).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 3}, nil).Once()
+				s.mockOrm.On("Query").Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("Where", "package_id = ? AND user_id = ?", uint64(1), uint64(2)).Return(s.mockOrmQuery).Once()
+				s.mockOrmQuery.On("First", mock.AnythingOfType("*models.Maintainer")).Return(nil).Once()
+				s.mockLang.On("Get", "not_exist.package").Return("package not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "package not exist"),
+		},
+		{
+			name: "Sad path - CreateReview returns error",
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", "1", visibleFields).Return(publicPackage, nil).Once()
+				s.mockReviewInterface.On("CreateReview", review).Return(false, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			test.setup()
+
+			review, err := s.reviewImpl.CreateReview(s.ctx, req)
+			s.Equal(test.expectReview, review)
+			s.Equal(test.expectedErr, err)
+
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockReviewInterface.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *ReviewTestSuite) TestUpdateReview() {
+	req := &protopackage.UpdateReviewRequest{
+		UserId:    "2",
+		PackageId: "1",
+		Id:        "10",
+		Rating:    3,
+		Content:   "Good",
+	}
+
+	tests := []struct {
+		name         string
+		setup        func()
+		expectReview *models.Review
+		expectedErr  error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockReviewInterface.On("GetReviewByID", "10").Return(&models.Review{UUIDModel: models.UUIDModel{ID: 10}, PackageID: 1, UserID: 2, Rating: 5, Content: "Great"}, nil).Once()
+				s.mockReviewInterface.On("UpdateReview", &models.Review{UUIDModel: models.UUIDModel{ID: 10}, PackageID: 1, UserID: 2, Rating: 3, Content: "Good"}).Return(nil).Once()
+			},
+			expectReview: &models.Review{UUIDModel: models.UUIDModel{ID: 10}, PackageID: 1, UserID: 2, Rating: 3, Content: "Good"},
+		},
+		{
+			name: "Sad path - review doesn't exist",
+			setup: func() {
+				s.mockReviewInterface.On("GetReviewByID", "10").Return(&models.Review{}, nil).Once()
+				s.mockLang.On("Get", "not_exist.review").Return("review not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "review not exist"),
+		},
+		{
+			name: "Sad path - review belongs to another package",
+			setup: func() {
+				s.mockReviewInterface.On("GetReviewByID", "10").Return(&models.Review{UUIDModel: models.UUIDModel{ID: 10}, PackageID: 4, UserID: 2}, nil).Once()
+				s.mockLang.On("Get", "not_exist.review").Return("review not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "review not exist"),
+		},
+		{
+			name: "Sad path - not the reviewer",
+			setup: func() {
+				s.mockReviewInterface.On("GetReviewByID", "10").Return(&models.Review{UUIDModel: models.UUIDModel{ID: 10}, PackageID: 1, UserID: 3}, nil).Once()
+				s.mockLang.On("Get", "forbidden.update_review").Return("forbidden").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusUnauthorized, "forbidden"),
+		},
+		{
+			name: "Sad path - GetReviewByID returns error",
+			setup: func() {
+				s.mockReviewInterface.On("GetReviewByID", "10").Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			name: "Sad path - UpdateReview returns error",
+			setup: func() {
+				s.mockReviewInterface.On("GetReviewByID", "10").Return(&models.Review{UUIDModel: models.UUIDModel{ID: 10}, PackageID: 1, UserID: 2, Rating: 5}, nil).Once()
+				s.mockReviewInterface.On("UpdateReview", mock.AnythingOfType("*models.Review")).Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			test.setup()
+
+			review, err := s.reviewImpl.UpdateReview(s.ctx, req)
+			s.Equal(test.expectReview, review)
+			s.Equal(test.expectedErr, err)
+
+			s.mockReviewInterface.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *ReviewTestSuite) TestDeleteReview() {
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				review := &models.Review{UUIDModel: models.UUIDModel{ID: 10}, PackageID: 1, UserID: 2}
+				s.mockReviewInterface.On("GetReviewByID", "10").Return(review, nil).Once()
+				s.mockReviewInterface.On("DeleteReview", review).Return(nil).Once()
+			},
+		},
+		{
+			name: "Sad path - not the reviewer",
+			setup: func() {
+				s.mockReviewInterface.On("GetReviewByID", "10").Return(&models.Review{UUIDModel: models.UUIDModel{ID: 10}, PackageID: 1, UserID: 3}, nil).Once()
+				s.mockLang.On("Get", "forbidden.delete_review").Return("forbidden").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusUnauthorized, "forbidden"),
+		},
+		{
+			name: "Sad path - review doesn't exist",
+			setup: func() {
+				s.mockReviewInterface.On("GetReviewByID", "10").Return(&models.Review{}, nil).Once()
+				s.mockLang.On("Get", "not_exist.review").Return("review not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "review not exist"),
+		},
+		{
+			name: "Sad path - DeleteReview returns error",
+			setup: func() {
+				review := &models.Review{UUIDModel: models.UUIDModel{ID: 10}, PackageID: 1, UserID: 2}
+				s.mockReviewInterface.On("GetReviewByID", "10").Return(review, nil).Once()
+				s.mockReviewInterface.On("DeleteReview", review).Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			test.setup()
+
+			s.Equal(test.expectedErr, s.reviewImpl.DeleteReview(s.ctx, "2", "1", "10"))
+
+			s.mockReviewInterface.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *ReviewTestSuite) TestListReviews() {
+	var (
+		visibleFields = []string{"id", "user_id", "is_approved", "is_public", "organization_id"}
+		publicPackage = &models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 3, IsApproved: models.PackageApproved, IsPublic: models.PackagePublic}
+		users         = []*protouser.User{
+			{
+				Id:   "2",
+				Name: "test",
+			},
+		}
+
+		mockOrm      *mocksorm.Orm
+		mockOrmQuery *mocksorm.Query
+	)
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockOrm = mockFactory.Orm()
+		mockFactory.Log()
+		mockOrmQuery = mockFactory.OrmQuery()
+		s.mockPackageInterface.On("GetPackageByID", "1", visibleFields).Return(publicPackage, nil).Once()
+		mockOrm.On("Query").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Where", "package_id = ?", "1").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Order", "id DESC").Return(mockOrmQuery).Once()
+	}
+
+	tests := []struct {
+		name           string
+		pagination     *protobase.Pagination
+		setup          func()
+		expectReviews  []*models.Review
+		expectedTotal  int64
+		expectedCursor string
+		expectedErr    error
+	}{
+		{
+			name:       "Happy path",
+			pagination: &protobase.Pagination{Page: 1, Limit: 1},
+			setup: func() {
+				beforeSetup()
+				mockOrmQuery.On("Paginate", 1, 1, mock.AnythingOfType("*[]*models.Review"), mock.AnythingOfType("*int64")).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						*args.Get(2).(*[]*models.Review) = []*models.Review{{UUIDModel: models.UUIDModel{ID: 10}, PackageID: 1, UserID: 2, Rating: 5}}
+						*args.Get(3).(*int64) = 2
+					}).Once()
+				s.mockUserService.On("GetUsers", s.ctx, []string{"2"}).Return(users, nil).Once()
+			},
+			expectReviews:  []*models.Review{{UUIDModel: models.UUIDModel{ID: 10}, PackageID: 1, UserID: 2, Rating: 5, User: users[0]}},
+			expectedTotal:  2,
+			expectedCursor: utilspagination.EncodeCursor(utilspagination.Cursor{ID: 10, Desc: true}),
+		},
+		{
+			name:       "Happy path - with cursor",
+			pagination: &protobase.Pagination{Limit: 1, Cursor: utilspagination.EncodeCursor(utilspagination.Cursor{ID: 10, Desc: true})},
+			setup: func() {
+				beforeSetup()
+				mockOrmQuery.On("Where", "id < ?", uint64(10)).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Limit", 2).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Review")).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						*args.Get(0).(*[]*models.Review) = []*models.Review{{UUIDModel: models.UUIDModel{ID: 9}, PackageID: 1, UserID: 2, Rating: 4}}
+					}).Once()
+				s.mockUserService.On("GetUsers", s.ctx, []string{"2"}).Return(users, nil).Once()
+			},
+			expectReviews: []*models.Review{{UUIDModel: models.UUIDModel{ID: 9}, PackageID: 1, UserID: 2, Rating: 4, User: users[0]}},
+		},
+		{
+			name:       "Sad path - package is private",
+			pagination: &protobase.Pagination{Page: 1, Limit: 1},
+			setup: func() {
+				s.mockPackageInterface.On("GetPackageByID", "1", visibleFields).Return(&models.Package{UUIDModel: models.UUIDModel{ID: 1}, UserID: 3}, nil).Once()
+				s.mockLang.On("Get", "not_exist.package").Return("package not exist").Once()
+			},
+			expectedErr: utilserrors.New(http.StatusNotFound, "package not exist"),
+		},
+		{
+			name:       "Sad path - Paginate returns error",
+			pagination: &protobase.Pagination{Page: 1, Limit: 1},
+			setup: func() {
+				beforeSetup()
+				mockOrmQuery.On("Paginate", 1, 1, mock.AnythingOfType("*[]*models.Review"), mock.AnythingOfType("*int64")).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+		{
+			name:       "Sad path - GetUsers returns error",
+			pagination: &protobase.Pagination{Page: 1, Limit: 1},
+			setup: func() {
+				beforeSetup()
+				mockOrmQuery.On("Paginate", 1, 1, mock.AnythingOfType("*[]*models.Review"), mock.AnythingOfType("*int64")).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						*args.Get(2).(*[]*models.Review) = []*models.Review{{UUIDModel: models.UUIDModel{ID: 10}, PackageID: 1, UserID: 2, Rating: 5}}
+						*args.Get(3).(*int64) = 1
+					}).Once()
+				s.mockUserService.On("GetUsers", s.ctx, []string{"2"}).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			mockOrm, mockOrmQuery = nil, nil
+			test.setup()
+
+			reviews, total, nextCursor, err := s.reviewImpl.ListReviews(s.ctx, "", "1", test.pagination)
+			s.Equal(test.expectReviews, reviews)
+			s.Equal(test.expectedTotal, total)
+			s.Equal(test.expectedCursor, nextCursor)
+			s.Equal(test.expectedErr, err)
+
+			if mockOrm != nil {
+				mockOrm.AssertExpectations(s.T())
+				mockOrmQuery.AssertExpectations(s.T())
+			}
+			s.mockPackageInterface.AssertExpectations(s.T())
+			s.mockUserService.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
@@ -1,36 +1,133 @@
 package services
 
 import (
+	"context"
+
 	"github.com/goravel/framework/facades"
+	"github.com/spf13/cast"
 
 	"market.goravel.dev/package/app/models"
 	protobase "market.goravel.dev/proto/base"
+	protopackage "market.goravel.dev/proto/package"
 	"market.goravel.dev/utils/errors"
+	utilspagination "market.goravel.dev/utils/pagination"
 )
 
 type Tag interface {
-	GetTags(packageID, name string, pagination *protobase.Pagination) ([]*models.Tag, int64, error)
+	CreateTag(ctx context.Context, req *protopackage.CreateTagRequest) (*models.Tag, error)
+	CreateTagAlias(ctx context.Context, req *protopackage.CreateTagAliasRequest) (*models.TagAlias, error)
+	DeleteTagAlias(ctx context.Context, tagID, name string) error
+	GetTags(ctx context.Context, userID, packageID, name, sort string, pagination *protobase.Pagination) ([]*models.Tag, int64, string, error)
+	MergeTags(ctx context.Context, id, targetID string) (*models.Tag, error)
+	UpdateTag(ctx context.Context, req *protopackage.UpdateTagRequest) (*models.Tag, error)
 }
 
 type TagImpl struct {
+	tagAliasModel models.TagAliasInterface
+	tagModel      models.TagInterface
+	userService   User
 }
 
 func NewTagImpl() *TagImpl {
-	return &TagImpl{}
+	return &TagImpl{
+		tagAliasModel: models.NewTagAlias(),
+		tagModel:      models.NewTag(),
+		userService:   NewUserImpl(),
+	}
+}
+
+// CreateTag creates the tag, the name is normalized the same way as the tags of packages.
+func (r *TagImpl) CreateTag(ctx context.Context, req *protopackage.CreateTagRequest) (*models.Tag, error) {
+	name := models.NormalizeTagName(req.GetName())
+	if err := r.checkTagNameAvailable(ctx, 0, name); err != nil {
+		return nil, err
+	}
+
+	tag := &models.Tag{
+		UserID: cast.ToUint64(req.GetUserId()),
+		Name:   name,
+		IsShow: models.TagShown,
+	}
+	if req.GetIsShow() != 0 {
+		tag.IsShow = uint(req.GetIsShow())
+	}
+
+	if err := r.tagModel.CreateTag(tag); err != nil {
+		return nil, err
+	}
+
+	return tag, nil
+}
+
+// CreateTagAlias adds an alias to the tag, the alias can't be the name of another tag, otherwise that tag couldn't be
+// attached anymore.
+func (r *TagImpl) CreateTagAlias(ctx context.Context, req *protopackage.CreateTagAliasRequest) (*models.TagAlias, error) {
+	tag, err := r.getTag(ctx, req.GetTagId())
+	if err != nil {
+		return nil, err
+	}
+
+	name := models.NormalizeTagName(req.GetName())
+	alias, err := r.tagAliasModel.GetTagAliasByName(name)
+	if err != nil {
+		return nil, err
+	}
+	if alias.ID != 0 {
+		return nil, errors.NewBadRequest(facades.Lang(ctx).Get("exist.tag_alias"))
+	}
+
+	existTag, err := r.tagModel.GetTagByName(name)
+	if err != nil {
+		return nil, err
+	}
+	if existTag.ID != 0 && existTag.ID != tag.ID {
+		return nil, errors.NewBadRequest(facades.Lang(ctx).Get("exist.tag"))
+	}
+
+	alias = &models.TagAlias{
+		TagID: tag.ID,
+		Name:  name,
+	}
+	if err := r.tagAliasModel.CreateTagAlias(alias); err != nil {
+		return nil, err
+	}
+
+	return alias, nil
+}
+
+func (r *TagImpl) DeleteTagAlias(ctx context.Context, tagID, name string) error {
+	alias, err := r.tagAliasModel.GetTagAliasByName(name)
+	if err != nil {
+		return err
+	}
+
+	if alias.ID == 0 || alias.TagID != cast.ToUint64(tagID) {
+		return errors.NewNotFound(facades.Lang(ctx).Get("not_exist.tag_alias"))
+	}
+
+	return r.tagAliasModel.DeleteTagAlias(alias)
 }
 
-func (r *TagImpl) GetTags(packageID, name string, pagination *protobase.Pagination) ([]*models.Tag, int64, error) {
+// GetTags gets the shown tags, the tags are ordered by ID, or by the maintained package counts if sort is "popular".
+func (r *TagImpl) GetTags(ctx context.Context, userID, packageID, name, sort string, pagination *protobase.Pagination) ([]*models.Tag, int64, string, error) {
 	var tags []*models.Tag
 	query := facades.Orm().Query()
 	var total int64
 
-	page := pagination.GetPage()
-	limit := pagination.GetLimit()
-
 	if packageID != "" {
+		visibleScope, err := visiblePackages(r.userService, userID)
+		if err != nil {
+			return nil, 0, "", err
+		}
+
 		var tagIDs []any
-		if err := facades.Orm().Query().Table("package_tags").Where("package_id = ?", packageID).Pluck("tag_id", &tagIDs); err != nil {
-			return nil, 0, errors.NewInternalServerError(err)
+		// Tags of packages that the user can't read shouldn't be exposed either.
+		if err := facades.Orm().Query().Table("package_tags").
+			Join("JOIN packages ON packages.id = package_tags.package_id AND packages.deleted_at IS NULL").
+			Where("package_tags.package_id = ?", packageID).
+			Scopes(visibleScope).
+			Pluck("package_tags.tag_id", &tagIDs); err != nil {
+			return nil, 0, "", errors.NewInternalServerError(err)
 		}
 
 		query = query.WhereIn("id", tagIDs)
@@ -40,9 +137,102 @@ func (r *TagImpl) GetTags(packageID, name string, pagination *protobase.Paginati
 		// fuzzy search
 		query = query.Where("name LIKE ?", "%"+name+"%")
 	}
-	if err := query.Select([]string{"id", "name"}).Where("is_show = ?", "1").Paginate(int(page), int(limit), &tags, &total); err != nil {
-		return nil, 0, errors.NewInternalServerError(err)
+	query = query.Select([]string{"id", "name", "is_show", "package_count"}).Where("is_show = ?", "1")
+
+	order := utilspagination.Order{}
+	if sort == models.TagSortPopular {
+		order = utilspagination.Order{Column: "package_count", Desc: true}
+	}
+
+	nextCursor, err := utilspagination.Paginate(query, pagination, &order, func(tag *models.Tag) (any, uint64) {
+		return int64(tag.PackageCount), tag.ID
+	}, &tags, &total)
+	if err != nil {
+		return nil, 0, "", paginationError(ctx, err)
+	}
+
+	return tags, total, nextCursor, nil
+}
+
+// MergeTags merges the tag into the target tag and returns the target tag.
+func (r *TagImpl) MergeTags(ctx context.Context, id, targetID string) (*models.Tag, error) {
+	if id == targetID {
+		return nil, errors.NewBadRequest(facades.Lang(ctx).Get("invalid.merge_tags"))
+	}
+
+	tag, err := r.getTag(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := r.getTag(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.tagModel.MergeTags(tag, target); err != nil {
+		return nil, err
+	}
+
+	return target, nil
+}
+
+// UpdateTag renames, hides or shows the tag, the empty fields are kept.
+func (r *TagImpl) UpdateTag(ctx context.Context, req *protopackage.UpdateTagRequest) (*models.Tag, error) {
+	tag, err := r.getTag(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if name := models.NormalizeTagName(req.GetName()); name != "" && name != tag.Name {
+		if err := r.checkTagNameAvailable(ctx, tag.ID, name); err != nil {
+			return nil, err
+		}
+
+		tag.Name = name
+	}
+	if req.GetIsShow() != 0 {
+		tag.IsShow = uint(req.GetIsShow())
+	}
+
+	if err := r.tagModel.UpdateTag(tag); err != nil {
+		return nil, err
+	}
+
+	return tag, nil
+}
+
+// checkTagNameAvailable checks that the name isn't used by another tag or by an alias of another tag, tagID is 0 for
+// new tags.
+func (r *TagImpl) checkTagNameAvailable(ctx context.Context, tagID uint64, name string) error {
+	tag, err := r.tagModel.GetTagByName(name)
+	if err != nil {
+		return err
+	}
+	if tag.ID != 0 && tag.ID != tagID {
+		return errors.NewBadRequest(facades.Lang(ctx).Get("exist.tag"))
+	}
+
+	alias, err := r.tagAliasModel.GetTagAliasByName(name)
+	if err != nil {
+		return err
+	}
+	if alias.ID != 0 && alias.TagID != tagID {
+		return errors.NewBadRequest(facades.Lang(ctx).Get("exist.tag_alias"))
+	}
+
+	return nil
+}
+
+func (r *TagImpl) getTag(ctx context.Context, id string) (*models.Tag, error) {
+	tag, err := r.tagModel.GetTagByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if tag.ID == 0 {
+		return nil, errors.NewNotFound(facades.Lang(ctx).Get("not_exist.tag"))
 	}
 
-	return tags, total, nil
+	return tag, nil
 }
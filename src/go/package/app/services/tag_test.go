@@ -1,23 +1,34 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"testing"
 
 	"github.com/goravel/framework/contracts/http"
 	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	mockstranslation "github.com/goravel/framework/mocks/translation"
 	testingmock "github.com/goravel/framework/testing/mock"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	mocksmodels "market.goravel.dev/package/app/mocks/models"
+	mocksservice "market.goravel.dev/package/app/mocks/services"
 	"market.goravel.dev/package/app/models"
 	protobase "market.goravel.dev/proto/base"
+	protopackage "market.goravel.dev/proto/package"
 	utilserrors "market.goravel.dev/utils/errors"
+	utilspagination "market.goravel.dev/utils/pagination"
 )
 
 type TagTestSuite struct {
 	suite.Suite
-	tagImpl *TagImpl
+	ctx                   context.Context
+	mockLang              *mockstranslation.Translator
+	mockTagAliasInterface *mocksmodels.TagAliasInterface
+	mockTagInterface      *mocksmodels.TagInterface
+	mockUserService       *mocksservice.User
+	tagImpl               *TagImpl
 }
 
 func TestTagTestSuite(t *testing.T) {
@@ -25,14 +36,215 @@ func TestTagTestSuite(t *testing.T) {
 }
 
 func (s *TagTestSuite) SetupTest() {
-	s.tagImpl = NewTagImpl()
+	s.ctx = context.Background()
+	mockFactory := testingmock.Factory()
+	s.mockLang = mockFactory.Lang(s.ctx)
+	s.mockTagAliasInterface = &mocksmodels.TagAliasInterface{}
+	s.mockTagInterface = &mocksmodels.TagInterface{}
+	s.mockUserService = &mocksservice.User{}
+	s.tagImpl = &TagImpl{
+		tagAliasModel: s.mockTagAliasInterface,
+		tagModel:      s.mockTagInterface,
+		userService:   s.mockUserService,
+	}
+}
+
+func (s *TagTestSuite) TestCreateTag() {
+	tests := []struct {
+		name        string
+		req         *protopackage.CreateTagRequest
+		setup       func()
+		expectTag   *models.Tag
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			req:  &protopackage.CreateTagRequest{UserId: "1", Name: " ORM "},
+			setup: func() {
+				s.mockTagInterface.On("GetTagByName", "orm").Return(&models.Tag{}, nil).Once()
+				s.mockTagAliasInterface.On("GetTagAliasByName", "orm").Return(&models.TagAlias{}, nil).Once()
+				s.mockTagInterface.On("CreateTag", &models.Tag{UserID: 1, Name: "orm", IsShow: models.TagShown}).Return(nil).Once()
+			},
+			expectTag: &models.Tag{UserID: 1, Name: "orm", IsShow: models.TagShown},
+		},
+		{
+			name: "Happy path - hidden tag",
+			req:  &protopackage.CreateTagRequest{UserId: "1", Name: "orm", IsShow: 2},
+			setup: func() {
+				s.mockTagInterface.On("GetTagByName", "orm").Return(&models.Tag{}, nil).Once()
+				s.mockTagAliasInterface.On("GetTagAliasByName", "orm").Return(&models.TagAlias{}, nil).Once()
+				s.mockTagInterface.On("CreateTag", &models.Tag{UserID: 1, Name: "orm", IsShow: models.TagHidden}).Return(nil).Once()
+			},
+			expectTag: &models.Tag{UserID: 1, Name: "orm", IsShow: models.TagHidden},
+		},
+		{
+			name: "Sad path - the tag exists",
+			req:  &protopackage.CreateTagRequest{UserId: "1", Name: "orm"},
+			setup: func() {
+				s.mockTagInterface.On("GetTagByName", "orm").Return(&models.Tag{UUIDModel: models.UUIDModel{ID: 1}}, nil).Once()
+				s.mockLang.On("Get", "exist.tag").Return("The tag already exists").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("The tag already exists"),
+		},
+		{
+			name: "Sad path - the name is an alias",
+			req:  &protopackage.CreateTagRequest{UserId: "1", Name: "orm"},
+			setup: func() {
+				s.mockTagInterface.On("GetTagByName", "orm").Return(&models.Tag{}, nil).Once()
+				s.mockTagAliasInterface.On("GetTagAliasByName", "orm").Return(&models.TagAlias{UUIDModel: models.UUIDModel{ID: 3}, TagID: 2}, nil).Once()
+				s.mockLang.On("Get", "exist.tag_alias").Return("The tag alias already exists").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("The tag alias already exists"),
+		},
+		{
+			name: "Sad path - CreateTag returns error",
+			req:  &protopackage.CreateTagRequest{UserId: "1", Name: "orm"},
+			setup: func() {
+				s.mockTagInterface.On("GetTagByName", "orm").Return(&models.Tag{}, nil).Once()
+				s.mockTagAliasInterface.On("GetTagAliasByName", "orm").Return(&models.TagAlias{}, nil).Once()
+				s.mockTagInterface.On("CreateTag", mock.AnythingOfType("*models.Tag")).Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+
+			tag, err := s.tagImpl.CreateTag(s.ctx, test.req)
+			s.Equal(test.expectTag, tag)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockTagAliasInterface.AssertExpectations(s.T())
+			s.mockTagInterface.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *TagTestSuite) TestCreateTagAlias() {
+	var (
+		req = &protopackage.CreateTagAliasRequest{UserId: "1", TagId: "2", Name: "Database ORM"}
+		tag = &models.Tag{UUIDModel: models.UUIDModel{ID: 2}, Name: "orm"}
+	)
+
+	tests := []struct {
+		name        string
+		setup       func()
+		expectAlias *models.TagAlias
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockTagInterface.On("GetTagByID", "2").Return(tag, nil).Once()
+				s.mockTagAliasInterface.On("GetTagAliasByName", "database-orm").Return(&models.TagAlias{}, nil).Once()
+				s.mockTagInterface.On("GetTagByName", "database-orm").Return(&models.Tag{}, nil).Once()
+				s.mockTagAliasInterface.On("CreateTagAlias", &models.TagAlias{TagID: 2, Name: "database-orm"}).Return(nil).Once()
+			},
+			expectAlias: &models.TagAlias{TagID: 2, Name: "database-orm"},
+		},
+		{
+			name: "Sad path - the tag doesn't exist",
+			setup: func() {
+				s.mockTagInterface.On("GetTagByID", "2").Return(&models.Tag{}, nil).Once()
+				s.mockLang.On("Get", "not_exist.tag").Return("Tag not found").Once()
+			},
+			expectedErr: utilserrors.NewNotFound("Tag not found"),
+		},
+		{
+			name: "Sad path - the alias exists",
+			setup: func() {
+				s.mockTagInterface.On("GetTagByID", "2").Return(tag, nil).Once()
+				s.mockTagAliasInterface.On("GetTagAliasByName", "database-orm").Return(&models.TagAlias{UUIDModel: models.UUIDModel{ID: 1}, TagID: 2}, nil).Once()
+				s.mockLang.On("Get", "exist.tag_alias").Return("The tag alias already exists").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("The tag alias already exists"),
+		},
+		{
+			name: "Sad path - the alias is the name of another tag",
+			setup: func() {
+				s.mockTagInterface.On("GetTagByID", "2").Return(tag, nil).Once()
+				s.mockTagAliasInterface.On("GetTagAliasByName", "database-orm").Return(&models.TagAlias{}, nil).Once()
+				s.mockTagInterface.On("GetTagByName", "database-orm").Return(&models.Tag{UUIDModel: models.UUIDModel{ID: 3}}, nil).Once()
+				s.mockLang.On("Get", "exist.tag").Return("The tag already exists").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("The tag already exists"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+
+			alias, err := s.tagImpl.CreateTagAlias(s.ctx, req)
+			s.Equal(test.expectAlias, alias)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockTagAliasInterface.AssertExpectations(s.T())
+			s.mockTagInterface.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *TagTestSuite) TestDeleteTagAlias() {
+	alias := &models.TagAlias{UUIDModel: models.UUIDModel{ID: 1}, TagID: 2, Name: "database-orm"}
+
+	tests := []struct {
+		name        string
+		tagID       string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name:  "Happy path",
+			tagID: "2",
+			setup: func() {
+				s.mockTagAliasInterface.On("GetTagAliasByName", "database-orm").Return(alias, nil).Once()
+				s.mockTagAliasInterface.On("DeleteTagAlias", alias).Return(nil).Once()
+			},
+		},
+		{
+			name:  "Sad path - the alias belongs to another tag",
+			tagID: "3",
+			setup: func() {
+				s.mockTagAliasInterface.On("GetTagAliasByName", "database-orm").Return(alias, nil).Once()
+				s.mockLang.On("Get", "not_exist.tag_alias").Return("Tag alias not found").Once()
+			},
+			expectedErr: utilserrors.NewNotFound("Tag alias not found"),
+		},
+		{
+			name:  "Sad path - DeleteTagAlias returns error",
+			tagID: "2",
+			setup: func() {
+				s.mockTagAliasInterface.On("GetTagAliasByName", "database-orm").Return(alias, nil).Once()
+				s.mockTagAliasInterface.On("DeleteTagAlias", alias).Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+
+			s.Equal(test.expectedErr, s.tagImpl.DeleteTagAlias(s.ctx, test.tagID, "database-orm"))
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockTagAliasInterface.AssertExpectations(s.T())
+		})
+	}
 }
 
 func (s *TagTestSuite) TestGetTags() {
 	var (
+		userID    = "1"
 		packageID = "1"
 		name      = "go"
-		fields    = []string{"id", "name"}
+		sort      = ""
+		fields    = []string{"id", "name", "is_show", "package_count"}
 
 		mockOrm      *mocksorm.Orm
 		mockOrmQuery *mocksorm.Query
@@ -48,11 +260,12 @@ func (s *TagTestSuite) TestGetTags() {
 	}
 
 	tests := []struct {
-		name          string
-		setup         func()
-		expectTags    []*models.Tag
-		expectedTotal int64
-		expectedErr   error
+		name           string
+		setup          func()
+		expectTags     []*models.Tag
+		expectedTotal  int64
+		expectedCursor string
+		expectedErr    error
 	}{
 		{
 			name: "Happy path - GetTags with packageID and name",
@@ -63,14 +276,18 @@ func (s *TagTestSuite) TestGetTags() {
 				}
 
 				beforeSetup()
+				s.mockUserService.On("ListUserOrganizations", s.ctx, uint64(1)).Return(nil, nil).Once()
 				mockOrm.On("Query").Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Table", "package_tags").Return(mockOrmQuery).Once()
-				mockOrmQuery.On("Where", "package_id = ?", packageID).Return(mockOrmQuery).Once()
-				mockOrmQuery.On("Pluck", "tag_id", mock.Anything).Return(nil).Once()
+				mockOrmQuery.On("Join", "JOIN packages ON packages.id = package_tags.package_id AND packages.deleted_at IS NULL").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Where", "package_tags.package_id = ?", packageID).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Scopes", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Pluck", "package_tags.tag_id", mock.Anything).Return(nil).Once()
 				mockOrmQuery.On("WhereIn", "id", mock.Anything).Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Where", "name LIKE ?", "%"+name+"%").Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Select", fields).Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Where", "is_show = ?", "1").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "id ASC").Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Paginate", int(pagination.GetPage()), int(pagination.GetLimit()), mock.AnythingOfType("*[]*models.Tag"), mock.AnythingOfType("*int64")).
 					Return(nil).
 					Run(func(args mock.Arguments) {
@@ -97,13 +314,17 @@ func (s *TagTestSuite) TestGetTags() {
 
 				beforeSetup()
 
+				s.mockUserService.On("ListUserOrganizations", s.ctx, uint64(1)).Return(nil, nil).Once()
 				mockOrm.On("Query").Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Table", "package_tags").Return(mockOrmQuery).Once()
-				mockOrmQuery.On("Where", "package_id = ?", packageID).Return(mockOrmQuery).Once()
-				mockOrmQuery.On("Pluck", "tag_id", mock.Anything).Return(nil).Once()
+				mockOrmQuery.On("Join", "JOIN packages ON packages.id = package_tags.package_id AND packages.deleted_at IS NULL").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Where", "package_tags.package_id = ?", packageID).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Scopes", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Pluck", "package_tags.tag_id", mock.Anything).Return(nil).Once()
 				mockOrmQuery.On("WhereIn", "id", mock.Anything).Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Select", fields).Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Where", "is_show = ?", "1").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "id ASC").Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Paginate", int(pagination.GetPage()), int(pagination.GetLimit()), mock.AnythingOfType("*[]*models.Tag"), mock.AnythingOfType("*int64")).
 					Return(nil).
 					Run(func(args mock.Arguments) {
@@ -133,6 +354,7 @@ func (s *TagTestSuite) TestGetTags() {
 				mockOrmQuery.On("Where", "name LIKE ?", "%"+name+"%").Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Select", fields).Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Where", "is_show = ?", "1").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "id ASC").Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Paginate", int(pagination.GetPage()), int(pagination.GetLimit()), mock.AnythingOfType("*[]*models.Tag"), mock.AnythingOfType("*int64")).
 					Return(nil).
 					Run(func(args mock.Arguments) {
@@ -147,17 +369,107 @@ func (s *TagTestSuite) TestGetTags() {
 			expectedTotal: 2,
 			expectedErr:   nil,
 		},
+		{
+			name: "Happy path - GetTags with a cursor",
+			setup: func() {
+				packageID = ""
+				name = ""
+				pagination = &protobase.Pagination{
+					Limit:  1,
+					Cursor: utilspagination.EncodeCursor(utilspagination.Cursor{ID: 4}),
+				}
+
+				beforeSetup()
+
+				mockOrmQuery.On("Select", fields).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Where", "is_show = ?", "1").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Where", "id > ?", uint64(4)).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "id ASC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Limit", 2).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Tag")).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						tagsPtr := args.Get(0).(*[]*models.Tag)
+						*tagsPtr = []*models.Tag{{UUIDModel: models.UUIDModel{ID: 5}, Name: "GoTest"}, {UUIDModel: models.UUIDModel{ID: 6}, Name: "JavaScript"}}
+					}).Once()
+			},
+			expectTags:     []*models.Tag{{UUIDModel: models.UUIDModel{ID: 5}, Name: "GoTest"}},
+			expectedCursor: utilspagination.EncodeCursor(utilspagination.Cursor{ID: 5}),
+		},
+		{
+			name: "Happy path - GetTags sorted by popularity",
+			setup: func() {
+				packageID = ""
+				name = ""
+				sort = models.TagSortPopular
+				pagination = &protobase.Pagination{
+					Page:  1,
+					Limit: 1,
+				}
+
+				beforeSetup()
+
+				mockOrmQuery.On("Select", fields).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Where", "is_show = ?", "1").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "package_count DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "id DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Paginate", 1, 1, mock.AnythingOfType("*[]*models.Tag"), mock.AnythingOfType("*int64")).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						tagsPtr := args.Get(2).(*[]*models.Tag)
+						*tagsPtr = []*models.Tag{{UUIDModel: models.UUIDModel{ID: 7}, Name: "orm", PackageCount: 12}}
+
+						totalPtr := args.Get(3).(*int64)
+						*totalPtr = 2
+					}).Once()
+			},
+			expectTags:     []*models.Tag{{UUIDModel: models.UUIDModel{ID: 7}, Name: "orm", PackageCount: 12}},
+			expectedTotal:  2,
+			expectedCursor: utilspagination.EncodeCursor(utilspagination.Cursor{ID: 7, Value: int64(12), Sort: "package_count", Desc: true}),
+		},
+		{
+			name: "Happy path - GetTags sorted by popularity with a cursor",
+			setup: func() {
+				packageID = ""
+				name = ""
+				sort = models.TagSortPopular
+				pagination = &protobase.Pagination{
+					Limit:  1,
+					Cursor: utilspagination.EncodeCursor(utilspagination.Cursor{ID: 7, Value: int64(12), Sort: "package_count", Desc: true}),
+				}
+
+				beforeSetup()
+
+				mockOrmQuery.On("Select", fields).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Where", "is_show = ?", "1").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Where", "(package_count < ? OR (package_count = ? AND id < ?))", int64(12), int64(12), uint64(7)).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "package_count DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "id DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Limit", 2).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.Tag")).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						tagsPtr := args.Get(0).(*[]*models.Tag)
+						*tagsPtr = []*models.Tag{{UUIDModel: models.UUIDModel{ID: 3}, Name: "cache", PackageCount: 5}}
+					}).Once()
+			},
+			expectTags: []*models.Tag{{UUIDModel: models.UUIDModel{ID: 3}, Name: "cache", PackageCount: 5}},
+		},
 		{
 			name: "Sad path - Pluck return error",
 			setup: func() {
 				packageID = "1"
+				sort = ""
 
 				beforeSetup()
 
+				s.mockUserService.On("ListUserOrganizations", s.ctx, uint64(1)).Return(nil, nil).Once()
 				mockOrm.On("Query").Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Table", "package_tags").Return(mockOrmQuery).Once()
-				mockOrmQuery.On("Where", "package_id = ?", packageID).Return(mockOrmQuery).Once()
-				mockOrmQuery.On("Pluck", "tag_id", mock.Anything).Return(errors.New("pluck error")).Once()
+				mockOrmQuery.On("Join", "JOIN packages ON packages.id = package_tags.package_id AND packages.deleted_at IS NULL").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Where", "package_tags.package_id = ?", packageID).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Scopes", mock.Anything).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Pluck", "package_tags.tag_id", mock.Anything).Return(errors.New("pluck error")).Once()
 			},
 			expectTags:    nil,
 			expectedTotal: 0,
@@ -178,6 +490,7 @@ func (s *TagTestSuite) TestGetTags() {
 				mockOrmQuery.On("Where", "name LIKE ?", "%"+name+"%").Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Select", fields).Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Where", "is_show = ?", "1").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "id ASC").Return(mockOrmQuery).Once()
 				mockOrmQuery.On("Paginate", int(pagination.GetPage()), int(pagination.GetLimit()), mock.AnythingOfType("*[]*models.Tag"), mock.AnythingOfType("*int64")).
 					Return(errors.New("paginate error")).Once()
 			},
@@ -191,19 +504,162 @@ func (s *TagTestSuite) TestGetTags() {
 		s.Run(test.name, func() {
 			test.setup()
 
-			tags, total, err := s.tagImpl.GetTags(packageID, name, pagination)
+			tags, total, nextCursor, err := s.tagImpl.GetTags(s.ctx, userID, packageID, name, sort, pagination)
 			if test.expectedErr != nil {
 				s.Nil(tags)
 				s.Equal(int64(0), total)
+				s.Empty(nextCursor)
 				s.Equal(test.expectedErr, err)
 			} else {
 				s.Nil(err)
 				s.Equal(test.expectTags, tags)
 				s.Equal(test.expectedTotal, total)
+				s.Equal(test.expectedCursor, nextCursor)
 			}
 
 			mockOrm.AssertExpectations(s.T())
 			mockOrmQuery.AssertExpectations(s.T())
+			s.mockUserService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *TagTestSuite) TestMergeTags() {
+	var (
+		tag    = &models.Tag{UUIDModel: models.UUIDModel{ID: 1}, Name: "database-orm"}
+		target = &models.Tag{UUIDModel: models.UUIDModel{ID: 2}, Name: "orm"}
+	)
+
+	tests := []struct {
+		name        string
+		targetID    string
+		setup       func()
+		expectTag   *models.Tag
+		expectedErr error
+	}{
+		{
+			name:     "Happy path",
+			targetID: "2",
+			setup: func() {
+				s.mockTagInterface.On("GetTagByID", "1").Return(tag, nil).Once()
+				s.mockTagInterface.On("GetTagByID", "2").Return(target, nil).Once()
+				s.mockTagInterface.On("MergeTags", tag, target).Return(nil).Once()
+			},
+			expectTag: target,
+		},
+		{
+			name:     "Sad path - merge a tag into itself",
+			targetID: "1",
+			setup: func() {
+				s.mockLang.On("Get", "invalid.merge_tags").Return("A tag can't be merged into itself").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("A tag can't be merged into itself"),
+		},
+		{
+			name:     "Sad path - the target tag doesn't exist",
+			targetID: "2",
+			setup: func() {
+				s.mockTagInterface.On("GetTagByID", "1").Return(tag, nil).Once()
+				s.mockTagInterface.On("GetTagByID", "2").Return(&models.Tag{}, nil).Once()
+				s.mockLang.On("Get", "not_exist.tag").Return("Tag not found").Once()
+			},
+			expectedErr: utilserrors.NewNotFound("Tag not found"),
+		},
+		{
+			name:     "Sad path - MergeTags returns error",
+			targetID: "2",
+			setup: func() {
+				s.mockTagInterface.On("GetTagByID", "1").Return(tag, nil).Once()
+				s.mockTagInterface.On("GetTagByID", "2").Return(target, nil).Once()
+				s.mockTagInterface.On("MergeTags", tag, target).Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+
+			tag, err := s.tagImpl.MergeTags(s.ctx, "1", test.targetID)
+			s.Equal(test.expectTag, tag)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockTagInterface.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *TagTestSuite) TestUpdateTag() {
+	tests := []struct {
+		name        string
+		req         *protopackage.UpdateTagRequest
+		setup       func()
+		expectTag   *models.Tag
+		expectedErr error
+	}{
+		{
+			name: "Happy path - rename",
+			req:  &protopackage.UpdateTagRequest{UserId: "1", Id: "1", Name: "Database ORM"},
+			setup: func() {
+				s.mockTagInterface.On("GetTagByID", "1").Return(&models.Tag{UUIDModel: models.UUIDModel{ID: 1}, Name: "Database", IsShow: models.TagShown}, nil).Once()
+				s.mockTagInterface.On("GetTagByName", "database-orm").Return(&models.Tag{}, nil).Once()
+				s.mockTagAliasInterface.On("GetTagAliasByName", "database-orm").Return(&models.TagAlias{}, nil).Once()
+				s.mockTagInterface.On("UpdateTag", &models.Tag{UUIDModel: models.UUIDModel{ID: 1}, Name: "database-orm", IsShow: models.TagShown}).Return(nil).Once()
+			},
+			expectTag: &models.Tag{UUIDModel: models.UUIDModel{ID: 1}, Name: "database-orm", IsShow: models.TagShown},
+		},
+		{
+			name: "Happy path - hide",
+			req:  &protopackage.UpdateTagRequest{UserId: "1", Id: "1", IsShow: 2},
+			setup: func() {
+				s.mockTagInterface.On("GetTagByID", "1").Return(&models.Tag{UUIDModel: models.UUIDModel{ID: 1}, Name: "orm", IsShow: models.TagShown}, nil).Once()
+				s.mockTagInterface.On("UpdateTag", &models.Tag{UUIDModel: models.UUIDModel{ID: 1}, Name: "orm", IsShow: models.TagHidden}).Return(nil).Once()
+			},
+			expectTag: &models.Tag{UUIDModel: models.UUIDModel{ID: 1}, Name: "orm", IsShow: models.TagHidden},
+		},
+		{
+			name: "Sad path - the name is used by another tag",
+			req:  &protopackage.UpdateTagRequest{UserId: "1", Id: "1", Name: "database"},
+			setup: func() {
+				s.mockTagInterface.On("GetTagByID", "1").Return(&models.Tag{UUIDModel: models.UUIDModel{ID: 1}, Name: "orm"}, nil).Once()
+				s.mockTagInterface.On("GetTagByName", "database").Return(&models.Tag{UUIDModel: models.UUIDModel{ID: 2}, Name: "database"}, nil).Once()
+				s.mockLang.On("Get", "exist.tag").Return("The tag already exists").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("The tag already exists"),
+		},
+		{
+			name: "Sad path - the tag doesn't exist",
+			req:  &protopackage.UpdateTagRequest{UserId: "1", Id: "1", Name: "orm"},
+			setup: func() {
+				s.mockTagInterface.On("GetTagByID", "1").Return(&models.Tag{}, nil).Once()
+				s.mockLang.On("Get", "not_exist.tag").Return("Tag not found").Once()
+			},
+			expectedErr: utilserrors.NewNotFound("Tag not found"),
+		},
+		{
+			name: "Sad path - UpdateTag returns error",
+			req:  &protopackage.UpdateTagRequest{UserId: "1", Id: "1", IsShow: 2},
+			setup: func() {
+				s.mockTagInterface.On("GetTagByID", "1").Return(&models.Tag{UUIDModel: models.UUIDModel{ID: 1}, Name: "orm"}, nil).Once()
+				s.mockTagInterface.On("UpdateTag", mock.AnythingOfType("*models.Tag")).Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+
+			tag, err := s.tagImpl.UpdateTag(s.ctx, test.req)
+			s.Equal(test.expectTag, tag)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockTagAliasInterface.AssertExpectations(s.T())
+			s.mockTagInterface.AssertExpectations(s.T())
 		})
 	}
 }
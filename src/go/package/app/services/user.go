@@ -13,8 +13,10 @@ import (
 var userInstance *UserImpl
 
 type User interface {
+	GetMember(ctx context.Context, organizationID, userID uint64) (*user.OrganizationMember, error)
 	GetUser(ctx context.Context, userID uint64) (*user.User, error)
 	GetUsers(ctx context.Context, userIDs []string) ([]*user.User, error)
+	ListUserOrganizations(ctx context.Context, userID uint64) ([]uint64, error)
 }
 
 type UserImpl struct {
@@ -39,6 +41,16 @@ func NewUserImpl() *UserImpl {
 	return userInstance
 }
 
+// GetMember gets the user in the members of the organization, it's nil if the user isn't a member.
+func (r *UserImpl) GetMember(ctx context.Context, organizationID, userID uint64) (*user.OrganizationMember, error) {
+	resp, err := r.client.GetMember(ctx, &user.GetMemberRequest{OrganizationId: cast.ToString(organizationID), UserId: cast.ToString(userID)})
+	if err := errors.NewResponse(resp, err); err != nil {
+		return nil, err
+	}
+
+	return resp.GetMember(), nil
+}
+
 func (r *UserImpl) GetUser(ctx context.Context, userID uint64) (*user.User, error) {
 	resp, err := r.client.GetUser(ctx, &user.GetUserRequest{UserId: cast.ToString(userID)})
 	if err := errors.NewResponse(resp, err); err != nil {
@@ -56,3 +68,18 @@ func (r *UserImpl) GetUsers(ctx context.Context, userIDs []string) ([]*user.User
 
 	return resp.GetUsers(), nil
 }
+
+// ListUserOrganizations lists the IDs of the organizations the user is a member of.
+func (r *UserImpl) ListUserOrganizations(ctx context.Context, userID uint64) ([]uint64, error) {
+	resp, err := r.client.ListUserOrganizations(ctx, &user.ListUserOrganizationsRequest{UserId: cast.ToString(userID)})
+	if err := errors.NewResponse(resp, err); err != nil {
+		return nil, err
+	}
+
+	organizationIDs := make([]uint64, len(resp.GetOrganizationIds()))
+	for i, organizationID := range resp.GetOrganizationIds() {
+		organizationIDs[i] = cast.ToUint64(organizationID)
+	}
+
+	return organizationIDs, nil
+}
@@ -0,0 +1,80 @@
+package config
+
+import (
+	"github.com/goravel/framework/facades"
+)
+
+func init() {
+	config := facades.Config()
+	config.Add("package", map[string]any{
+		// Administrators
+		//
+		// The comma separated user IDs that are allowed to moderate packages,
+		// e.g. "1,2,3".
+		"admins": config.Env("PACKAGE_ADMINS", ""),
+
+		// Comment Replies Limit
+		//
+		// The number of replies listed with each thread, the rest of the replies
+		// are listed by the parent of the thread.
+		"comment_replies_limit": config.Env("PACKAGE_COMMENT_REPLIES_LIMIT", 3),
+
+		// Cover
+		//
+		// The uploaded covers are stored on the disk, the images larger than
+		// max_size_kb or out of the dimensions are rejected. The thumbnails
+		// shown in the package lists are cropped to the thumbnail size.
+		"cover": map[string]any{
+			"disk":             config.Env("PACKAGE_COVER_DISK", "public"),
+			"max_size_kb":      config.Env("PACKAGE_COVER_MAX_SIZE_KB", 2048),
+			"min_width":        320,
+			"min_height":       180,
+			"max_width":        4096,
+			"max_height":       4096,
+			"thumbnail_width":  480,
+			"thumbnail_height": 270,
+		},
+
+		// Deleted Package Retention
+		//
+		// The number of days that soft deleted packages are kept before they are
+		// permanently deleted by the package:purge-deleted command.
+		"deleted_retention_days": config.Env("PACKAGE_DELETED_RETENTION_DAYS", 30),
+
+		// GitHub
+		//
+		// The packages linked to a GitHub repository sync the stars, latest tag,
+		// last commit date, license and README from the GitHub API. The token is
+		// optional, but the rate limit of anonymous requests is low. The
+		// package:sync command syncs sync_batch_size packages each run.
+		"github": map[string]any{
+			"base_url":        config.Env("GITHUB_BASE_URL", "https://api.github.com"),
+			"token":           config.Env("GITHUB_TOKEN", ""),
+			"sync_batch_size": config.Env("GITHUB_SYNC_BATCH_SIZE", 100),
+		},
+
+		// Idempotency TTL
+		//
+		// The number of hours that the responses of the requests carrying an
+		// Idempotency-Key header are replayed to the retries with the same key.
+		"idempotency_ttl_hours": config.Env("PACKAGE_IDEMPOTENCY_TTL_HOURS", 24),
+
+		// Markdown Cache
+		//
+		// The number of days that the HTML rendered from the package
+		// descriptions is cached, the edited descriptions are rendered again.
+		"markdown_cache_days": config.Env("PACKAGE_MARKDOWN_CACHE_DAYS", 7),
+
+		// Tag Blocklist
+		//
+		// The comma separated tags that can't be added to packages, e.g.
+		// "spam,casino". The tags are compared after they are normalized.
+		"tag_blocklist": config.Env("PACKAGE_TAG_BLOCKLIST", ""),
+
+		// View Deduplication Window
+		//
+		// The number of minutes in which repeat views of a package from the same
+		// viewer are only counted once.
+		"view_dedup_minutes": config.Env("PACKAGE_VIEW_DEDUP_MINUTES", 30),
+	})
+}
@@ -0,0 +1,3 @@
+ALTER TABLE packages DROP COLUMN IF EXISTS reject_reason;
+
+COMMENT ON COLUMN packages.is_approved IS '1: approved, 2: not approved';
@@ -0,0 +1,6 @@
+ALTER TABLE packages ADD COLUMN reject_reason varchar(255) DEFAULT NULL;
+
+COMMENT ON COLUMN packages.is_approved IS '1: approved, 2: not approved, 3: rejected';
+
+-- The packages created before the moderation were listed without a review, keep them approved.
+UPDATE packages SET is_approved = 1 WHERE is_approved NOT IN (1, 2, 3);
@@ -0,0 +1 @@
+UPDATE packages SET is_public = CASE WHEN is_public = 1 THEN 2 ELSE 1 END;
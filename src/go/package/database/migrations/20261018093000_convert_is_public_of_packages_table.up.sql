@@ -0,0 +1,3 @@
+-- The packages stored 2 as public before, swap the values to the meaning of the column comment: 1 is public and
+-- anything else is private.
+UPDATE packages SET is_public = CASE WHEN is_public = 2 THEN 1 ELSE 2 END;
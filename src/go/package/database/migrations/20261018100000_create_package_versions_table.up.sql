@@ -0,0 +1,16 @@
+CREATE TABLE package_versions (
+  id bigint PRIMARY KEY,
+  package_id bigint NOT NULL,
+  user_id bigint NOT NULL,
+  version varchar(50) NOT NULL,
+  changelog text DEFAULT NULL,
+  framework_versions varchar(255) DEFAULT NULL,
+  published_at timestamp NOT NULL,
+  created_at timestamp NOT NULL,
+  updated_at timestamp NOT NULL,
+  UNIQUE(package_id, version)
+);
+
+CREATE INDEX package_versions_package_id_published_at_index ON package_versions (package_id, published_at);
+
+COMMENT ON COLUMN package_versions.framework_versions IS 'Comma separated Goravel versions, e.g. v1.13,v1.14.3';
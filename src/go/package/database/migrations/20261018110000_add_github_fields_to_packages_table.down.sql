@@ -0,0 +1,4 @@
+ALTER TABLE packages DROP COLUMN IF EXISTS stars;
+ALTER TABLE packages DROP COLUMN IF EXISTS license;
+ALTER TABLE packages DROP COLUMN IF EXISTS readme;
+ALTER TABLE packages DROP COLUMN IF EXISTS synced_at;
@@ -0,0 +1,6 @@
+ALTER TABLE packages ADD COLUMN stars int DEFAULT 0;
+ALTER TABLE packages ADD COLUMN license varchar(100) DEFAULT NULL;
+ALTER TABLE packages ADD COLUMN readme text DEFAULT NULL;
+ALTER TABLE packages ADD COLUMN synced_at timestamp DEFAULT NULL;
+
+COMMENT ON COLUMN packages.synced_at IS 'The last time the GitHub repository of the link was synced';
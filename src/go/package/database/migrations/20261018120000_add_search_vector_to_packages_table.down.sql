@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS tags_search_vector_update ON tags;
+DROP FUNCTION IF EXISTS tags_search_vector_update();
+DROP TRIGGER IF EXISTS package_tags_search_vector_update ON package_tags;
+DROP FUNCTION IF EXISTS package_tags_search_vector_update();
+DROP TRIGGER IF EXISTS packages_search_vector_update ON packages;
+DROP FUNCTION IF EXISTS packages_search_vector_update();
+DROP INDEX IF EXISTS packages_search_vector_index;
+ALTER TABLE packages DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,58 @@
+ALTER TABLE packages ADD COLUMN search_vector tsvector;
+
+CREATE INDEX packages_search_vector_index ON packages USING GIN (search_vector);
+
+COMMENT ON COLUMN packages.search_vector IS 'Maintained by triggers, name (A), summary and tag names (B), description (C)';
+
+-- The vector is rebuilt when the searchable columns change, setting it to NULL forces a rebuild.
+CREATE FUNCTION packages_search_vector_update() RETURNS trigger AS $$
+BEGIN
+  NEW.search_vector :=
+    setweight(to_tsvector('english', coalesce(NEW.name, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(NEW.summary, '')), 'B') ||
+    setweight(to_tsvector('english', coalesce((
+      SELECT string_agg(tags.name, ' ')
+      FROM package_tags
+      JOIN tags ON tags.id = package_tags.tag_id AND tags.is_show = 1 AND tags.deleted_at IS NULL
+      WHERE package_tags.package_id = NEW.id
+    ), '')), 'B') ||
+    setweight(to_tsvector('english', coalesce(NEW.description, '')), 'C');
+
+  RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER packages_search_vector_update
+  BEFORE INSERT OR UPDATE OF name, summary, description, search_vector ON packages
+  FOR EACH ROW EXECUTE FUNCTION packages_search_vector_update();
+
+CREATE FUNCTION package_tags_search_vector_update() RETURNS trigger AS $$
+BEGIN
+  IF TG_OP = 'DELETE' THEN
+    UPDATE packages SET search_vector = NULL WHERE id = OLD.package_id;
+  ELSE
+    UPDATE packages SET search_vector = NULL WHERE id = NEW.package_id;
+  END IF;
+
+  RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER package_tags_search_vector_update
+  AFTER INSERT OR DELETE ON package_tags
+  FOR EACH ROW EXECUTE FUNCTION package_tags_search_vector_update();
+
+CREATE FUNCTION tags_search_vector_update() RETURNS trigger AS $$
+BEGIN
+  UPDATE packages SET search_vector = NULL
+  WHERE id IN (SELECT package_id FROM package_tags WHERE tag_id = NEW.id);
+
+  RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tags_search_vector_update
+  AFTER UPDATE OF name, is_show, deleted_at ON tags
+  FOR EACH ROW EXECUTE FUNCTION tags_search_vector_update();
+
+UPDATE packages SET search_vector = NULL;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS package_favorites;
+ALTER TABLE packages DROP COLUMN IF EXISTS favorite_count;
@@ -0,0 +1,14 @@
+CREATE TABLE package_favorites (
+  id bigint PRIMARY KEY,
+  package_id bigint NOT NULL,
+  user_id bigint NOT NULL,
+  created_at timestamp NOT NULL,
+  updated_at timestamp NOT NULL,
+  UNIQUE(package_id, user_id)
+);
+
+CREATE INDEX package_favorites_user_id_created_at_index ON package_favorites (user_id, created_at);
+
+ALTER TABLE packages ADD COLUMN favorite_count int NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN packages.favorite_count IS 'The number of the package_favorites rows of the package, kept in the same statement as the rows';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS package_reviews;
+ALTER TABLE packages DROP COLUMN IF EXISTS rating_count;
+ALTER TABLE packages DROP COLUMN IF EXISTS rating_sum;
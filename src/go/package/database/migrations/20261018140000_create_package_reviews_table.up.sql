@@ -0,0 +1,16 @@
+CREATE TABLE package_reviews (
+  id bigint PRIMARY KEY,
+  package_id bigint NOT NULL,
+  user_id bigint NOT NULL,
+  rating smallint NOT NULL CHECK (rating BETWEEN 1 AND 5),
+  content text DEFAULT NULL,
+  created_at timestamp NOT NULL,
+  updated_at timestamp NOT NULL,
+  UNIQUE(package_id, user_id)
+);
+
+ALTER TABLE packages ADD COLUMN rating_count int NOT NULL DEFAULT 0;
+ALTER TABLE packages ADD COLUMN rating_sum int NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN packages.rating_count IS 'The number of the package_reviews rows of the package, kept in the same statement as the rows';
+COMMENT ON COLUMN packages.rating_sum IS 'The sum of the ratings of the package_reviews rows of the package, kept in the same statement as the rows';
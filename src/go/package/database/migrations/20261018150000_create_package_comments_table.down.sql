@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS package_comments;
+ALTER TABLE packages DROP COLUMN IF EXISTS comment_count;
@@ -0,0 +1,19 @@
+CREATE TABLE package_comments (
+  id bigint PRIMARY KEY,
+  package_id bigint NOT NULL,
+  user_id bigint NOT NULL,
+  parent_id bigint NOT NULL DEFAULT 0,
+  content text NOT NULL,
+  edited_at timestamp DEFAULT NULL,
+  created_at timestamp NOT NULL,
+  updated_at timestamp NOT NULL,
+  deleted_at timestamp DEFAULT NULL
+);
+
+CREATE INDEX package_comments_package_id_parent_id_index ON package_comments (package_id, parent_id);
+CREATE INDEX package_comments_parent_id_index ON package_comments (parent_id);
+
+ALTER TABLE packages ADD COLUMN comment_count int NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN package_comments.parent_id IS '0: top-level comment, otherwise the top-level comment that it replies to';
+COMMENT ON COLUMN packages.comment_count IS 'The number of the package_comments rows of the package that are not deleted, kept in the same statement as the rows';
@@ -0,0 +1,12 @@
+CREATE TABLE tag_aliases (
+  id bigint PRIMARY KEY,
+  tag_id bigint NOT NULL,
+  name varchar(255) NOT NULL,
+  created_at timestamp NOT NULL,
+  updated_at timestamp NOT NULL,
+  UNIQUE(name)
+);
+
+CREATE INDEX tag_aliases_tag_id_index ON tag_aliases (tag_id);
+
+COMMENT ON COLUMN tag_aliases.name IS 'lower-cased, resolves to the tag when tags are attached to packages';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS tags_package_count_index;
+ALTER TABLE tags DROP COLUMN IF EXISTS package_count;
@@ -0,0 +1,10 @@
+ALTER TABLE tags ADD COLUMN package_count int NOT NULL DEFAULT 0;
+
+UPDATE tags SET package_count = (
+  SELECT COUNT(*) FROM package_tags JOIN packages ON packages.id = package_tags.package_id
+  WHERE package_tags.tag_id = tags.id AND packages.is_approved = 1 AND packages.is_public = 1 AND packages.deleted_at IS NULL
+);
+
+CREATE INDEX tags_package_count_index ON tags (package_count, id);
+
+COMMENT ON COLUMN tags.package_count IS 'The number of the approved public packages that are not deleted carrying the tag, recounted when the tags or the visibility of a package change';
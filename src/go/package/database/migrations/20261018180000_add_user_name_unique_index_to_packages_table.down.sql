@@ -0,0 +1 @@
+DROP INDEX IF EXISTS packages_user_id_name_unique;
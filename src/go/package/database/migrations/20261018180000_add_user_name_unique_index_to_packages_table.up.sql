@@ -0,0 +1,9 @@
+-- The packages created before the index keep their names only if they are the first package of the user with the
+-- name, the later ones get the ID as a suffix.
+UPDATE packages SET name = packages.name || '-' || packages.id
+FROM (
+  SELECT id, ROW_NUMBER() OVER (PARTITION BY user_id, name ORDER BY id) AS number FROM packages WHERE deleted_at IS NULL
+) AS duplicates
+WHERE packages.id = duplicates.id AND duplicates.number > 1;
+
+CREATE UNIQUE INDEX packages_user_id_name_unique ON packages (user_id, name) WHERE deleted_at IS NULL;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS package_slugs;
+
+DROP INDEX IF EXISTS packages_slug_unique;
+
+ALTER TABLE packages DROP COLUMN IF EXISTS slug;
@@ -0,0 +1,19 @@
+ALTER TABLE packages ADD COLUMN slug varchar(255) NOT NULL DEFAULT '';
+
+-- The existing packages get their slugs from the package:backfill-slugs command.
+CREATE UNIQUE INDEX packages_slug_unique ON packages (slug) WHERE slug <> '';
+
+COMMENT ON COLUMN packages.slug IS 'generated from the name, unique across the packages including the deleted ones, empty until backfilled';
+
+CREATE TABLE package_slugs (
+  id bigint PRIMARY KEY,
+  package_id bigint NOT NULL,
+  slug varchar(255) NOT NULL,
+  created_at timestamp NOT NULL,
+  updated_at timestamp NOT NULL,
+  UNIQUE(slug)
+);
+
+CREATE INDEX package_slugs_package_id_index ON package_slugs (package_id);
+
+COMMENT ON TABLE package_slugs IS 'The previous slugs of the renamed packages, they redirect to the current slugs';
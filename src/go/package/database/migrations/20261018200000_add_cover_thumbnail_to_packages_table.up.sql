@@ -0,0 +1,3 @@
+ALTER TABLE packages ADD COLUMN cover_thumbnail varchar(255) NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN packages.cover_thumbnail IS 'the thumbnail of an uploaded cover, empty if the cover is linked';
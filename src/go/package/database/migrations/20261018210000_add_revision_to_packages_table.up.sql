@@ -0,0 +1,3 @@
+ALTER TABLE packages ADD COLUMN revision bigint NOT NULL DEFAULT 1;
+
+COMMENT ON COLUMN packages.revision IS 'increased by every update, the updates based on a previous revision are rejected';
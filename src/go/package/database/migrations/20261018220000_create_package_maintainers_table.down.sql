@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS package_maintainers;
+ALTER TABLE packages DROP COLUMN IF EXISTS pending_owner_id;
@@ -0,0 +1,22 @@
+CREATE TABLE package_maintainers (
+  id bigint PRIMARY KEY,
+  package_id bigint NOT NULL,
+  user_id bigint NOT NULL,
+  role varchar(16) NOT NULL,
+  created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE(package_id, user_id)
+);
+
+CREATE INDEX package_maintainers_user_id_index ON package_maintainers (user_id);
+
+COMMENT ON TABLE package_maintainers IS 'The users who can manage the packages, the owner of a package (packages.user_id) has the owner role';
+
+-- The owners of the existing packages, a package has a single owner, so its snowflake ID is reused as the ID of the
+-- owner.
+INSERT INTO package_maintainers (id, package_id, user_id, role, created_at, updated_at)
+SELECT id, id, user_id, 'owner', created_at, created_at FROM packages;
+
+ALTER TABLE packages ADD COLUMN pending_owner_id bigint NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN packages.pending_owner_id IS 'the user the package is being transferred to, 0 without a pending transfer';
@@ -0,0 +1,5 @@
+ALTER TABLE packages ADD COLUMN organization_id bigint NOT NULL DEFAULT 0;
+
+CREATE INDEX packages_organization_id_index ON packages (organization_id);
+
+COMMENT ON COLUMN packages.organization_id IS 'the organization publishing the package in the user service, 0 if it is published by its owner';
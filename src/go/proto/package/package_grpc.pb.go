@@ -19,11 +19,44 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	PackageService_GetPackage_FullMethodName    = "/package.PackageService/GetPackage"
-	PackageService_GetTags_FullMethodName       = "/package.PackageService/GetTags"
-	PackageService_GetPackages_FullMethodName   = "/package.PackageService/GetPackages"
-	PackageService_CreatePackage_FullMethodName = "/package.PackageService/CreatePackage"
-	PackageService_UpdatePackage_FullMethodName = "/package.PackageService/UpdatePackage"
+	PackageService_GetPackage_FullMethodName             = "/package.PackageService/GetPackage"
+	PackageService_GetPackageBySlug_FullMethodName       = "/package.PackageService/GetPackageBySlug"
+	PackageService_GetTags_FullMethodName                = "/package.PackageService/GetTags"
+	PackageService_CreateTag_FullMethodName              = "/package.PackageService/CreateTag"
+	PackageService_UpdateTag_FullMethodName              = "/package.PackageService/UpdateTag"
+	PackageService_MergeTags_FullMethodName              = "/package.PackageService/MergeTags"
+	PackageService_CreateTagAlias_FullMethodName         = "/package.PackageService/CreateTagAlias"
+	PackageService_DeleteTagAlias_FullMethodName         = "/package.PackageService/DeleteTagAlias"
+	PackageService_GetPackages_FullMethodName            = "/package.PackageService/GetPackages"
+	PackageService_CreatePackage_FullMethodName          = "/package.PackageService/CreatePackage"
+	PackageService_UpdatePackage_FullMethodName          = "/package.PackageService/UpdatePackage"
+	PackageService_GetPendingPackages_FullMethodName     = "/package.PackageService/GetPendingPackages"
+	PackageService_ApprovePackage_FullMethodName         = "/package.PackageService/ApprovePackage"
+	PackageService_RejectPackage_FullMethodName          = "/package.PackageService/RejectPackage"
+	PackageService_DeletePackage_FullMethodName          = "/package.PackageService/DeletePackage"
+	PackageService_RestorePackage_FullMethodName         = "/package.PackageService/RestorePackage"
+	PackageService_CreateRelease_FullMethodName          = "/package.PackageService/CreateRelease"
+	PackageService_ListReleases_FullMethodName           = "/package.PackageService/ListReleases"
+	PackageService_GetRelease_FullMethodName             = "/package.PackageService/GetRelease"
+	PackageService_SyncPackage_FullMethodName            = "/package.PackageService/SyncPackage"
+	PackageService_GetMaintainers_FullMethodName         = "/package.PackageService/GetMaintainers"
+	PackageService_AddMaintainer_FullMethodName          = "/package.PackageService/AddMaintainer"
+	PackageService_RemoveMaintainer_FullMethodName       = "/package.PackageService/RemoveMaintainer"
+	PackageService_TransferPackage_FullMethodName        = "/package.PackageService/TransferPackage"
+	PackageService_AcceptPackageTransfer_FullMethodName  = "/package.PackageService/AcceptPackageTransfer"
+	PackageService_DeclinePackageTransfer_FullMethodName = "/package.PackageService/DeclinePackageTransfer"
+	PackageService_UploadPackageCover_FullMethodName     = "/package.PackageService/UploadPackageCover"
+	PackageService_FavoritePackage_FullMethodName        = "/package.PackageService/FavoritePackage"
+	PackageService_UnfavoritePackage_FullMethodName      = "/package.PackageService/UnfavoritePackage"
+	PackageService_ListFavoritePackages_FullMethodName   = "/package.PackageService/ListFavoritePackages"
+	PackageService_CreateReview_FullMethodName           = "/package.PackageService/CreateReview"
+	PackageService_UpdateReview_FullMethodName           = "/package.PackageService/UpdateReview"
+	PackageService_DeleteReview_FullMethodName           = "/package.PackageService/DeleteReview"
+	PackageService_ListReviews_FullMethodName            = "/package.PackageService/ListReviews"
+	PackageService_CreateComment_FullMethodName          = "/package.PackageService/CreateComment"
+	PackageService_UpdateComment_FullMethodName          = "/package.PackageService/UpdateComment"
+	PackageService_DeleteComment_FullMethodName          = "/package.PackageService/DeleteComment"
+	PackageService_ListComments_FullMethodName           = "/package.PackageService/ListComments"
 )
 
 // PackageServiceClient is the client API for PackageService service.
@@ -31,10 +64,62 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PackageServiceClient interface {
 	GetPackage(ctx context.Context, in *GetPackageRequest, opts ...grpc.CallOption) (*GetPackageResponse, error)
+	GetPackageBySlug(ctx context.Context, in *GetPackageBySlugRequest, opts ...grpc.CallOption) (*GetPackageBySlugResponse, error)
 	GetTags(ctx context.Context, in *GetTagsRequest, opts ...grpc.CallOption) (*GetTagsResponse, error)
+	// Admin only.
+	CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*CreateTagResponse, error)
+	// Admin only, renames, hides or shows the tag.
+	UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*UpdateTagResponse, error)
+	// Admin only, moves the packages and aliases of the tag to the target tag, then deletes the tag and keeps its name as
+	// an alias of the target tag.
+	MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*MergeTagsResponse, error)
+	// Admin only.
+	CreateTagAlias(ctx context.Context, in *CreateTagAliasRequest, opts ...grpc.CallOption) (*CreateTagAliasResponse, error)
+	// Admin only.
+	DeleteTagAlias(ctx context.Context, in *DeleteTagAliasRequest, opts ...grpc.CallOption) (*DeleteTagAliasResponse, error)
 	GetPackages(ctx context.Context, in *GetPackagesRequest, opts ...grpc.CallOption) (*GetPackagesResponse, error)
 	CreatePackage(ctx context.Context, in *CreatePackageRequest, opts ...grpc.CallOption) (*CreatePackageResponse, error)
 	UpdatePackage(ctx context.Context, in *UpdatePackageRequest, opts ...grpc.CallOption) (*UpdatePackageResponse, error)
+	// Admin only, list the packages that are waiting for moderation.
+	GetPendingPackages(ctx context.Context, in *GetPendingPackagesRequest, opts ...grpc.CallOption) (*GetPendingPackagesResponse, error)
+	// Admin only.
+	ApprovePackage(ctx context.Context, in *ApprovePackageRequest, opts ...grpc.CallOption) (*ApprovePackageResponse, error)
+	// Admin only.
+	RejectPackage(ctx context.Context, in *RejectPackageRequest, opts ...grpc.CallOption) (*RejectPackageResponse, error)
+	DeletePackage(ctx context.Context, in *DeletePackageRequest, opts ...grpc.CallOption) (*DeletePackageResponse, error)
+	RestorePackage(ctx context.Context, in *RestorePackageRequest, opts ...grpc.CallOption) (*RestorePackageResponse, error)
+	CreateRelease(ctx context.Context, in *CreateReleaseRequest, opts ...grpc.CallOption) (*CreateReleaseResponse, error)
+	ListReleases(ctx context.Context, in *ListReleasesRequest, opts ...grpc.CallOption) (*ListReleasesResponse, error)
+	GetRelease(ctx context.Context, in *GetReleaseRequest, opts ...grpc.CallOption) (*GetReleaseResponse, error)
+	SyncPackage(ctx context.Context, in *SyncPackageRequest, opts ...grpc.CallOption) (*SyncPackageResponse, error)
+	// The maintainers of the package, the owner first.
+	GetMaintainers(ctx context.Context, in *GetMaintainersRequest, opts ...grpc.CallOption) (*GetMaintainersResponse, error)
+	// Only the owner can add maintainers.
+	AddMaintainer(ctx context.Context, in *AddMaintainerRequest, opts ...grpc.CallOption) (*AddMaintainerResponse, error)
+	// The owner can remove the other maintainers, a maintainer can remove themselves.
+	RemoveMaintainer(ctx context.Context, in *RemoveMaintainerRequest, opts ...grpc.CallOption) (*RemoveMaintainerResponse, error)
+	// Starts transferring the package to another user, it replaces the pending transfer of the package.
+	TransferPackage(ctx context.Context, in *TransferPackageRequest, opts ...grpc.CallOption) (*TransferPackageResponse, error)
+	// The recipient accepts the pending transfer, the previous owner stays a maintainer.
+	AcceptPackageTransfer(ctx context.Context, in *AcceptPackageTransferRequest, opts ...grpc.CallOption) (*AcceptPackageTransferResponse, error)
+	// The recipient declines or the owner cancels the pending transfer.
+	DeclinePackageTransfer(ctx context.Context, in *DeclinePackageTransferRequest, opts ...grpc.CallOption) (*DeclinePackageTransferResponse, error)
+	// Replaces the cover by the uploaded image, the previously uploaded cover is deleted.
+	UploadPackageCover(ctx context.Context, in *UploadPackageCoverRequest, opts ...grpc.CallOption) (*UploadPackageCoverResponse, error)
+	FavoritePackage(ctx context.Context, in *FavoritePackageRequest, opts ...grpc.CallOption) (*FavoritePackageResponse, error)
+	UnfavoritePackage(ctx context.Context, in *UnfavoritePackageRequest, opts ...grpc.CallOption) (*UnfavoritePackageResponse, error)
+	// The packages favorited by the caller.
+	ListFavoritePackages(ctx context.Context, in *ListFavoritePackagesRequest, opts ...grpc.CallOption) (*ListFavoritePackagesResponse, error)
+	// One review per user per package.
+	CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*CreateReviewResponse, error)
+	UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*UpdateReviewResponse, error)
+	DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*DeleteReviewResponse, error)
+	ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error)
+	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error)
+	UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentResponse, error)
+	// The commenter or the author of the package can delete a comment.
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
+	ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error)
 }
 
 type packageServiceClient struct {
@@ -54,6 +139,15 @@ func (c *packageServiceClient) GetPackage(ctx context.Context, in *GetPackageReq
 	return out, nil
 }
 
+func (c *packageServiceClient) GetPackageBySlug(ctx context.Context, in *GetPackageBySlugRequest, opts ...grpc.CallOption) (*GetPackageBySlugResponse, error) {
+	out := new(GetPackageBySlugResponse)
+	err := c.cc.Invoke(ctx, PackageService_GetPackageBySlug_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *packageServiceClient) GetTags(ctx context.Context, in *GetTagsRequest, opts ...grpc.CallOption) (*GetTagsResponse, error) {
 	out := new(GetTagsResponse)
 	err := c.cc.Invoke(ctx, PackageService_GetTags_FullMethodName, in, out, opts...)
@@ -63,6 +157,51 @@ func (c *packageServiceClient) GetTags(ctx context.Context, in *GetTagsRequest,
 	return out, nil
 }
 
+func (c *packageServiceClient) CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*CreateTagResponse, error) {
+	out := new(CreateTagResponse)
+	err := c.cc.Invoke(ctx, PackageService_CreateTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*UpdateTagResponse, error) {
+	out := new(UpdateTagResponse)
+	err := c.cc.Invoke(ctx, PackageService_UpdateTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*MergeTagsResponse, error) {
+	out := new(MergeTagsResponse)
+	err := c.cc.Invoke(ctx, PackageService_MergeTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) CreateTagAlias(ctx context.Context, in *CreateTagAliasRequest, opts ...grpc.CallOption) (*CreateTagAliasResponse, error) {
+	out := new(CreateTagAliasResponse)
+	err := c.cc.Invoke(ctx, PackageService_CreateTagAlias_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) DeleteTagAlias(ctx context.Context, in *DeleteTagAliasRequest, opts ...grpc.CallOption) (*DeleteTagAliasResponse, error) {
+	out := new(DeleteTagAliasResponse)
+	err := c.cc.Invoke(ctx, PackageService_DeleteTagAlias_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *packageServiceClient) GetPackages(ctx context.Context, in *GetPackagesRequest, opts ...grpc.CallOption) (*GetPackagesResponse, error) {
 	out := new(GetPackagesResponse)
 	err := c.cc.Invoke(ctx, PackageService_GetPackages_FullMethodName, in, out, opts...)
@@ -90,15 +229,310 @@ func (c *packageServiceClient) UpdatePackage(ctx context.Context, in *UpdatePack
 	return out, nil
 }
 
+func (c *packageServiceClient) GetPendingPackages(ctx context.Context, in *GetPendingPackagesRequest, opts ...grpc.CallOption) (*GetPendingPackagesResponse, error) {
+	out := new(GetPendingPackagesResponse)
+	err := c.cc.Invoke(ctx, PackageService_GetPendingPackages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) ApprovePackage(ctx context.Context, in *ApprovePackageRequest, opts ...grpc.CallOption) (*ApprovePackageResponse, error) {
+	out := new(ApprovePackageResponse)
+	err := c.cc.Invoke(ctx, PackageService_ApprovePackage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) RejectPackage(ctx context.Context, in *RejectPackageRequest, opts ...grpc.CallOption) (*RejectPackageResponse, error) {
+	out := new(RejectPackageResponse)
+	err := c.cc.Invoke(ctx, PackageService_RejectPackage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) DeletePackage(ctx context.Context, in *DeletePackageRequest, opts ...grpc.CallOption) (*DeletePackageResponse, error) {
+	out := new(DeletePackageResponse)
+	err := c.cc.Invoke(ctx, PackageService_DeletePackage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) RestorePackage(ctx context.Context, in *RestorePackageRequest, opts ...grpc.CallOption) (*RestorePackageResponse, error) {
+	out := new(RestorePackageResponse)
+	err := c.cc.Invoke(ctx, PackageService_RestorePackage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) CreateRelease(ctx context.Context, in *CreateReleaseRequest, opts ...grpc.CallOption) (*CreateReleaseResponse, error) {
+	out := new(CreateReleaseResponse)
+	err := c.cc.Invoke(ctx, PackageService_CreateRelease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) ListReleases(ctx context.Context, in *ListReleasesRequest, opts ...grpc.CallOption) (*ListReleasesResponse, error) {
+	out := new(ListReleasesResponse)
+	err := c.cc.Invoke(ctx, PackageService_ListReleases_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) GetRelease(ctx context.Context, in *GetReleaseRequest, opts ...grpc.CallOption) (*GetReleaseResponse, error) {
+	out := new(GetReleaseResponse)
+	err := c.cc.Invoke(ctx, PackageService_GetRelease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) SyncPackage(ctx context.Context, in *SyncPackageRequest, opts ...grpc.CallOption) (*SyncPackageResponse, error) {
+	out := new(SyncPackageResponse)
+	err := c.cc.Invoke(ctx, PackageService_SyncPackage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) GetMaintainers(ctx context.Context, in *GetMaintainersRequest, opts ...grpc.CallOption) (*GetMaintainersResponse, error) {
+	out := new(GetMaintainersResponse)
+	err := c.cc.Invoke(ctx, PackageService_GetMaintainers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) AddMaintainer(ctx context.Context, in *AddMaintainerRequest, opts ...grpc.CallOption) (*AddMaintainerResponse, error) {
+	out := new(AddMaintainerResponse)
+	err := c.cc.Invoke(ctx, PackageService_AddMaintainer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) RemoveMaintainer(ctx context.Context, in *RemoveMaintainerRequest, opts ...grpc.CallOption) (*RemoveMaintainerResponse, error) {
+	out := new(RemoveMaintainerResponse)
+	err := c.cc.Invoke(ctx, PackageService_RemoveMaintainer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) TransferPackage(ctx context.Context, in *TransferPackageRequest, opts ...grpc.CallOption) (*TransferPackageResponse, error) {
+	out := new(TransferPackageResponse)
+	err := c.cc.Invoke(ctx, PackageService_TransferPackage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) AcceptPackageTransfer(ctx context.Context, in *AcceptPackageTransferRequest, opts ...grpc.CallOption) (*AcceptPackageTransferResponse, error) {
+	out := new(AcceptPackageTransferResponse)
+	err := c.cc.Invoke(ctx, PackageService_AcceptPackageTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) DeclinePackageTransfer(ctx context.Context, in *DeclinePackageTransferRequest, opts ...grpc.CallOption) (*DeclinePackageTransferResponse, error) {
+	out := new(DeclinePackageTransferResponse)
+	err := c.cc.Invoke(ctx, PackageService_DeclinePackageTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) UploadPackageCover(ctx context.Context, in *UploadPackageCoverRequest, opts ...grpc.CallOption) (*UploadPackageCoverResponse, error) {
+	out := new(UploadPackageCoverResponse)
+	err := c.cc.Invoke(ctx, PackageService_UploadPackageCover_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) FavoritePackage(ctx context.Context, in *FavoritePackageRequest, opts ...grpc.CallOption) (*FavoritePackageResponse, error) {
+	out := new(FavoritePackageResponse)
+	err := c.cc.Invoke(ctx, PackageService_FavoritePackage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) UnfavoritePackage(ctx context.Context, in *UnfavoritePackageRequest, opts ...grpc.CallOption) (*UnfavoritePackageResponse, error) {
+	out := new(UnfavoritePackageResponse)
+	err := c.cc.Invoke(ctx, PackageService_UnfavoritePackage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) ListFavoritePackages(ctx context.Context, in *ListFavoritePackagesRequest, opts ...grpc.CallOption) (*ListFavoritePackagesResponse, error) {
+	out := new(ListFavoritePackagesResponse)
+	err := c.cc.Invoke(ctx, PackageService_ListFavoritePackages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*CreateReviewResponse, error) {
+	out := new(CreateReviewResponse)
+	err := c.cc.Invoke(ctx, PackageService_CreateReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*UpdateReviewResponse, error) {
+	out := new(UpdateReviewResponse)
+	err := c.cc.Invoke(ctx, PackageService_UpdateReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*DeleteReviewResponse, error) {
+	out := new(DeleteReviewResponse)
+	err := c.cc.Invoke(ctx, PackageService_DeleteReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error) {
+	out := new(ListReviewsResponse)
+	err := c.cc.Invoke(ctx, PackageService_ListReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error) {
+	out := new(CreateCommentResponse)
+	err := c.cc.Invoke(ctx, PackageService_CreateComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentResponse, error) {
+	out := new(UpdateCommentResponse)
+	err := c.cc.Invoke(ctx, PackageService_UpdateComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
+	out := new(DeleteCommentResponse)
+	err := c.cc.Invoke(ctx, PackageService_DeleteComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packageServiceClient) ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error) {
+	out := new(ListCommentsResponse)
+	err := c.cc.Invoke(ctx, PackageService_ListComments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PackageServiceServer is the server API for PackageService service.
 // All implementations must embed UnimplementedPackageServiceServer
 // for forward compatibility
 type PackageServiceServer interface {
 	GetPackage(context.Context, *GetPackageRequest) (*GetPackageResponse, error)
+	GetPackageBySlug(context.Context, *GetPackageBySlugRequest) (*GetPackageBySlugResponse, error)
 	GetTags(context.Context, *GetTagsRequest) (*GetTagsResponse, error)
+	// Admin only.
+	CreateTag(context.Context, *CreateTagRequest) (*CreateTagResponse, error)
+	// Admin only, renames, hides or shows the tag.
+	UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagResponse, error)
+	// Admin only, moves the packages and aliases of the tag to the target tag, then deletes the tag and keeps its name as
+	// an alias of the target tag.
+	MergeTags(context.Context, *MergeTagsRequest) (*MergeTagsResponse, error)
+	// Admin only.
+	CreateTagAlias(context.Context, *CreateTagAliasRequest) (*CreateTagAliasResponse, error)
+	// Admin only.
+	DeleteTagAlias(context.Context, *DeleteTagAliasRequest) (*DeleteTagAliasResponse, error)
 	GetPackages(context.Context, *GetPackagesRequest) (*GetPackagesResponse, error)
 	CreatePackage(context.Context, *CreatePackageRequest) (*CreatePackageResponse, error)
 	UpdatePackage(context.Context, *UpdatePackageRequest) (*UpdatePackageResponse, error)
+	// Admin only, list the packages that are waiting for moderation.
+	GetPendingPackages(context.Context, *GetPendingPackagesRequest) (*GetPendingPackagesResponse, error)
+	// Admin only.
+	ApprovePackage(context.Context, *ApprovePackageRequest) (*ApprovePackageResponse, error)
+	// Admin only.
+	RejectPackage(context.Context, *RejectPackageRequest) (*RejectPackageResponse, error)
+	DeletePackage(context.Context, *DeletePackageRequest) (*DeletePackageResponse, error)
+	RestorePackage(context.Context, *RestorePackageRequest) (*RestorePackageResponse, error)
+	CreateRelease(context.Context, *CreateReleaseRequest) (*CreateReleaseResponse, error)
+	ListReleases(context.Context, *ListReleasesRequest) (*ListReleasesResponse, error)
+	GetRelease(context.Context, *GetReleaseRequest) (*GetReleaseResponse, error)
+	SyncPackage(context.Context, *SyncPackageRequest) (*SyncPackageResponse, error)
+	// The maintainers of the package, the owner first.
+	GetMaintainers(context.Context, *GetMaintainersRequest) (*GetMaintainersResponse, error)
+	// Only the owner can add maintainers.
+	AddMaintainer(context.Context, *AddMaintainerRequest) (*AddMaintainerResponse, error)
+	// The owner can remove the other maintainers, a maintainer can remove themselves.
+	RemoveMaintainer(context.Context, *RemoveMaintainerRequest) (*RemoveMaintainerResponse, error)
+	// Starts transferring the package to another user, it replaces the pending transfer of the package.
+	TransferPackage(context.Context, *TransferPackageRequest) (*TransferPackageResponse, error)
+	// The recipient accepts the pending transfer, the previous owner stays a maintainer.
+	AcceptPackageTransfer(context.Context, *AcceptPackageTransferRequest) (*AcceptPackageTransferResponse, error)
+	// The recipient declines or the owner cancels the pending transfer.
+	DeclinePackageTransfer(context.Context, *DeclinePackageTransferRequest) (*DeclinePackageTransferResponse, error)
+	// Replaces the cover by the uploaded image, the previously uploaded cover is deleted.
+	UploadPackageCover(context.Context, *UploadPackageCoverRequest) (*UploadPackageCoverResponse, error)
+	FavoritePackage(context.Context, *FavoritePackageRequest) (*FavoritePackageResponse, error)
+	UnfavoritePackage(context.Context, *UnfavoritePackageRequest) (*UnfavoritePackageResponse, error)
+	// The packages favorited by the caller.
+	ListFavoritePackages(context.Context, *ListFavoritePackagesRequest) (*ListFavoritePackagesResponse, error)
+	// One review per user per package.
+	CreateReview(context.Context, *CreateReviewRequest) (*CreateReviewResponse, error)
+	UpdateReview(context.Context, *UpdateReviewRequest) (*UpdateReviewResponse, error)
+	DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewResponse, error)
+	ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error)
+	CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error)
+	UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentResponse, error)
+	// The commenter or the author of the package can delete a comment.
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error)
 	mustEmbedUnimplementedPackageServiceServer()
 }
 
@@ -109,9 +543,27 @@ type UnimplementedPackageServiceServer struct {
 func (UnimplementedPackageServiceServer) GetPackage(context.Context, *GetPackageRequest) (*GetPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPackage not implemented")
 }
+func (UnimplementedPackageServiceServer) GetPackageBySlug(context.Context, *GetPackageBySlugRequest) (*GetPackageBySlugResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPackageBySlug not implemented")
+}
 func (UnimplementedPackageServiceServer) GetTags(context.Context, *GetTagsRequest) (*GetTagsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTags not implemented")
 }
+func (UnimplementedPackageServiceServer) CreateTag(context.Context, *CreateTagRequest) (*CreateTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTag not implemented")
+}
+func (UnimplementedPackageServiceServer) UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTag not implemented")
+}
+func (UnimplementedPackageServiceServer) MergeTags(context.Context, *MergeTagsRequest) (*MergeTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeTags not implemented")
+}
+func (UnimplementedPackageServiceServer) CreateTagAlias(context.Context, *CreateTagAliasRequest) (*CreateTagAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTagAlias not implemented")
+}
+func (UnimplementedPackageServiceServer) DeleteTagAlias(context.Context, *DeleteTagAliasRequest) (*DeleteTagAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTagAlias not implemented")
+}
 func (UnimplementedPackageServiceServer) GetPackages(context.Context, *GetPackagesRequest) (*GetPackagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPackages not implemented")
 }
@@ -121,6 +573,87 @@ func (UnimplementedPackageServiceServer) CreatePackage(context.Context, *CreateP
 func (UnimplementedPackageServiceServer) UpdatePackage(context.Context, *UpdatePackageRequest) (*UpdatePackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePackage not implemented")
 }
+func (UnimplementedPackageServiceServer) GetPendingPackages(context.Context, *GetPendingPackagesRequest) (*GetPendingPackagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingPackages not implemented")
+}
+func (UnimplementedPackageServiceServer) ApprovePackage(context.Context, *ApprovePackageRequest) (*ApprovePackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovePackage not implemented")
+}
+func (UnimplementedPackageServiceServer) RejectPackage(context.Context, *RejectPackageRequest) (*RejectPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectPackage not implemented")
+}
+func (UnimplementedPackageServiceServer) DeletePackage(context.Context, *DeletePackageRequest) (*DeletePackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePackage not implemented")
+}
+func (UnimplementedPackageServiceServer) RestorePackage(context.Context, *RestorePackageRequest) (*RestorePackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePackage not implemented")
+}
+func (UnimplementedPackageServiceServer) CreateRelease(context.Context, *CreateReleaseRequest) (*CreateReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRelease not implemented")
+}
+func (UnimplementedPackageServiceServer) ListReleases(context.Context, *ListReleasesRequest) (*ListReleasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReleases not implemented")
+}
+func (UnimplementedPackageServiceServer) GetRelease(context.Context, *GetReleaseRequest) (*GetReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelease not implemented")
+}
+func (UnimplementedPackageServiceServer) SyncPackage(context.Context, *SyncPackageRequest) (*SyncPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncPackage not implemented")
+}
+func (UnimplementedPackageServiceServer) GetMaintainers(context.Context, *GetMaintainersRequest) (*GetMaintainersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaintainers not implemented")
+}
+func (UnimplementedPackageServiceServer) AddMaintainer(context.Context, *AddMaintainerRequest) (*AddMaintainerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMaintainer not implemented")
+}
+func (UnimplementedPackageServiceServer) RemoveMaintainer(context.Context, *RemoveMaintainerRequest) (*RemoveMaintainerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMaintainer not implemented")
+}
+func (UnimplementedPackageServiceServer) TransferPackage(context.Context, *TransferPackageRequest) (*TransferPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPackage not implemented")
+}
+func (UnimplementedPackageServiceServer) AcceptPackageTransfer(context.Context, *AcceptPackageTransferRequest) (*AcceptPackageTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptPackageTransfer not implemented")
+}
+func (UnimplementedPackageServiceServer) DeclinePackageTransfer(context.Context, *DeclinePackageTransferRequest) (*DeclinePackageTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclinePackageTransfer not implemented")
+}
+func (UnimplementedPackageServiceServer) UploadPackageCover(context.Context, *UploadPackageCoverRequest) (*UploadPackageCoverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadPackageCover not implemented")
+}
+func (UnimplementedPackageServiceServer) FavoritePackage(context.Context, *FavoritePackageRequest) (*FavoritePackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FavoritePackage not implemented")
+}
+func (UnimplementedPackageServiceServer) UnfavoritePackage(context.Context, *UnfavoritePackageRequest) (*UnfavoritePackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfavoritePackage not implemented")
+}
+func (UnimplementedPackageServiceServer) ListFavoritePackages(context.Context, *ListFavoritePackagesRequest) (*ListFavoritePackagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFavoritePackages not implemented")
+}
+func (UnimplementedPackageServiceServer) CreateReview(context.Context, *CreateReviewRequest) (*CreateReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedPackageServiceServer) UpdateReview(context.Context, *UpdateReviewRequest) (*UpdateReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
+}
+func (UnimplementedPackageServiceServer) DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReview not implemented")
+}
+func (UnimplementedPackageServiceServer) ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviews not implemented")
+}
+func (UnimplementedPackageServiceServer) CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (UnimplementedPackageServiceServer) UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
+}
+func (UnimplementedPackageServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedPackageServiceServer) ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
+}
 func (UnimplementedPackageServiceServer) mustEmbedUnimplementedPackageServiceServer() {}
 
 // UnsafePackageServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -152,74 +685,668 @@ func _PackageService_GetPackage_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PackageService_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTagsRequest)
+func _PackageService_GetPackageBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPackageBySlugRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PackageServiceServer).GetTags(ctx, in)
+		return srv.(PackageServiceServer).GetPackageBySlug(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PackageService_GetTags_FullMethodName,
+		FullMethod: PackageService_GetPackageBySlug_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PackageServiceServer).GetTags(ctx, req.(*GetTagsRequest))
+		return srv.(PackageServiceServer).GetPackageBySlug(ctx, req.(*GetPackageBySlugRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PackageService_GetPackages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPackagesRequest)
+func _PackageService_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PackageServiceServer).GetPackages(ctx, in)
+		return srv.(PackageServiceServer).GetTags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PackageService_GetPackages_FullMethodName,
+		FullMethod: PackageService_GetTags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PackageServiceServer).GetPackages(ctx, req.(*GetPackagesRequest))
+		return srv.(PackageServiceServer).GetTags(ctx, req.(*GetTagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PackageService_CreatePackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePackageRequest)
+func _PackageService_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PackageServiceServer).CreatePackage(ctx, in)
+		return srv.(PackageServiceServer).CreateTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PackageService_CreatePackage_FullMethodName,
+		FullMethod: PackageService_CreateTag_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PackageServiceServer).CreatePackage(ctx, req.(*CreatePackageRequest))
+		return srv.(PackageServiceServer).CreateTag(ctx, req.(*CreateTagRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PackageService_UpdatePackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePackageRequest)
+func _PackageService_UpdateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PackageServiceServer).UpdatePackage(ctx, in)
+		return srv.(PackageServiceServer).UpdateTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PackageService_UpdatePackage_FullMethodName,
+		FullMethod: PackageService_UpdateTag_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PackageServiceServer).UpdatePackage(ctx, req.(*UpdatePackageRequest))
+		return srv.(PackageServiceServer).UpdateTag(ctx, req.(*UpdateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_MergeTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).MergeTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_MergeTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).MergeTags(ctx, req.(*MergeTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_CreateTagAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).CreateTagAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_CreateTagAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).CreateTagAlias(ctx, req.(*CreateTagAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_DeleteTagAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).DeleteTagAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_DeleteTagAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).DeleteTagAlias(ctx, req.(*DeleteTagAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_GetPackages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPackagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).GetPackages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_GetPackages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).GetPackages(ctx, req.(*GetPackagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_CreatePackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).CreatePackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_CreatePackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).CreatePackage(ctx, req.(*CreatePackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_UpdatePackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).UpdatePackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_UpdatePackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).UpdatePackage(ctx, req.(*UpdatePackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_GetPendingPackages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingPackagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).GetPendingPackages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_GetPendingPackages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).GetPendingPackages(ctx, req.(*GetPendingPackagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_ApprovePackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApprovePackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).ApprovePackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_ApprovePackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).ApprovePackage(ctx, req.(*ApprovePackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_RejectPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).RejectPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_RejectPackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).RejectPackage(ctx, req.(*RejectPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_DeletePackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).DeletePackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_DeletePackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).DeletePackage(ctx, req.(*DeletePackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_RestorePackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).RestorePackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_RestorePackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).RestorePackage(ctx, req.(*RestorePackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_CreateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).CreateRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_CreateRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).CreateRelease(ctx, req.(*CreateReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_ListReleases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReleasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).ListReleases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_ListReleases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).ListReleases(ctx, req.(*ListReleasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_GetRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).GetRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_GetRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).GetRelease(ctx, req.(*GetReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_SyncPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).SyncPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_SyncPackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).SyncPackage(ctx, req.(*SyncPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_GetMaintainers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaintainersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).GetMaintainers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_GetMaintainers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).GetMaintainers(ctx, req.(*GetMaintainersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_AddMaintainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMaintainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).AddMaintainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_AddMaintainer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).AddMaintainer(ctx, req.(*AddMaintainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_RemoveMaintainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMaintainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).RemoveMaintainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_RemoveMaintainer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).RemoveMaintainer(ctx, req.(*RemoveMaintainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_TransferPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).TransferPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_TransferPackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).TransferPackage(ctx, req.(*TransferPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_AcceptPackageTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptPackageTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).AcceptPackageTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_AcceptPackageTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).AcceptPackageTransfer(ctx, req.(*AcceptPackageTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_DeclinePackageTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclinePackageTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).DeclinePackageTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_DeclinePackageTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).DeclinePackageTransfer(ctx, req.(*DeclinePackageTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_UploadPackageCover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadPackageCoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).UploadPackageCover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_UploadPackageCover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).UploadPackageCover(ctx, req.(*UploadPackageCoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_FavoritePackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoritePackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).FavoritePackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_FavoritePackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).FavoritePackage(ctx, req.(*FavoritePackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_UnfavoritePackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfavoritePackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).UnfavoritePackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_UnfavoritePackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).UnfavoritePackage(ctx, req.(*UnfavoritePackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_ListFavoritePackages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFavoritePackagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).ListFavoritePackages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_ListFavoritePackages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).ListFavoritePackages(ctx, req.(*ListFavoritePackagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).CreateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_CreateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).CreateReview(ctx, req.(*CreateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).UpdateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_UpdateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).UpdateReview(ctx, req.(*UpdateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_DeleteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).DeleteReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_DeleteReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).DeleteReview(ctx, req.(*DeleteReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_ListReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).ListReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_ListReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).ListReviews(ctx, req.(*ListReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).CreateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_CreateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).CreateComment(ctx, req.(*CreateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).UpdateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_UpdateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).UpdateComment(ctx, req.(*UpdateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackageService_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackageServiceServer).ListComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackageService_ListComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackageServiceServer).ListComments(ctx, req.(*ListCommentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -235,10 +1362,34 @@ var PackageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPackage",
 			Handler:    _PackageService_GetPackage_Handler,
 		},
+		{
+			MethodName: "GetPackageBySlug",
+			Handler:    _PackageService_GetPackageBySlug_Handler,
+		},
 		{
 			MethodName: "GetTags",
 			Handler:    _PackageService_GetTags_Handler,
 		},
+		{
+			MethodName: "CreateTag",
+			Handler:    _PackageService_CreateTag_Handler,
+		},
+		{
+			MethodName: "UpdateTag",
+			Handler:    _PackageService_UpdateTag_Handler,
+		},
+		{
+			MethodName: "MergeTags",
+			Handler:    _PackageService_MergeTags_Handler,
+		},
+		{
+			MethodName: "CreateTagAlias",
+			Handler:    _PackageService_CreateTagAlias_Handler,
+		},
+		{
+			MethodName: "DeleteTagAlias",
+			Handler:    _PackageService_DeleteTagAlias_Handler,
+		},
 		{
 			MethodName: "GetPackages",
 			Handler:    _PackageService_GetPackages_Handler,
@@ -251,6 +1402,114 @@ var PackageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePackage",
 			Handler:    _PackageService_UpdatePackage_Handler,
 		},
+		{
+			MethodName: "GetPendingPackages",
+			Handler:    _PackageService_GetPendingPackages_Handler,
+		},
+		{
+			MethodName: "ApprovePackage",
+			Handler:    _PackageService_ApprovePackage_Handler,
+		},
+		{
+			MethodName: "RejectPackage",
+			Handler:    _PackageService_RejectPackage_Handler,
+		},
+		{
+			MethodName: "DeletePackage",
+			Handler:    _PackageService_DeletePackage_Handler,
+		},
+		{
+			MethodName: "RestorePackage",
+			Handler:    _PackageService_RestorePackage_Handler,
+		},
+		{
+			MethodName: "CreateRelease",
+			Handler:    _PackageService_CreateRelease_Handler,
+		},
+		{
+			MethodName: "ListReleases",
+			Handler:    _PackageService_ListReleases_Handler,
+		},
+		{
+			MethodName: "GetRelease",
+			Handler:    _PackageService_GetRelease_Handler,
+		},
+		{
+			MethodName: "SyncPackage",
+			Handler:    _PackageService_SyncPackage_Handler,
+		},
+		{
+			MethodName: "GetMaintainers",
+			Handler:    _PackageService_GetMaintainers_Handler,
+		},
+		{
+			MethodName: "AddMaintainer",
+			Handler:    _PackageService_AddMaintainer_Handler,
+		},
+		{
+			MethodName: "RemoveMaintainer",
+			Handler:    _PackageService_RemoveMaintainer_Handler,
+		},
+		{
+			MethodName: "TransferPackage",
+			Handler:    _PackageService_TransferPackage_Handler,
+		},
+		{
+			MethodName: "AcceptPackageTransfer",
+			Handler:    _PackageService_AcceptPackageTransfer_Handler,
+		},
+		{
+			MethodName: "DeclinePackageTransfer",
+			Handler:    _PackageService_DeclinePackageTransfer_Handler,
+		},
+		{
+			MethodName: "UploadPackageCover",
+			Handler:    _PackageService_UploadPackageCover_Handler,
+		},
+		{
+			MethodName: "FavoritePackage",
+			Handler:    _PackageService_FavoritePackage_Handler,
+		},
+		{
+			MethodName: "UnfavoritePackage",
+			Handler:    _PackageService_UnfavoritePackage_Handler,
+		},
+		{
+			MethodName: "ListFavoritePackages",
+			Handler:    _PackageService_ListFavoritePackages_Handler,
+		},
+		{
+			MethodName: "CreateReview",
+			Handler:    _PackageService_CreateReview_Handler,
+		},
+		{
+			MethodName: "UpdateReview",
+			Handler:    _PackageService_UpdateReview_Handler,
+		},
+		{
+			MethodName: "DeleteReview",
+			Handler:    _PackageService_DeleteReview_Handler,
+		},
+		{
+			MethodName: "ListReviews",
+			Handler:    _PackageService_ListReviews_Handler,
+		},
+		{
+			MethodName: "CreateComment",
+			Handler:    _PackageService_CreateComment_Handler,
+		},
+		{
+			MethodName: "UpdateComment",
+			Handler:    _PackageService_UpdateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _PackageService_DeleteComment_Handler,
+		},
+		{
+			MethodName: "ListComments",
+			Handler:    _PackageService_ListComments_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "package/package.proto",
@@ -5,27 +5,65 @@ import (
 
 	"github.com/goravel/framework/facades"
 	"github.com/goravel/framework/http"
+	"github.com/spf13/cast"
 
 	protouser "market.goravel.dev/proto/user"
 	"market.goravel.dev/user/app/models"
 	"market.goravel.dev/user/app/services"
 	utilserrors "market.goravel.dev/utils/errors"
+	utilsetag "market.goravel.dev/utils/etag"
 	utilsresponse "market.goravel.dev/utils/response"
 )
 
 type UserController struct {
 	protouser.UnimplementedUserServiceServer
+	avatarService       services.Avatar
 	notificationService services.Notification
+	organizationService services.Organization
 	userService         services.User
 }
 
 func NewUserController() *UserController {
 	return &UserController{
+		avatarService:       services.NewAvatarImpl(),
 		notificationService: services.NewNotificationImpl(),
+		organizationService: services.NewOrganizationImpl(),
 		userService:         services.NewUserImpl(),
 	}
 }
 
+func (r *UserController) AcceptInvitation(ctx context.Context, req *protouser.AcceptInvitationRequest) (*protouser.AcceptInvitationResponse, error) {
+	if err := validateAcceptInvitationRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	member, err := r.organizationService.AcceptInvitation(ctx, req.GetUserId(), req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protouser.AcceptInvitationResponse{
+		Status: utilsresponse.NewOkStatus(),
+		Member: member.ToProto(),
+	}, nil
+}
+
+func (r *UserController) CreateOrganization(ctx context.Context, req *protouser.CreateOrganizationRequest) (*protouser.CreateOrganizationResponse, error) {
+	if err := validateCreateOrganizationRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	organization, err := r.organizationService.CreateOrganization(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protouser.CreateOrganizationResponse{
+		Status:       utilsresponse.NewOkStatus(),
+		Organization: organization.ToProto(),
+	}, nil
+}
+
 func (r *UserController) EmailLogin(ctx context.Context, req *protouser.EmailLoginRequest) (*protouser.EmailLoginResponse, error) {
 	if err := validateEmailLoginRequest(ctx, req); err != nil {
 		return nil, err
@@ -110,6 +148,60 @@ func (r *UserController) GetEmailRegisterCode(ctx context.Context, req *protouse
 	}, nil
 }
 
+// GetMember is used by the other services to check the role of a user in an organization, the member is empty if the
+// user isn't a member.
+func (r *UserController) GetMember(ctx context.Context, req *protouser.GetMemberRequest) (*protouser.GetMemberResponse, error) {
+	if err := validateGetMemberRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	member, err := r.organizationService.GetMember(req.GetOrganizationId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &protouser.GetMemberResponse{
+		Status: utilsresponse.NewOkStatus(),
+	}
+	if member.ID != 0 {
+		response.Member = member.ToProto()
+	}
+
+	return response, nil
+}
+
+func (r *UserController) GetOrganization(ctx context.Context, req *protouser.GetOrganizationRequest) (*protouser.GetOrganizationResponse, error) {
+	if req.GetId() == "" {
+		return nil, utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.id"))
+	}
+
+	organization, err := r.organizationService.GetOrganization(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protouser.GetOrganizationResponse{
+		Status:       utilsresponse.NewOkStatus(),
+		Organization: organization.ToProto(),
+	}, nil
+}
+
+func (r *UserController) GetPublicProfile(ctx context.Context, req *protouser.GetPublicProfileRequest) (*protouser.GetPublicProfileResponse, error) {
+	if req.GetId() == "" {
+		return nil, utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.id"))
+	}
+
+	user, err := r.userService.GetPublicProfile(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protouser.GetPublicProfileResponse{
+		Status:  utilsresponse.NewOkStatus(),
+		Profile: user.ToPublicProfile(),
+	}, nil
+}
+
 func (r *UserController) GetUser(ctx context.Context, req *protouser.GetUserRequest) (*protouser.GetUserResponse, error) {
 	userID := req.GetUserId()
 	if userID == "" {
@@ -172,11 +264,80 @@ func (r *UserController) GetUsers(_ context.Context, req *protouser.GetUsersRequ
 	}, nil
 }
 
+func (r *UserController) InviteMember(ctx context.Context, req *protouser.InviteMemberRequest) (*protouser.InviteMemberResponse, error) {
+	if err := validateInviteMemberRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	invitation, err := r.organizationService.InviteMember(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protouser.InviteMemberResponse{
+		Status:     utilsresponse.NewOkStatus(),
+		Invitation: invitation.ToProto(),
+	}, nil
+}
+
+func (r *UserController) ListMembers(ctx context.Context, req *protouser.ListMembersRequest) (*protouser.ListMembersResponse, error) {
+	if req.GetId() == "" {
+		return nil, utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.id"))
+	}
+
+	members, err := r.organizationService.ListMembers(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	membersProto := make([]*protouser.OrganizationMember, 0)
+	for _, member := range members {
+		membersProto = append(membersProto, member.ToProto())
+	}
+
+	return &protouser.ListMembersResponse{
+		Status:  utilsresponse.NewOkStatus(),
+		Members: membersProto,
+	}, nil
+}
+
+// ListUserOrganizations is used by the package service to let the members read the private packages of their
+// organizations.
+func (r *UserController) ListUserOrganizations(ctx context.Context, req *protouser.ListUserOrganizationsRequest) (*protouser.ListUserOrganizationsResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, utilserrors.NewBadRequest(facades.Lang(ctx).Get("required.user_id"))
+	}
+
+	organizationIDs, err := r.organizationService.ListUserOrganizations(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	organizationIDsProto := make([]string, len(organizationIDs))
+	for i, organizationID := range organizationIDs {
+		organizationIDsProto[i] = cast.ToString(organizationID)
+	}
+
+	return &protouser.ListUserOrganizationsResponse{
+		Status:          utilsresponse.NewOkStatus(),
+		OrganizationIds: organizationIDsProto,
+	}, nil
+}
+
 func (r *UserController) UpdateUser(ctx context.Context, req *protouser.UpdateUserRequest) (*protouser.UpdateUserResponse, error) {
 	if err := validateUpdateUserRequest(ctx, req); err != nil {
 		return nil, err
 	}
 
+	// The revision in the request takes precedence over the If-Match header.
+	if req.GetRevision() == 0 {
+		revision, ok := utilsetag.IfMatch(ctx)
+		if !ok {
+			return nil, utilserrors.NewBadRequest(facades.Lang(ctx).Get("invalid.if_match"))
+		}
+		req.Revision = revision
+	}
+
 	user, err := r.userService.UpdateUser(ctx, req)
 	if err != nil {
 		return nil, err
@@ -187,3 +348,19 @@ func (r *UserController) UpdateUser(ctx context.Context, req *protouser.UpdateUs
 		User:   user.ToProto(),
 	}, nil
 }
+
+func (r *UserController) UploadAvatar(ctx context.Context, req *protouser.UploadAvatarRequest) (*protouser.UploadAvatarResponse, error) {
+	if err := validateUploadAvatarRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	user, err := r.avatarService.UploadAvatar(ctx, req.GetId(), req.GetUserId(), req.GetImage())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protouser.UploadAvatarResponse{
+		Status: utilsresponse.NewOkStatus(),
+		User:   user.ToProto(),
+	}, nil
+}
@@ -25,9 +25,11 @@ type UserControllerSuite struct {
 	ctx                     context.Context
 	userController          *UserController
 	mockAuth                *mocksauth.Auth
+	mockAvatarService       *mocksservice.Avatar
 	mockHash                *mockshash.Hash
 	mockLang                *mockstranslation.Translator
 	mockNotificationService *mocksservice.Notification
+	mockOrganizationService *mocksservice.Organization
 	mockUserService         *mocksservice.User
 }
 
@@ -39,16 +41,140 @@ func (s *UserControllerSuite) SetupTest() {
 	s.ctx = context.Background()
 	mockFactory := testingmock.Factory()
 	s.mockAuth = mockFactory.Auth(http.Background())
+	s.mockAvatarService = &mocksservice.Avatar{}
 	s.mockHash = mockFactory.Hash()
 	s.mockLang = mockFactory.Lang(s.ctx)
 	s.mockNotificationService = &mocksservice.Notification{}
+	s.mockOrganizationService = &mocksservice.Organization{}
 	s.mockUserService = &mocksservice.User{}
 	s.userController = &UserController{
+		avatarService:       s.mockAvatarService,
 		notificationService: s.mockNotificationService,
+		organizationService: s.mockOrganizationService,
 		userService:         s.mockUserService,
 	}
 }
 
+func (s *UserControllerSuite) TestAcceptInvitation() {
+	tests := []struct {
+		name             string
+		request          *protouser.AcceptInvitationRequest
+		setup            func()
+		expectedResponse *protouser.AcceptInvitationResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: &protouser.AcceptInvitationRequest{UserId: "1", Token: "token"},
+			setup: func() {
+				s.mockOrganizationService.On("AcceptInvitation", s.ctx, "1", "token").Return(&models.OrganizationMember{
+					OrganizationID: 2,
+					UserID:         1,
+					Role:           models.OrganizationRoleMember,
+				}, nil).Once()
+			},
+			expectedResponse: &protouser.AcceptInvitationResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Member: &protouser.OrganizationMember{
+					OrganizationId: "2",
+					UserId:         "1",
+					Role:           models.OrganizationRoleMember,
+				},
+			},
+		},
+		{
+			name:    "Sad path - AcceptInvitation returns error",
+			request: &protouser.AcceptInvitationRequest{UserId: "1", Token: "token"},
+			setup: func() {
+				s.mockOrganizationService.On("AcceptInvitation", s.ctx, "1", "token").Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			name:    "Sad path - Request validation error",
+			request: &protouser.AcceptInvitationRequest{UserId: "1"},
+			setup: func() {
+				s.mockLang.On("Get", "required.token").Return("token is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("token is required"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.userController.AcceptInvitation(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockOrganizationService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *UserControllerSuite) TestCreateOrganization() {
+	request := &protouser.CreateOrganizationRequest{UserId: "1", Name: "goravel"}
+
+	tests := []struct {
+		name             string
+		request          *protouser.CreateOrganizationRequest
+		setup            func()
+		expectedResponse *protouser.CreateOrganizationResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: request,
+			setup: func() {
+				s.mockOrganizationService.On("CreateOrganization", s.ctx, request).Return(&models.Organization{
+					UUIDModel: models.UUIDModel{
+						ID: 2,
+					},
+					Name:        "goravel",
+					DisplayName: "goravel",
+				}, nil).Once()
+			},
+			expectedResponse: &protouser.CreateOrganizationResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Organization: &protouser.Organization{
+					Id:          "2",
+					Name:        "goravel",
+					DisplayName: "goravel",
+				},
+			},
+		},
+		{
+			name:    "Sad path - CreateOrganization returns error",
+			request: request,
+			setup: func() {
+				s.mockOrganizationService.On("CreateOrganization", s.ctx, request).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			name:    "Sad path - Request validation error",
+			request: &protouser.CreateOrganizationRequest{UserId: "1", Name: "-goravel"},
+			setup: func() {
+				s.mockLang.On("Get", "invalid.organization_name").Return("name is invalid").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("name is invalid"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.userController.CreateOrganization(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockOrganizationService.AssertExpectations(s.T())
+		})
+	}
+}
+
 func (s *UserControllerSuite) TestEmailLogin() {
 	var (
 		email          = "hello@goravel.dev"
@@ -482,6 +608,192 @@ func (s *UserControllerSuite) TestGetEmailRegisterCode() {
 	}
 }
 
+func (s *UserControllerSuite) TestGetMember() {
+	request := &protouser.GetMemberRequest{OrganizationId: "2", UserId: "1"}
+
+	tests := []struct {
+		name             string
+		setup            func()
+		expectedResponse *protouser.GetMemberResponse
+		expectedErr      error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrganizationService.On("GetMember", "2", "1").Return(&models.OrganizationMember{
+					UUIDModel:      models.UUIDModel{ID: 1},
+					OrganizationID: 2,
+					UserID:         1,
+					Role:           models.OrganizationRoleAdmin,
+				}, nil).Once()
+			},
+			expectedResponse: &protouser.GetMemberResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Member: &protouser.OrganizationMember{
+					OrganizationId: "2",
+					UserId:         "1",
+					Role:           models.OrganizationRoleAdmin,
+				},
+			},
+		},
+		{
+			name: "Happy path - user isn't a member",
+			setup: func() {
+				s.mockOrganizationService.On("GetMember", "2", "1").Return(&models.OrganizationMember{}, nil).Once()
+			},
+			expectedResponse: &protouser.GetMemberResponse{
+				Status: utilsresponse.NewOkStatus(),
+			},
+		},
+		{
+			name: "Sad path - GetMember returns error",
+			setup: func() {
+				s.mockOrganizationService.On("GetMember", "2", "1").Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.userController.GetMember(s.ctx, request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockOrganizationService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *UserControllerSuite) TestListUserOrganizations() {
+	tests := []struct {
+		name             string
+		request          *protouser.ListUserOrganizationsRequest
+		setup            func()
+		expectedResponse *protouser.ListUserOrganizationsResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: &protouser.ListUserOrganizationsRequest{UserId: "1"},
+			setup: func() {
+				s.mockOrganizationService.On("ListUserOrganizations", "1").Return([]uint64{2, 3}, nil).Once()
+			},
+			expectedResponse: &protouser.ListUserOrganizationsResponse{
+				Status:          utilsresponse.NewOkStatus(),
+				OrganizationIds: []string{"2", "3"},
+			},
+		},
+		{
+			name:    "Happy path - user isn't a member of any organization",
+			request: &protouser.ListUserOrganizationsRequest{UserId: "1"},
+			setup: func() {
+				s.mockOrganizationService.On("ListUserOrganizations", "1").Return(nil, nil).Once()
+			},
+			expectedResponse: &protouser.ListUserOrganizationsResponse{
+				Status:          utilsresponse.NewOkStatus(),
+				OrganizationIds: []string{},
+			},
+		},
+		{
+			name:    "Sad path - UserId is empty",
+			request: &protouser.ListUserOrganizationsRequest{},
+			setup: func() {
+				s.mockLang.On("Get", "required.user_id").Return("user_id is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("user_id is required"),
+		},
+		{
+			name:    "Sad path - ListUserOrganizations returns error",
+			request: &protouser.ListUserOrganizationsRequest{UserId: "1"},
+			setup: func() {
+				s.mockOrganizationService.On("ListUserOrganizations", "1").Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.userController.ListUserOrganizations(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockOrganizationService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *UserControllerSuite) TestGetPublicProfile() {
+	tests := []struct {
+		name             string
+		request          *protouser.GetPublicProfileRequest
+		setup            func()
+		expectedResponse *protouser.GetPublicProfileResponse
+		expectedErr      error
+	}{
+		{
+			name: "Happy path",
+			request: &protouser.GetPublicProfileRequest{
+				Id: "goravel",
+			},
+			setup: func() {
+				s.mockUserService.On("GetPublicProfile", s.ctx, "goravel").Return(&models.User{
+					UUIDModel: models.UUIDModel{
+						ID: 1,
+					},
+					Name:     "Goravel",
+					Email:    "hello@goravel.dev",
+					Username: "goravel",
+				}, nil).Once()
+			},
+			expectedResponse: &protouser.GetPublicProfileResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Profile: &protouser.PublicProfile{
+					Id:       "1",
+					Username: "goravel",
+					Name:     "Goravel",
+				},
+			},
+		},
+		{
+			name: "Sad path - id is empty",
+			request: &protouser.GetPublicProfileRequest{
+				Id: "",
+			},
+			setup: func() {
+				s.mockLang.On("Get", "required.id").Return("required id").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("required id"),
+		},
+		{
+			name: "Sad path - GetPublicProfile returns error",
+			request: &protouser.GetPublicProfileRequest{
+				Id: "goravel",
+			},
+			setup: func() {
+				s.mockUserService.On("GetPublicProfile", s.ctx, "goravel").Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.userController.GetPublicProfile(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockUserService.AssertExpectations(s.T())
+		})
+	}
+}
+
 func (s *UserControllerSuite) TestGetUser() {
 	var (
 		userID = "1"
@@ -626,6 +938,141 @@ func (s *UserControllerSuite) TestGetUsers() {
 	}
 }
 
+func (s *UserControllerSuite) TestInviteMember() {
+	request := &protouser.InviteMemberRequest{UserId: "1", Id: "2", Email: "hello@goravel.dev", Role: models.OrganizationRoleAdmin}
+
+	tests := []struct {
+		name             string
+		request          *protouser.InviteMemberRequest
+		setup            func()
+		expectedResponse *protouser.InviteMemberResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: request,
+			setup: func() {
+				s.mockOrganizationService.On("InviteMember", s.ctx, request).Return(&models.OrganizationInvitation{
+					UUIDModel:      models.UUIDModel{ID: 1},
+					OrganizationID: 2,
+					Email:          "hello@goravel.dev",
+					Role:           models.OrganizationRoleAdmin,
+				}, nil).Once()
+			},
+			expectedResponse: &protouser.InviteMemberResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Invitation: &protouser.OrganizationInvitation{
+					Id:             "1",
+					OrganizationId: "2",
+					Email:          "hello@goravel.dev",
+					Role:           models.OrganizationRoleAdmin,
+				},
+			},
+		},
+		{
+			name:    "Sad path - InviteMember returns error",
+			request: request,
+			setup: func() {
+				s.mockOrganizationService.On("InviteMember", s.ctx, request).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			name:    "Sad path - Request validation error",
+			request: &protouser.InviteMemberRequest{UserId: "1", Id: "2", Email: "hello@goravel.dev", Role: models.OrganizationRoleOwner},
+			setup: func() {
+				s.mockLang.On("Get", "invalid.role").Return("role is invalid").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("role is invalid"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.userController.InviteMember(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockOrganizationService.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *UserControllerSuite) TestListMembers() {
+	tests := []struct {
+		name             string
+		request          *protouser.ListMembersRequest
+		setup            func()
+		expectedResponse *protouser.ListMembersResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: &protouser.ListMembersRequest{Id: "2"},
+			setup: func() {
+				s.mockOrganizationService.On("ListMembers", s.ctx, "2").Return([]*models.OrganizationMember{
+					{
+						UUIDModel:      models.UUIDModel{ID: 1},
+						OrganizationID: 2,
+						UserID:         1,
+						Role:           models.OrganizationRoleOwner,
+						User: &models.User{
+							UUIDModel: models.UUIDModel{
+								ID: 1,
+							},
+							Name: "Goravel",
+						},
+					},
+				}, nil).Once()
+			},
+			expectedResponse: &protouser.ListMembersResponse{
+				Status: utilsresponse.NewOkStatus(),
+				Members: []*protouser.OrganizationMember{
+					{
+						OrganizationId: "2",
+						UserId:         "1",
+						Role:           models.OrganizationRoleOwner,
+						User: &protouser.User{
+							Id:   "1",
+							Name: "Goravel",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "Sad path - ListMembers returns error",
+			request: &protouser.ListMembersRequest{Id: "2"},
+			setup: func() {
+				s.mockOrganizationService.On("ListMembers", s.ctx, "2").Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			name:    "Sad path - id is empty",
+			request: &protouser.ListMembersRequest{},
+			setup: func() {
+				s.mockLang.On("Get", "required.id").Return("id is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("id is required"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.userController.ListMembers(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockOrganizationService.AssertExpectations(s.T())
+		})
+	}
+}
+
 func (s *UserControllerSuite) TestUpdateUser() {
 	var (
 		id     = "1"
@@ -705,3 +1152,71 @@ func (s *UserControllerSuite) TestUpdateUser() {
 		})
 	}
 }
+
+func (s *UserControllerSuite) TestUploadAvatar() {
+	var (
+		id              = "1"
+		userID          = "1"
+		image           = []byte("image")
+		avatar          = "https://goravel.dev/storage/avatars/1/a.png"
+		avatarThumbnail = "https://goravel.dev/storage/avatars/1/a_thumb.png"
+	)
+
+	tests := []struct {
+		name             string
+		request          *protouser.UploadAvatarRequest
+		setup            func()
+		expectedResponse *protouser.UploadAvatarResponse
+		expectedErr      error
+	}{
+		{
+			name:    "Happy path",
+			request: &protouser.UploadAvatarRequest{Id: id, UserId: userID, Image: image},
+			setup: func() {
+				s.mockAvatarService.On("UploadAvatar", s.ctx, id, userID, image).Return(&models.User{
+					UUIDModel: models.UUIDModel{
+						ID: 1,
+					},
+					Avatar:          avatar,
+					AvatarThumbnail: avatarThumbnail,
+				}, nil).Once()
+			},
+			expectedResponse: &protouser.UploadAvatarResponse{
+				Status: utilsresponse.NewOkStatus(),
+				User: &protouser.User{
+					Id:              id,
+					Avatar:          avatar,
+					AvatarThumbnail: avatarThumbnail,
+				},
+			},
+		},
+		{
+			name:    "Sad path - UploadAvatar returns error",
+			request: &protouser.UploadAvatarRequest{Id: id, UserId: userID, Image: image},
+			setup: func() {
+				s.mockAvatarService.On("UploadAvatar", s.ctx, id, userID, image).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			name:    "Sad path - Request validation error",
+			request: &protouser.UploadAvatarRequest{Id: id, UserId: userID},
+			setup: func() {
+				s.mockLang.On("Get", "required.image").Return("image is required").Once()
+			},
+			expectedErr: utilserrors.NewBadRequest("image is required"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+			response, err := s.userController.UploadAvatar(s.ctx, test.request)
+			s.Equal(test.expectedResponse, response)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockAvatarService.AssertExpectations(s.T())
+		})
+	}
+}
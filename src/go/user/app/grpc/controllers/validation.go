@@ -8,9 +8,70 @@ import (
 	"github.com/goravel/framework/facades"
 
 	protouser "market.goravel.dev/proto/user"
+	"market.goravel.dev/user/app/models"
 	utilserrors "market.goravel.dev/utils/errors"
+	utilsfieldmask "market.goravel.dev/utils/fieldmask"
 )
 
+// organizationNamePattern matches the names of organizations, they are used in URLs.
+var organizationNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// updateUserFields are the fields of UpdateUserRequest that can be set in its update mask.
+var updateUserFields = []string{"name", "password", "avatar", "summary", "username"}
+
+// usernamePattern matches the usernames, they start with a letter so they can't be mistaken for IDs.
+var usernamePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]{2,29}$`)
+
+func validateAcceptInvitationRequest(ctx context.Context, req *protouser.AcceptInvitationRequest) error {
+	translate := facades.Lang(ctx)
+	if req.GetUserId() == "" {
+		return utilserrors.NewBadRequest(translate.Get("required.user_id"))
+	}
+	if req.GetToken() == "" {
+		return utilserrors.NewBadRequest(translate.Get("required.token"))
+	}
+
+	return nil
+}
+
+func validateCreateOrganizationRequest(ctx context.Context, req *protouser.CreateOrganizationRequest) error {
+	name := req.GetName()
+
+	translate := facades.Lang(ctx)
+	if req.GetUserId() == "" {
+		return utilserrors.NewBadRequest(translate.Get("required.user_id"))
+	}
+	if name == "" {
+		return utilserrors.NewBadRequest(translate.Get("required.name"))
+	}
+	if len(name) > 50 {
+		return utilserrors.NewBadRequest(translate.Get("invalid.name.max", translation.Option{
+			Replace: map[string]string{
+				"max": "50",
+			},
+		}))
+	}
+	if !organizationNamePattern.MatchString(name) {
+		return utilserrors.NewBadRequest(translate.Get("invalid.organization_name"))
+	}
+	if len(req.GetDisplayName()) > 50 {
+		return utilserrors.NewBadRequest(translate.Get("invalid.name.max", translation.Option{
+			Replace: map[string]string{
+				"max": "50",
+			},
+		}))
+	}
+	if len(req.GetSummary()) > 200 {
+		return utilserrors.NewBadRequest(translate.Get("invalid.summery.max", translation.Option{
+			Replace: map[string]string{
+				"max": "200",
+			},
+		}))
+	}
+
+	return nil
+}
+
 func validateEmailLoginRequest(ctx context.Context, req *protouser.EmailLoginRequest) error {
 	if err := validateEmailValid(ctx, req.GetEmail()); err != nil {
 		return err
@@ -63,10 +124,42 @@ func validateGetEmailRegisterCodeRequest(ctx context.Context, req *protouser.Get
 	return validateEmailValid(ctx, req.GetEmail())
 }
 
+func validateGetMemberRequest(ctx context.Context, req *protouser.GetMemberRequest) error {
+	translate := facades.Lang(ctx)
+	if req.GetOrganizationId() == "" {
+		return utilserrors.NewBadRequest(translate.Get("required.organization_id"))
+	}
+	if req.GetUserId() == "" {
+		return utilserrors.NewBadRequest(translate.Get("required.user_id"))
+	}
+
+	return nil
+}
+
+func validateInviteMemberRequest(ctx context.Context, req *protouser.InviteMemberRequest) error {
+	translate := facades.Lang(ctx)
+	if req.GetId() == "" {
+		return utilserrors.NewBadRequest(translate.Get("required.id"))
+	}
+	if req.GetUserId() == "" {
+		return utilserrors.NewBadRequest(translate.Get("required.user_id"))
+	}
+	if err := validateEmailValid(ctx, req.GetEmail()); err != nil {
+		return err
+	}
+	// The owner can't be invited, an organization has a single owner.
+	if role := req.GetRole(); role != "" && role != models.OrganizationRoleAdmin && role != models.OrganizationRoleMember {
+		return utilserrors.NewBadRequest(translate.Get("invalid.role"))
+	}
+
+	return nil
+}
+
 func validateUpdateUserRequest(ctx context.Context, req *protouser.UpdateUserRequest) error {
 	name := req.GetName()
 	summery := req.GetSummary()
 	password := req.GetPassword()
+	username := req.GetUsername()
 	userID := req.GetUserId()
 	id := req.GetId()
 
@@ -77,18 +170,31 @@ func validateUpdateUserRequest(ctx context.Context, req *protouser.UpdateUserReq
 	if userID == "" {
 		return utilserrors.NewBadRequest(translate.Get("required.user_id"))
 	}
-	if name == "" {
-		return utilserrors.NewBadRequest(translate.Get("required.name"))
-	}
-	if len(name) > 50 {
-		return utilserrors.NewBadRequest(translate.Get("invalid.name.max", translation.Option{
+
+	if field := utilsfieldmask.Unknown(req.GetUpdateMask(), updateUserFields); field != "" {
+		return utilserrors.NewBadRequest(translate.Get("invalid.update_mask", translation.Option{
 			Replace: map[string]string{
-				"max": "50",
+				"field": field,
 			},
 		}))
 	}
 
-	if len(summery) > 200 {
+	// Only the fields in the update mask are validated, all of them are validated without a mask.
+	fields := utilsfieldmask.New(req.GetUpdateMask())
+	if fields.Has("name") {
+		if name == "" {
+			return utilserrors.NewBadRequest(translate.Get("required.name"))
+		}
+		if len(name) > 50 {
+			return utilserrors.NewBadRequest(translate.Get("invalid.name.max", translation.Option{
+				Replace: map[string]string{
+					"max": "50",
+				},
+			}))
+		}
+	}
+
+	if fields.Has("summary") && len(summery) > 200 {
 		return utilserrors.NewBadRequest(translate.Get("invalid.summery.max", translation.Option{
 			Replace: map[string]string{
 				"max": "200",
@@ -96,7 +202,11 @@ func validateUpdateUserRequest(ctx context.Context, req *protouser.UpdateUserReq
 		}))
 	}
 
-	if password != "" {
+	if fields.Has("username") && username != "" && !usernamePattern.MatchString(username) {
+		return utilserrors.NewBadRequest(translate.Get("invalid.username"))
+	}
+
+	if fields.Has("password") && password != "" {
 		if len(password) < 6 {
 			return utilserrors.NewBadRequest(translate.Get("invalid.password.min"))
 		}
@@ -112,3 +222,18 @@ func validateUpdateUserRequest(ctx context.Context, req *protouser.UpdateUserReq
 
 	return nil
 }
+
+func validateUploadAvatarRequest(ctx context.Context, req *protouser.UploadAvatarRequest) error {
+	translate := facades.Lang(ctx)
+	if req.GetId() == "" {
+		return utilserrors.NewBadRequest(translate.Get("required.id"))
+	}
+	if req.GetUserId() == "" {
+		return utilserrors.NewBadRequest(translate.Get("required.user_id"))
+	}
+	if len(req.GetImage()) == 0 {
+		return utilserrors.NewBadRequest(translate.Get("required.image"))
+	}
+
+	return nil
+}
@@ -9,11 +9,87 @@ import (
 	testingmock "github.com/goravel/framework/testing/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	protouser "market.goravel.dev/proto/user"
 	utilserrors "market.goravel.dev/utils/errors"
 )
 
+func TestValidateCreateOrganizationRequest(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		mockLang *mockstranslation.Translator
+	)
+
+	beforeEach := func() {
+		mockFactory := testingmock.Factory()
+		mockLang = mockFactory.Lang(ctx)
+	}
+
+	tests := []struct {
+		name      string
+		request   *protouser.CreateOrganizationRequest
+		setup     func()
+		expectErr error
+	}{
+		{
+			name:    "Happy path",
+			request: &protouser.CreateOrganizationRequest{UserId: "1", Name: "goravel-2"},
+			setup:   func() {},
+		},
+		{
+			name:    "Sad path - user_id is empty",
+			request: &protouser.CreateOrganizationRequest{Name: "goravel"},
+			setup: func() {
+				mockLang.On("Get", "required.user_id").Return("user_id is required").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("user_id is required"),
+		},
+		{
+			name:    "Sad path - name is empty",
+			request: &protouser.CreateOrganizationRequest{UserId: "1"},
+			setup: func() {
+				mockLang.On("Get", "required.name").Return("name is required").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("name is required"),
+		},
+		{
+			name:    "Sad path - name is too long",
+			request: &protouser.CreateOrganizationRequest{UserId: "1", Name: str.Random(51)},
+			setup: func() {
+				mockLang.On("Get", "invalid.name.max", mock.Anything).Return("name is too long").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("name is too long"),
+		},
+		{
+			name:    "Sad path - name contains uppercase letters",
+			request: &protouser.CreateOrganizationRequest{UserId: "1", Name: "Goravel"},
+			setup: func() {
+				mockLang.On("Get", "invalid.organization_name").Return("name is invalid").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("name is invalid"),
+		},
+		{
+			name:    "Sad path - summary is too long",
+			request: &protouser.CreateOrganizationRequest{UserId: "1", Name: "goravel", Summary: str.Random(201)},
+			setup: func() {
+				mockLang.On("Get", "invalid.summery.max", mock.Anything).Return("summary is too long").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("summary is too long"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			beforeEach()
+			test.setup()
+			assert.Equal(t, test.expectErr, validateCreateOrganizationRequest(ctx, test.request))
+
+			mockLang.AssertExpectations(t)
+		})
+	}
+}
+
 func TestValidateEmailLoginRequest(t *testing.T) {
 	var (
 		ctx      = context.Background()
@@ -308,6 +384,51 @@ func TestValidateUpdateUserRequest(t *testing.T) {
 			},
 			expectErr: utilserrors.NewBadRequest("user id is required"),
 		},
+		{
+			name: "Happy path - only the masked fields are validated",
+			request: &protouser.UpdateUserRequest{
+				Id:         id,
+				UserId:     userID,
+				Summary:    summary,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"summary"}},
+			},
+			setup: func() {},
+		},
+		{
+			name: "Unknown mask field",
+			request: &protouser.UpdateUserRequest{
+				Id:         id,
+				UserId:     userID,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"email"}},
+			},
+			setup: func() {
+				mockLang.On("Get", "invalid.update_mask", mock.Anything).Return("email can't be updated").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("email can't be updated"),
+		},
+		{
+			name: "Happy path - valid username",
+			request: &protouser.UpdateUserRequest{
+				Id:       id,
+				UserId:   userID,
+				Name:     name,
+				Username: "Goravel_dev",
+			},
+			setup: func() {},
+		},
+		{
+			name: "Invalid username",
+			request: &protouser.UpdateUserRequest{
+				Id:       id,
+				UserId:   userID,
+				Name:     name,
+				Username: "1goravel",
+			},
+			setup: func() {
+				mockLang.On("Get", "invalid.username").Return("username is invalid").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("username is invalid"),
+		},
 		{
 			name: "Empty name",
 			request: &protouser.UpdateUserRequest{
@@ -383,3 +504,67 @@ func TestValidateUpdateUserRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateInviteMemberRequest(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		mockLang *mockstranslation.Translator
+	)
+
+	beforeEach := func() {
+		mockFactory := testingmock.Factory()
+		mockLang = mockFactory.Lang(ctx)
+	}
+
+	tests := []struct {
+		name      string
+		request   *protouser.InviteMemberRequest
+		setup     func()
+		expectErr error
+	}{
+		{
+			name:    "Happy path",
+			request: &protouser.InviteMemberRequest{UserId: "1", Id: "2", Email: "hello@goravel.dev"},
+			setup:   func() {},
+		},
+		{
+			name:    "Happy path - invite an admin",
+			request: &protouser.InviteMemberRequest{UserId: "1", Id: "2", Email: "hello@goravel.dev", Role: "admin"},
+			setup:   func() {},
+		},
+		{
+			name:    "Sad path - id is empty",
+			request: &protouser.InviteMemberRequest{UserId: "1", Email: "hello@goravel.dev"},
+			setup: func() {
+				mockLang.On("Get", "required.id").Return("id is required").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("id is required"),
+		},
+		{
+			name:    "Sad path - email is invalid",
+			request: &protouser.InviteMemberRequest{UserId: "1", Id: "2", Email: "goravel"},
+			setup: func() {
+				mockLang.On("Get", "invalid.email").Return("email is invalid").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("email is invalid"),
+		},
+		{
+			name:    "Sad path - invite an owner",
+			request: &protouser.InviteMemberRequest{UserId: "1", Id: "2", Email: "hello@goravel.dev", Role: "owner"},
+			setup: func() {
+				mockLang.On("Get", "invalid.role").Return("role is invalid").Once()
+			},
+			expectErr: utilserrors.NewBadRequest("role is invalid"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			beforeEach()
+			test.setup()
+			assert.Equal(t, test.expectErr, validateInviteMemberRequest(ctx, test.request))
+
+			mockLang.AssertExpectations(t)
+		})
+	}
+}
@@ -130,6 +130,61 @@ func (_c *UserInterface_GetUserByID_Call) RunAndReturn(run func(string, []string
 	return _c
 }
 
+// GetUserByUsername provides a mock function with given fields: username, fields
+func (_m *UserInterface) GetUserByUsername(username string, fields []string) (*models.User, error) {
+	ret := _m.Called(username, fields)
+
+	var r0 *models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []string) (*models.User, error)); ok {
+		return rf(username, fields)
+	}
+	if rf, ok := ret.Get(0).(func(string, []string) *models.User); ok {
+		r0 = rf(username, fields)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []string) error); ok {
+		r1 = rf(username, fields)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserInterface_GetUserByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByUsername'
+type UserInterface_GetUserByUsername_Call struct {
+	*mock.Call
+}
+
+// GetUserByUsername is a helper method to define mock.On call
+//   - username string
+//   - fields []string
+func (_e *UserInterface_Expecter) GetUserByUsername(username interface{}, fields interface{}) *UserInterface_GetUserByUsername_Call {
+	return &UserInterface_GetUserByUsername_Call{Call: _e.mock.On("GetUserByUsername", username, fields)}
+}
+
+func (_c *UserInterface_GetUserByUsername_Call) Run(run func(username string, fields []string)) *UserInterface_GetUserByUsername_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *UserInterface_GetUserByUsername_Call) Return(_a0 *models.User, _a1 error) *UserInterface_GetUserByUsername_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserInterface_GetUserByUsername_Call) RunAndReturn(run func(string, []string) (*models.User, error)) *UserInterface_GetUserByUsername_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUsers provides a mock function with given fields: ids, fields
 func (_m *UserInterface) GetUsers(ids []string, fields []string) ([]*models.User, error) {
 	ret := _m.Called(ids, fields)
@@ -74,6 +74,51 @@ func (_c *Notification_SendEmailRegisterCode_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// SendOrganizationInvitation provides a mock function with given fields: ctx, email, organization, url
+func (_m *Notification) SendOrganizationInvitation(ctx context.Context, email string, organization string, url string) error {
+	ret := _m.Called(ctx, email, organization, url)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, email, organization, url)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Notification_SendOrganizationInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendOrganizationInvitation'
+type Notification_SendOrganizationInvitation_Call struct {
+	*mock.Call
+}
+
+// SendOrganizationInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+//   - organization string
+//   - url string
+func (_e *Notification_Expecter) SendOrganizationInvitation(ctx interface{}, email interface{}, organization interface{}, url interface{}) *Notification_SendOrganizationInvitation_Call {
+	return &Notification_SendOrganizationInvitation_Call{Call: _e.mock.On("SendOrganizationInvitation", ctx, email, organization, url)}
+}
+
+func (_c *Notification_SendOrganizationInvitation_Call) Run(run func(ctx context.Context, email string, organization string, url string)) *Notification_SendOrganizationInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *Notification_SendOrganizationInvitation_Call) Return(_a0 error) *Notification_SendOrganizationInvitation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Notification_SendOrganizationInvitation_Call) RunAndReturn(run func(context.Context, string, string, string) error) *Notification_SendOrganizationInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // VerifyEmailRegisterCode provides a mock function with given fields: key, code
 func (_m *Notification) VerifyEmailRegisterCode(key string, code string) bool {
 	ret := _m.Called(key, code)
@@ -24,6 +24,61 @@ func (_m *User) EXPECT() *User_Expecter {
 	return &User_Expecter{mock: &_m.Mock}
 }
 
+// GetPublicProfile provides a mock function with given fields: ctx, id
+func (_m *User) GetPublicProfile(ctx context.Context, id string) (*models.User, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.User, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.User); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// User_GetPublicProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPublicProfile'
+type User_GetPublicProfile_Call struct {
+	*mock.Call
+}
+
+// GetPublicProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *User_Expecter) GetPublicProfile(ctx interface{}, id interface{}) *User_GetPublicProfile_Call {
+	return &User_GetPublicProfile_Call{Call: _e.mock.On("GetPublicProfile", ctx, id)}
+}
+
+func (_c *User_GetPublicProfile_Call) Run(run func(ctx context.Context, id string)) *User_GetPublicProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *User_GetPublicProfile_Call) Return(_a0 *models.User, _a1 error) *User_GetPublicProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *User_GetPublicProfile_Call) RunAndReturn(run func(context.Context, string) (*models.User, error)) *User_GetPublicProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserByEmail provides a mock function with given fields: email
 func (_m *User) GetUserByEmail(email string) (*models.User, error) {
 	ret := _m.Called(email)
@@ -0,0 +1,263 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	stderrors "errors"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/database/orm"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/support/carbon"
+	"github.com/spf13/cast"
+
+	protouser "market.goravel.dev/proto/user"
+	utilserrors "market.goravel.dev/utils/errors"
+)
+
+const (
+	OrganizationRoleOwner  = "owner"
+	OrganizationRoleAdmin  = "admin"
+	OrganizationRoleMember = "member"
+)
+
+var (
+	// ErrDuplicateOrganizationName is returned when another organization has the same name.
+	ErrDuplicateOrganizationName = stderrors.New("duplicate organization name")
+	// ErrDuplicateMember is returned when the user is a member of the organization already.
+	ErrDuplicateMember = stderrors.New("duplicate organization member")
+	// ErrInvitationAccepted is returned when the invitation was accepted by another request.
+	ErrInvitationAccepted = stderrors.New("organization invitation accepted")
+)
+
+type OrganizationInterface interface {
+	AcceptInvitation(invitation *OrganizationInvitation, userID uint64) (*OrganizationMember, error)
+	CreateInvitation(invitation *OrganizationInvitation) error
+	CreateOrganization(organization *Organization, ownerID uint64) error
+	DeleteInvitation(invitation *OrganizationInvitation) error
+	GetInvitationByToken(token string) (*OrganizationInvitation, error)
+	GetMember(organizationID, userID uint64) (*OrganizationMember, error)
+	GetMembers(organizationID uint64) ([]*OrganizationMember, error)
+	GetOrganizationByID(id string) (*Organization, error)
+	GetUserOrganizationIDs(userID uint64) ([]uint64, error)
+}
+
+// Organization publishes packages under its name, the packages keep the ID of the organization.
+type Organization struct {
+	UUIDModel
+	Name        string
+	DisplayName string
+	Avatar      string
+	Summary     string
+	orm.SoftDeletes
+}
+
+func NewOrganization() *Organization {
+	return &Organization{}
+}
+
+// AcceptInvitation marks the invitation as accepted and adds the user to the organization with the role of the
+// invitation. ErrInvitationAccepted is returned if the invitation was accepted meanwhile, ErrDuplicateMember if the
+// user is a member already, nothing is changed in both cases.
+func (r *Organization) AcceptInvitation(invitation *OrganizationInvitation, userID uint64) (*OrganizationMember, error) {
+	member := &OrganizationMember{
+		OrganizationID: invitation.OrganizationID,
+		UserID:         userID,
+		Role:           invitation.Role,
+	}
+	member.ID = member.GetID()
+
+	if err := facades.Orm().Transaction(func(tx contractsorm.Transaction) error {
+		now := carbon.DateTime{Carbon: carbon.Now()}
+		result, err := tx.Exec("UPDATE organization_invitations SET accepted_at = ?, updated_at = ? WHERE id = ? AND accepted_at IS NULL", now, now, invitation.ID)
+		if err != nil {
+			return utilserrors.NewInternalServerError(err)
+		}
+		if result.RowsAffected == 0 {
+			return ErrInvitationAccepted
+		}
+
+		if err := tx.Create(member); err != nil {
+			if isUniqueViolation(err) {
+				return ErrDuplicateMember
+			}
+
+			return utilserrors.NewInternalServerError(err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+func (r *Organization) CreateInvitation(invitation *OrganizationInvitation) error {
+	invitation.ID = invitation.GetID()
+	if err := facades.Orm().Query().Create(invitation); err != nil {
+		return utilserrors.NewInternalServerError(err)
+	}
+
+	return nil
+}
+
+// CreateOrganization creates the organization with the user as its owner, ErrDuplicateOrganizationName is returned if
+// the name is taken.
+func (r *Organization) CreateOrganization(organization *Organization, ownerID uint64) error {
+	organization.ID = r.GetID()
+
+	return facades.Orm().Transaction(func(tx contractsorm.Transaction) error {
+		if err := tx.Create(organization); err != nil {
+			if isUniqueViolation(err) {
+				return ErrDuplicateOrganizationName
+			}
+
+			return utilserrors.NewInternalServerError(err)
+		}
+
+		owner := &OrganizationMember{OrganizationID: organization.ID, UserID: ownerID, Role: OrganizationRoleOwner}
+		owner.ID = owner.GetID()
+		if err := tx.Create(owner); err != nil {
+			return utilserrors.NewInternalServerError(err)
+		}
+
+		return nil
+	})
+}
+
+// DeleteInvitation deletes the invitation, it's used to drop the invitation whose email failed to be sent.
+func (r *Organization) DeleteInvitation(invitation *OrganizationInvitation) error {
+	if _, err := facades.Orm().Query().Delete(invitation); err != nil {
+		return utilserrors.NewInternalServerError(err)
+	}
+
+	return nil
+}
+
+// GetInvitationByToken gets the invitation sent with the token, the ID of the returned invitation is 0 if there is no
+// such invitation.
+func (r *Organization) GetInvitationByToken(token string) (*OrganizationInvitation, error) {
+	var invitation OrganizationInvitation
+	if err := facades.Orm().Query().Where("token_hash", HashInvitationToken(token)).First(&invitation); err != nil {
+		return nil, utilserrors.NewInternalServerError(err)
+	}
+
+	return &invitation, nil
+}
+
+// GetMember gets the user in the members of the organization, the ID of the returned member is 0 if the user isn't a
+// member of the organization.
+func (r *Organization) GetMember(organizationID, userID uint64) (*OrganizationMember, error) {
+	var member OrganizationMember
+	if err := facades.Orm().Query().Where("organization_id = ? AND user_id = ?", organizationID, userID).First(&member); err != nil {
+		return nil, utilserrors.NewInternalServerError(err)
+	}
+
+	return &member, nil
+}
+
+// GetMembers gets the members of the organization, the owners first and the others in the order they joined.
+func (r *Organization) GetMembers(organizationID uint64) ([]*OrganizationMember, error) {
+	var members []*OrganizationMember
+	if err := facades.Orm().Query().Where("organization_id = ?", organizationID).Order("role = 'owner' DESC").Order("id ASC").Find(&members); err != nil {
+		return nil, utilserrors.NewInternalServerError(err)
+	}
+
+	return members, nil
+}
+
+func (r *Organization) GetOrganizationByID(id string) (*Organization, error) {
+	var organization Organization
+	if err := facades.Orm().Query().Where("id", id).First(&organization); err != nil {
+		return nil, utilserrors.NewInternalServerError(err)
+	}
+
+	return &organization, nil
+}
+
+// GetUserOrganizationIDs gets the IDs of the organizations the user is a member of.
+func (r *Organization) GetUserOrganizationIDs(userID uint64) ([]uint64, error) {
+	var organizationIDs []uint64
+	if err := facades.Orm().Query().Model(&OrganizationMember{}).Where("user_id = ?", userID).Pluck("organization_id", &organizationIDs); err != nil {
+		return nil, utilserrors.NewInternalServerError(err)
+	}
+
+	return organizationIDs, nil
+}
+
+func (r *Organization) ToProto() *protouser.Organization {
+	return &protouser.Organization{
+		Id:          cast.ToString(r.ID),
+		Name:        r.Name,
+		DisplayName: r.DisplayName,
+		Avatar:      r.Avatar,
+		Summary:     r.Summary,
+		CreatedAt:   r.CreatedAt.ToString(),
+	}
+}
+
+// OrganizationMember is a user in an organization, it's stored in the organization_members table.
+type OrganizationMember struct {
+	UUIDModel
+	OrganizationID uint64
+	UserID         uint64
+	Role           string
+	User           *User `gorm:"-"`
+}
+
+func (r *OrganizationMember) ToProto() *protouser.OrganizationMember {
+	member := &protouser.OrganizationMember{
+		OrganizationId: cast.ToString(r.OrganizationID),
+		UserId:         cast.ToString(r.UserID),
+		Role:           r.Role,
+		CreatedAt:      r.CreatedAt.ToString(),
+	}
+	if r.User != nil {
+		member.User = r.User.ToProto()
+	}
+
+	return member
+}
+
+// OrganizationInvitation invites an email to an organization. Only the hash of the token sent in the email is stored,
+// the token can't be recovered from the database.
+type OrganizationInvitation struct {
+	UUIDModel
+	OrganizationID uint64
+	Email          string
+	Role           string
+	TokenHash      string
+	InviterID      uint64
+	ExpiresAt      carbon.DateTime
+	AcceptedAt     *carbon.DateTime
+}
+
+// IsPending reports whether the invitation can still be accepted.
+func (r *OrganizationInvitation) IsPending() bool {
+	return r.ID != 0 && r.AcceptedAt == nil && r.ExpiresAt.Gt(carbon.Now())
+}
+
+func (r *OrganizationInvitation) ToProto() *protouser.OrganizationInvitation {
+	return &protouser.OrganizationInvitation{
+		Id:             cast.ToString(r.ID),
+		OrganizationId: cast.ToString(r.OrganizationID),
+		Email:          r.Email,
+		Role:           r.Role,
+		ExpiresAt:      r.ExpiresAt.ToString(),
+	}
+}
+
+// HashInvitationToken hashes the token of an invitation the way it's stored.
+func HashInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// isUniqueViolation reports whether the error is a unique violation (SQLSTATE 23505) reported by the database driver.
+func isUniqueViolation(err error) bool {
+	var sqlStateErr interface{ SQLState() string }
+
+	return stderrors.As(err, &sqlStateErr) && sqlStateErr.SQLState() == "23505"
+}
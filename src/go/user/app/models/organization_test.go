@@ -0,0 +1,444 @@
+package models
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	"github.com/goravel/framework/support/carbon"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	protouser "market.goravel.dev/proto/user"
+	utilserrors "market.goravel.dev/utils/errors"
+)
+
+type OrganizationSuite struct {
+	suite.Suite
+	organization *Organization
+}
+
+func TestOrganizationSuite(t *testing.T) {
+	suite.Run(t, new(OrganizationSuite))
+}
+
+func (s *OrganizationSuite) SetupTest() {
+	s.organization = NewOrganization()
+}
+
+func (s *OrganizationSuite) TestAcceptInvitation() {
+	var (
+		acceptSQL  = "UPDATE organization_invitations SET accepted_at = ?, updated_at = ? WHERE id = ? AND accepted_at IS NULL"
+		invitation = &OrganizationInvitation{UUIDModel: UUIDModel{ID: 1}, OrganizationID: 2, Email: "hello@goravel.dev", Role: OrganizationRoleAdmin}
+		isMember   = mock.MatchedBy(func(member *OrganizationMember) bool {
+			return member.ID > 0 && member.OrganizationID == 2 && member.UserID == 3 && member.Role == OrganizationRoleAdmin
+		})
+
+		mockOrm         *mocksorm.Orm
+		mockTransaction *mocksorm.Transaction
+	)
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockOrm = mockFactory.Orm()
+		mockTransaction = mockFactory.OrmTransaction()
+		mockFactory.Log()
+		mockOrm.On("Transaction", mock.Anything).Return(func(txFunc func(contractsorm.Transaction) error) error {
+			return txFunc(mockTransaction)
+		}).Once()
+	}
+
+	tests := []struct {
+		name         string
+		setup        func()
+		expectMember *OrganizationMember
+		expectedErr  error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				mockTransaction.On("Exec", acceptSQL, mock.Anything, mock.Anything, uint64(1)).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+				mockTransaction.On("Create", isMember).Return(nil).Once()
+			},
+			expectMember: &OrganizationMember{OrganizationID: 2, UserID: 3, Role: OrganizationRoleAdmin},
+		},
+		{
+			name: "Sad path - invitation was accepted meanwhile",
+			setup: func() {
+				mockTransaction.On("Exec", acceptSQL, mock.Anything, mock.Anything, uint64(1)).Return(&contractsorm.Result{RowsAffected: 0}, nil).Once()
+			},
+			expectedErr: ErrInvitationAccepted,
+		},
+		{
+			name: "Sad path - user is a member already",
+			setup: func() {
+				mockTransaction.On("Exec", acceptSQL, mock.Anything, mock.Anything, uint64(1)).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+				mockTransaction.On("Create", isMember).Return(sqlStateError("23505")).Once()
+			},
+			expectedErr: ErrDuplicateMember,
+		},
+		{
+			name: "Sad path - Exec returns error",
+			setup: func() {
+				mockTransaction.On("Exec", acceptSQL, mock.Anything, mock.Anything, uint64(1)).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+		{
+			name: "Sad path - Create returns error",
+			setup: func() {
+				mockTransaction.On("Exec", acceptSQL, mock.Anything, mock.Anything, uint64(1)).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+				mockTransaction.On("Create", isMember).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			beforeSetup()
+			test.setup()
+
+			member, err := s.organization.AcceptInvitation(invitation, 3)
+			if test.expectMember != nil {
+				// The ID is generated.
+				s.NotZero(member.ID)
+				test.expectMember.ID = member.ID
+			}
+			s.Equal(test.expectMember, member)
+			s.Equal(test.expectedErr, err)
+
+			mockOrm.AssertExpectations(s.T())
+			mockTransaction.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *OrganizationSuite) TestCreateOrganization() {
+	var (
+		mockOrm         *mocksorm.Orm
+		mockTransaction *mocksorm.Transaction
+	)
+
+	isOrganization := mock.MatchedBy(func(organization *Organization) bool {
+		return organization.ID > 0 && organization.Name == "goravel"
+	})
+	isOwner := mock.MatchedBy(func(member *OrganizationMember) bool {
+		return member.ID > 0 && member.OrganizationID > 0 && member.UserID == 1 && member.Role == OrganizationRoleOwner
+	})
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockOrm = mockFactory.Orm()
+		mockTransaction = mockFactory.OrmTransaction()
+		mockFactory.Log()
+		mockOrm.On("Transaction", mock.Anything).Return(func(txFunc func(contractsorm.Transaction) error) error {
+			return txFunc(mockTransaction)
+		}).Once()
+	}
+
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				mockTransaction.On("Create", isOrganization).Return(nil).Once()
+				mockTransaction.On("Create", isOwner).Return(nil).Once()
+			},
+		},
+		{
+			name: "Sad path - name is taken",
+			setup: func() {
+				mockTransaction.On("Create", isOrganization).Return(sqlStateError("23505")).Once()
+			},
+			expectedErr: ErrDuplicateOrganizationName,
+		},
+		{
+			name: "Sad path - creating the owner returns error",
+			setup: func() {
+				mockTransaction.On("Create", isOrganization).Return(nil).Once()
+				mockTransaction.On("Create", isOwner).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			beforeSetup()
+			test.setup()
+
+			s.Equal(test.expectedErr, s.organization.CreateOrganization(&Organization{Name: "goravel"}, 1))
+
+			mockOrm.AssertExpectations(s.T())
+			mockTransaction.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *OrganizationSuite) TestDeleteInvitation() {
+	var (
+		mockOrm      *mocksorm.Orm
+		mockOrmQuery *mocksorm.Query
+		invitation   = &OrganizationInvitation{UUIDModel: UUIDModel{ID: 1}}
+	)
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockOrm = mockFactory.Orm()
+		mockOrmQuery = mockFactory.OrmQuery()
+		mockFactory.Log()
+		mockOrm.On("Query").Return(mockOrmQuery).Once()
+	}
+
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				mockOrmQuery.On("Delete", invitation).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+			},
+		},
+		{
+			name: "Sad path - Delete returns error",
+			setup: func() {
+				mockOrmQuery.On("Delete", invitation).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			beforeSetup()
+			test.setup()
+
+			s.Equal(test.expectedErr, s.organization.DeleteInvitation(invitation))
+
+			mockOrm.AssertExpectations(s.T())
+			mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *OrganizationSuite) TestGetInvitationByToken() {
+	var (
+		mockOrm      *mocksorm.Orm
+		mockOrmQuery *mocksorm.Query
+	)
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockOrm = mockFactory.Orm()
+		mockOrmQuery = mockFactory.OrmQuery()
+		mockFactory.Log()
+		mockOrm.On("Query").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Where", "token_hash", HashInvitationToken("token")).Return(mockOrmQuery).Once()
+	}
+
+	tests := []struct {
+		name             string
+		setup            func()
+		expectInvitation *OrganizationInvitation
+		expectedErr      error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				mockOrmQuery.On("First", mock.AnythingOfType("*models.OrganizationInvitation")).Run(func(args mock.Arguments) {
+					invitation := args.Get(0).(*OrganizationInvitation)
+					invitation.ID = 1
+					invitation.Email = "hello@goravel.dev"
+				}).Return(nil).Once()
+			},
+			expectInvitation: &OrganizationInvitation{UUIDModel: UUIDModel{ID: 1}, Email: "hello@goravel.dev"},
+		},
+		{
+			name: "Sad path - First returns error",
+			setup: func() {
+				mockOrmQuery.On("First", mock.AnythingOfType("*models.OrganizationInvitation")).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			beforeSetup()
+			test.setup()
+
+			invitation, err := s.organization.GetInvitationByToken("token")
+			s.Equal(test.expectInvitation, invitation)
+			s.Equal(test.expectedErr, err)
+
+			mockOrm.AssertExpectations(s.T())
+			mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *OrganizationSuite) TestGetMembers() {
+	var (
+		mockOrm      *mocksorm.Orm
+		mockOrmQuery *mocksorm.Query
+	)
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockOrm = mockFactory.Orm()
+		mockOrmQuery = mockFactory.OrmQuery()
+		mockFactory.Log()
+		mockOrm.On("Query").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Where", "organization_id = ?", uint64(1)).Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Order", "role = 'owner' DESC").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Order", "id ASC").Return(mockOrmQuery).Once()
+	}
+
+	tests := []struct {
+		name          string
+		setup         func()
+		expectMembers []*OrganizationMember
+		expectedErr   error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.OrganizationMember")).Run(func(args mock.Arguments) {
+					members := args.Get(0).(*[]*OrganizationMember)
+					*members = []*OrganizationMember{{UUIDModel: UUIDModel{ID: 1}, OrganizationID: 1, UserID: 2, Role: OrganizationRoleOwner}}
+				}).Return(nil).Once()
+			},
+			expectMembers: []*OrganizationMember{{UUIDModel: UUIDModel{ID: 1}, OrganizationID: 1, UserID: 2, Role: OrganizationRoleOwner}},
+		},
+		{
+			name: "Sad path - Find returns error",
+			setup: func() {
+				mockOrmQuery.On("Find", mock.AnythingOfType("*[]*models.OrganizationMember")).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			beforeSetup()
+			test.setup()
+
+			members, err := s.organization.GetMembers(1)
+			s.Equal(test.expectMembers, members)
+			s.Equal(test.expectedErr, err)
+
+			mockOrm.AssertExpectations(s.T())
+			mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *OrganizationSuite) TestGetUserOrganizationIDs() {
+	var (
+		mockOrm      *mocksorm.Orm
+		mockOrmQuery *mocksorm.Query
+	)
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockOrm = mockFactory.Orm()
+		mockOrmQuery = mockFactory.OrmQuery()
+		mockFactory.Log()
+		mockOrm.On("Query").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Model", &OrganizationMember{}).Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Where", "user_id = ?", uint64(1)).Return(mockOrmQuery).Once()
+	}
+
+	tests := []struct {
+		name                  string
+		setup                 func()
+		expectOrganizationIDs []uint64
+		expectedErr           error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				mockOrmQuery.On("Pluck", "organization_id", mock.AnythingOfType("*[]uint64")).Run(func(args mock.Arguments) {
+					*args.Get(1).(*[]uint64) = []uint64{2, 3}
+				}).Return(nil).Once()
+			},
+			expectOrganizationIDs: []uint64{2, 3},
+		},
+		{
+			name: "Sad path - Pluck returns error",
+			setup: func() {
+				mockOrmQuery.On("Pluck", "organization_id", mock.AnythingOfType("*[]uint64")).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			beforeSetup()
+			test.setup()
+
+			organizationIDs, err := s.organization.GetUserOrganizationIDs(1)
+			s.Equal(test.expectOrganizationIDs, organizationIDs)
+			s.Equal(test.expectedErr, err)
+
+			mockOrm.AssertExpectations(s.T())
+			mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *OrganizationSuite) TestIsPending() {
+	expiresAt := carbon.DateTime{Carbon: carbon.Now().AddHour()}
+	acceptedAt := carbon.DateTime{Carbon: carbon.Now()}
+
+	s.True((&OrganizationInvitation{UUIDModel: UUIDModel{ID: 1}, ExpiresAt: expiresAt}).IsPending())
+	s.False((&OrganizationInvitation{ExpiresAt: expiresAt}).IsPending())
+	s.False((&OrganizationInvitation{UUIDModel: UUIDModel{ID: 1}, ExpiresAt: expiresAt, AcceptedAt: &acceptedAt}).IsPending())
+	s.False((&OrganizationInvitation{UUIDModel: UUIDModel{ID: 1}, ExpiresAt: carbon.DateTime{Carbon: carbon.Now().SubHour()}}).IsPending())
+}
+
+func (s *OrganizationSuite) TestToProto() {
+	organization := &Organization{
+		UUIDModel: UUIDModel{
+			ID: 1,
+		},
+		Name:        "goravel",
+		DisplayName: "Goravel",
+	}
+
+	s.Equal(&protouser.Organization{
+		Id:          "1",
+		Name:        "goravel",
+		DisplayName: "Goravel",
+		CreatedAt:   "",
+	}, organization.ToProto())
+
+	member := &OrganizationMember{OrganizationID: 1, UserID: 2, Role: OrganizationRoleMember, User: &User{Name: "goravel"}}
+	s.Equal(&protouser.OrganizationMember{
+		OrganizationId: "1",
+		UserId:         "2",
+		Role:           OrganizationRoleMember,
+		User:           &protouser.User{Id: "0", Name: "goravel"},
+	}, member.ToProto())
+}
+
+type sqlStateError string
+
+func (r sqlStateError) Error() string {
+	return "sqlstate " + string(r)
+}
+
+func (r sqlStateError) SQLState() string {
+	return string(r)
+}
@@ -1,6 +1,9 @@
 package models
 
 import (
+	stderrors "errors"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
 	"github.com/goravel/framework/database/orm"
 	"github.com/goravel/framework/facades"
 	"github.com/spf13/cast"
@@ -9,9 +12,18 @@ import (
 	utilserrors "market.goravel.dev/utils/errors"
 )
 
+var (
+	// ErrUserChanged is returned when the user was updated by someone else after it was read, the revision of the user
+	// differs.
+	ErrUserChanged = stderrors.New("user changed")
+	// ErrDuplicateUsername is returned when another user has the same username.
+	ErrDuplicateUsername = stderrors.New("duplicate username")
+)
+
 type UserInterface interface {
 	GetUserByEmail(email string, fields []string) (*User, error)
 	GetUserByID(id string, fields []string) (*User, error)
+	GetUserByUsername(username string, fields []string) (*User, error)
 	GetUsers(ids []string, fields []string) ([]*User, error)
 	Register(name, email, password string) (*User, error)
 	UpdateUser(user *User) error
@@ -19,11 +31,14 @@ type UserInterface interface {
 
 type User struct {
 	UUIDModel
-	Email    string
-	Password string
-	Name     string
-	Avatar   string
-	Summary  string
+	Email           string
+	Password        string
+	Name            string
+	Avatar          string
+	AvatarThumbnail string
+	Summary         string
+	Revision        uint64 // Increased by UpdateUser, guards the user against the concurrent updates.
+	Username        string // Unique, stored in lowercase, empty until the user sets it.
 	orm.SoftDeletes
 }
 
@@ -56,6 +71,23 @@ func (r *User) GetUserByID(id string, fields []string) (*User, error) {
 	return &user, nil
 }
 
+// GetUserByUsername gets the user by the lowercase username, the ID of the returned user is 0 if there is no such user.
+func (r *User) GetUserByUsername(username string, fields []string) (*User, error) {
+	var user User
+
+	query := facades.Orm().Query().Where("username", username)
+
+	if len(fields) > 0 {
+		query = query.Select(fields)
+	}
+
+	if err := query.First(&user); err != nil {
+		return nil, utilserrors.NewInternalServerError(err)
+	}
+
+	return &user, nil
+}
+
 func (r *User) GetUsers(ids []string, fields []string) ([]*User, error) {
 	var users []*User
 
@@ -92,18 +124,52 @@ func (r *User) Register(name, email, password string) (*User, error) {
 
 func (r *User) ToProto() *protouser.User {
 	return &protouser.User{
-		Id:      cast.ToString(r.ID),
-		Name:    r.Name,
-		Email:   r.Email,
-		Avatar:  r.Avatar,
-		Summary: r.Summary,
+		Id:              cast.ToString(r.ID),
+		Name:            r.Name,
+		Email:           r.Email,
+		Avatar:          r.Avatar,
+		AvatarThumbnail: r.AvatarThumbnail,
+		Summary:         r.Summary,
+		Revision:        r.Revision,
+		Username:        r.Username,
 	}
 }
 
-func (r *User) UpdateUser(user *User) error {
-	if err := facades.Orm().Query().Save(user); err != nil {
-		return utilserrors.NewInternalServerError(err)
+// ToPublicProfile converts the user to the profile shown to everyone, the email is left out.
+func (r *User) ToPublicProfile() *protouser.PublicProfile {
+	return &protouser.PublicProfile{
+		Id:              cast.ToString(r.ID),
+		Username:        r.Username,
+		Name:            r.Name,
+		Avatar:          r.Avatar,
+		AvatarThumbnail: r.AvatarThumbnail,
+		Summary:         r.Summary,
+		CreatedAt:       r.CreatedAt.ToString(),
 	}
+}
 
-	return nil
+// UpdateUser saves the user if its revision is still the one the user was read with, otherwise ErrUserChanged is
+// returned and nothing is saved. The revision is increased in the same transaction as the save, so the concurrent
+// updates can't interleave. ErrDuplicateUsername is returned if the username is taken.
+func (r *User) UpdateUser(user *User) error {
+	return facades.Orm().Transaction(func(tx contractsorm.Transaction) error {
+		result, err := tx.Exec("UPDATE users SET revision = revision + 1 WHERE id = ? AND revision = ?", user.ID, user.Revision)
+		if err != nil {
+			return utilserrors.NewInternalServerError(err)
+		}
+		if result.RowsAffected == 0 {
+			return ErrUserChanged
+		}
+		user.Revision++
+
+		if err := tx.Save(user); err != nil {
+			if isUniqueViolation(err) {
+				return ErrDuplicateUsername
+			}
+
+			return utilserrors.NewInternalServerError(err)
+		}
+
+		return nil
+	})
 }
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"testing"
 
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
 	mocksorm "github.com/goravel/framework/mocks/database/orm"
 	mockshash "github.com/goravel/framework/mocks/hash"
 	testingmock "github.com/goravel/framework/testing/mock"
@@ -158,6 +159,72 @@ func (s *UserSuite) TestGetUserByID() {
 	}
 }
 
+func (s *UserSuite) TestGetUserByUsername() {
+	var (
+		username = "goravel"
+		fields   = []string{"name"}
+
+		mockOrm      *mocksorm.Orm
+		mockOrmQuery *mocksorm.Query
+		user         User
+	)
+
+	beforeSetup := func() {
+		mockFactory := testingmock.Factory()
+		mockOrm = mockFactory.Orm()
+		mockFactory.Log()
+		mockOrmQuery = mockFactory.OrmQuery()
+		mockOrm.On("Query").Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Where", "username", username).Return(mockOrmQuery).Once()
+		mockOrmQuery.On("Select", []string{"name"}).Return(mockOrmQuery).Once()
+	}
+
+	tests := []struct {
+		name        string
+		setup       func()
+		expectUser  *User
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				mockOrmQuery.On("First", &user).Run(func(args mock.Arguments) {
+					user := args.Get(0).(*User)
+					user.ID = 1
+					user.Name = "Goravel"
+				}).Return(nil).Once()
+			},
+		},
+		{
+			name: "Sad path - get user error",
+			setup: func() {
+				var user User
+				mockOrmQuery.On("First", &user).Return(errors.New("error")).Once()
+			},
+			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			beforeSetup()
+			test.setup()
+			returnedUser, err := s.user.GetUserByUsername(username, fields)
+
+			if test.expectedErr != nil {
+				s.Nil(returnedUser)
+				s.Equal(test.expectedErr, err)
+			} else {
+				s.NotNil(returnedUser)
+				s.Nil(err)
+			}
+
+			mockOrm.AssertExpectations(s.T())
+			mockOrmQuery.AssertExpectations(s.T())
+		})
+	}
+}
+
 func (s *UserSuite) TestGetUsers() {
 	var (
 		ids    = []string{"1"}
@@ -342,6 +409,27 @@ func (s *UserSuite) TestToProto() {
 	}, user.ToProto())
 }
 
+func (s *UserSuite) TestToPublicProfile() {
+	user := User{
+		UUIDModel: UUIDModel{
+			ID: 1,
+		},
+		Name:     "Goravel",
+		Email:    "hello@goravel.dev",
+		Username: "goravel",
+		Avatar:   "avatar",
+		Summary:  "summary",
+	}
+
+	s.Equal(&protouser.PublicProfile{
+		Id:       "1",
+		Username: "goravel",
+		Name:     "Goravel",
+		Avatar:   "avatar",
+		Summary:  "summary",
+	}, user.ToPublicProfile())
+}
+
 func (s *UserSuite) TestUpdateUser() {
 	var (
 		name    = "krishan"
@@ -352,45 +440,70 @@ func (s *UserSuite) TestUpdateUser() {
 			UUIDModel: UUIDModel{
 				ID: 1,
 			},
-			Name:    name,
-			Avatar:  avatar,
-			Summary: summary,
+			Name:     name,
+			Avatar:   avatar,
+			Summary:  summary,
+			Revision: 2,
 		}
 
-		mockOrm      *mocksorm.Orm
-		mockOrmQuery *mocksorm.Query
+		mockOrm         *mocksorm.Orm
+		mockTransaction *mocksorm.Transaction
 	)
 
 	beforeSetup := func() {
 		mockFactory := testingmock.Factory()
 		mockOrm = mockFactory.Orm()
-		mockOrmQuery = mockFactory.OrmQuery()
+		mockTransaction = mockFactory.OrmTransaction()
 		mockFactory.Log()
-		mockOrm.On("Query").Return(mockOrmQuery).Once()
+		mockOrm.On("Transaction", mock.Anything).Return(func(txFunc func(contractsorm.Transaction) error) error {
+			return txFunc(mockTransaction)
+		}).Once()
 	}
 
 	tests := []struct {
-		name        string
-		setup       func()
-		expectedErr error
+		name           string
+		setup          func()
+		expectedErr    error
+		expectRevision uint64
 	}{
 		{
 			name: "Happy path",
 			setup: func() {
-				mockOrmQuery.On("Save", mock.MatchedBy(func(user *User) bool {
-					return user.Name == name && user.Avatar == avatar && user.Summary == summary
+				mockTransaction.On("Exec", "UPDATE users SET revision = revision + 1 WHERE id = ? AND revision = ?", uint64(1), uint64(2)).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+				mockTransaction.On("Save", mock.MatchedBy(func(user *User) bool {
+					return user.Name == name && user.Avatar == avatar && user.Summary == summary && user.Revision == 3
 				})).Return(nil).Once()
 			},
-			expectedErr: nil,
+			expectedErr:    nil,
+			expectRevision: 3,
 		},
 		{
-			name: "Sad path - save package error",
+			name: "Sad path - user changed",
 			setup: func() {
-				mockOrmQuery.On("Save", mock.MatchedBy(func(user *User) bool {
+				mockTransaction.On("Exec", "UPDATE users SET revision = revision + 1 WHERE id = ? AND revision = ?", uint64(1), uint64(2)).Return(&contractsorm.Result{RowsAffected: 0}, nil).Once()
+			},
+			expectedErr:    ErrUserChanged,
+			expectRevision: 2,
+		},
+		{
+			name: "Sad path - username is taken",
+			setup: func() {
+				mockTransaction.On("Exec", "UPDATE users SET revision = revision + 1 WHERE id = ? AND revision = ?", uint64(1), uint64(2)).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+				mockTransaction.On("Save", mock.AnythingOfType("*models.User")).Return(sqlStateError("23505")).Once()
+			},
+			expectedErr:    ErrDuplicateUsername,
+			expectRevision: 3,
+		},
+		{
+			name: "Sad path - save user error",
+			setup: func() {
+				mockTransaction.On("Exec", "UPDATE users SET revision = revision + 1 WHERE id = ? AND revision = ?", uint64(1), uint64(2)).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+				mockTransaction.On("Save", mock.MatchedBy(func(user *User) bool {
 					return user.Name == name && user.Avatar == avatar && user.Summary == summary
 				})).Return(errors.New("error")).Once()
 			},
-			expectedErr: utilserrors.New(http.StatusInternalServerError, "error"),
+			expectedErr:    utilserrors.New(http.StatusInternalServerError, "error"),
+			expectRevision: 3,
 		},
 	}
 
@@ -398,12 +511,14 @@ func (s *UserSuite) TestUpdateUser() {
 		s.Run(test.name, func() {
 			beforeSetup()
 			test.setup()
+			user := user
 			err := s.user.UpdateUser(&user)
 
 			s.Equal(test.expectedErr, err)
+			s.Equal(test.expectRevision, user.Revision)
 
 			mockOrm.AssertExpectations(s.T())
-			mockOrmQuery.AssertExpectations(s.T())
+			mockTransaction.AssertExpectations(s.T())
 		})
 	}
 }
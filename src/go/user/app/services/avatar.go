@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/goravel/framework/contracts/filesystem"
+	"github.com/goravel/framework/facades"
+	"github.com/spf13/cast"
+
+	"market.goravel.dev/user/app/models"
+	utilerrors "market.goravel.dev/utils/errors"
+	"market.goravel.dev/utils/image"
+)
+
+// avatarDir is the directory of the uploaded avatars of a user on the avatar disk.
+const avatarDir = "avatars/%d"
+
+// Avatar stores the avatar images uploaded by the users.
+type Avatar interface {
+	UploadAvatar(ctx context.Context, id, userID string, data []byte) (*models.User, error)
+}
+
+type AvatarImpl struct {
+	userModel models.UserInterface
+}
+
+func NewAvatarImpl() *AvatarImpl {
+	return &AvatarImpl{
+		userModel: models.NewUser(),
+	}
+}
+
+// UploadAvatar replaces the avatar of the user by the uploaded image and its thumbnail, the previously uploaded avatar
+// is deleted once the user is saved.
+func (r *AvatarImpl) UploadAvatar(ctx context.Context, id, userID string, data []byte) (*models.User, error) {
+	user, err := r.userModel.GetUserByID(id, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	if user.ID == 0 {
+		return nil, utilerrors.NewNotFound(facades.Lang(ctx).Get("not_exist.user"))
+	}
+
+	if user.ID != cast.ToUint64(userID) {
+		return nil, utilerrors.NewUnauthorized(facades.Lang(ctx).Get("forbidden.update_user"))
+	}
+
+	options := avatarOptions()
+	img, err := image.Process(data, options)
+	if err != nil {
+		return nil, image.TranslateError(ctx, err, options)
+	}
+
+	avatar, avatarThumbnail, err := image.Store(avatarDisk(), fmt.Sprintf(avatarDir, user.ID), img)
+	if err != nil {
+		return nil, utilerrors.NewInternalServerError(err)
+	}
+
+	previousAvatar, previousAvatarThumbnail := user.Avatar, user.AvatarThumbnail
+	user.Avatar = avatar
+	user.AvatarThumbnail = avatarThumbnail
+	if err := r.userModel.UpdateUser(user); err != nil {
+		deleteAvatar(user.ID, avatar, avatarThumbnail)
+
+		return nil, userConflictError(ctx, err)
+	}
+
+	deleteAvatar(user.ID, previousAvatar, previousAvatarThumbnail)
+
+	return user, nil
+}
+
+func avatarDisk() filesystem.Driver {
+	return facades.Storage().Disk(facades.Config().GetString("user.avatar.disk", "public"))
+}
+
+func avatarOptions() image.Options {
+	config := facades.Config()
+
+	return image.Options{
+		MaxSize:         config.GetInt("user.avatar.max_size_kb", 1024) * 1024,
+		MinWidth:        config.GetInt("user.avatar.min_width", 64),
+		MinHeight:       config.GetInt("user.avatar.min_height", 64),
+		MaxWidth:        config.GetInt("user.avatar.max_width", 4096),
+		MaxHeight:       config.GetInt("user.avatar.max_height", 4096),
+		ThumbnailWidth:  config.GetInt("user.avatar.thumbnail_width", 128),
+		ThumbnailHeight: config.GetInt("user.avatar.thumbnail_height", 128),
+	}
+}
+
+// deleteAvatar deletes the uploaded avatar files of the user, the linked avatars are skipped. A failure is only logged
+// since the user has been saved, the file is left orphaned.
+func deleteAvatar(userID uint64, urls ...string) {
+	if strings.Join(urls, "") == "" {
+		return
+	}
+
+	if err := image.Delete(avatarDisk(), fmt.Sprintf(avatarDir, userID), urls...); err != nil {
+		facades.Log().Errorf("delete avatar of user %d error: %+v", userID, err)
+	}
+}
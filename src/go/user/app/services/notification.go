@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/md5"
 	"fmt"
+	"html"
 	"math/rand"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 
 type Notification interface {
 	SendEmailRegisterCode(ctx context.Context, email string) (key string, err error)
+	SendOrganizationInvitation(ctx context.Context, email, organization, url string) error
 	VerifyEmailRegisterCode(key, code string) bool
 }
 
@@ -62,6 +64,28 @@ func (r *NotificationImpl) SendEmailRegisterCode(ctx context.Context, email stri
 	return key, nil
 }
 
+// SendOrganizationInvitation queues the email inviting to the organization, the url accepts the invitation.
+func (r *NotificationImpl) SendOrganizationInvitation(ctx context.Context, email, organization, url string) error {
+	subject := facades.Lang(ctx).Get("organization_invitation.subject", translation.Option{
+		Replace: map[string]string{
+			"organization": organization,
+			"url":          url,
+		},
+	})
+	// The organization name is chosen by its owner, it's escaped as the URL in the href of the HTML content.
+	content := facades.Lang(ctx).Get("organization_invitation.content", translation.Option{
+		Replace: map[string]string{
+			"organization": html.EscapeString(organization),
+			"url":          html.EscapeString(url),
+		},
+	})
+
+	return facades.Mail().To([]string{email}).Content(mail.Content{
+		Subject: subject,
+		Html:    content,
+	}).Queue()
+}
+
 func (r *NotificationImpl) VerifyEmailRegisterCode(key, code string) bool {
 	if facades.Cache().GetString(key) == code {
 		facades.Cache().Forget(key)
@@ -150,6 +150,67 @@ func (s *AuthTestSuite) TestSendEmailRegisterCode() {
 	}
 }
 
+func (s *AuthTestSuite) TestSendOrganizationInvitation() {
+	var (
+		ctx      = context.Background()
+		email    = "hello@goravel.dev"
+		url      = "https://market.goravel.dev/organizations/invitations?token=token&from=email"
+		mockMail *mocksmail.Mail
+		mockLang *mockstranslation.Translator
+	)
+
+	beforeEach := func() {
+		mockFactory := testingmock.Factory()
+		mockMail = mockFactory.Mail()
+		mockLang = mockFactory.Lang(ctx)
+		mockLang.On("Get", "organization_invitation.subject", translation.Option{
+			Replace: map[string]string{
+				"organization": `<b>"Goravel"</b>`,
+				"url":          url,
+			},
+		}).Return("subject").Once()
+		mockLang.On("Get", "organization_invitation.content", translation.Option{
+			Replace: map[string]string{
+				"organization": "&lt;b&gt;&#34;Goravel&#34;&lt;/b&gt;",
+				"url":          "https://market.goravel.dev/organizations/invitations?token=token&amp;from=email",
+			},
+		}).Return("html").Once()
+		mockMail.On("To", []string{email}).Return(mockMail).Once()
+		mockMail.On("Content", mail.Content{Subject: "subject", Html: "html"}).Return(mockMail).Once()
+	}
+
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				mockMail.On("Queue").Return(nil).Once()
+			},
+		},
+		{
+			name: "Sad path - send email failed",
+			setup: func() {
+				mockMail.On("Queue").Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			beforeEach()
+			test.setup()
+			s.Equal(test.expectedErr, s.notificationImpl.SendOrganizationInvitation(ctx, email, `<b>"Goravel"</b>`, url))
+
+			mockMail.AssertExpectations(s.T())
+			mockLang.AssertExpectations(s.T())
+		})
+	}
+}
+
 func (s *AuthTestSuite) TestGetEmailRegisterCodeKey() {
 	s.Equal(32, len(s.notificationImpl.getEmailRegisterCodeKey("hello@goravel.dev")))
 }
@@ -0,0 +1,239 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	neturl "net/url"
+	"strings"
+
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/support/carbon"
+	"github.com/spf13/cast"
+
+	protouser "market.goravel.dev/proto/user"
+	"market.goravel.dev/user/app/models"
+	utilerrors "market.goravel.dev/utils/errors"
+)
+
+// Organization manages the organizations publishing packages, their members and the invitations to them.
+type Organization interface {
+	AcceptInvitation(ctx context.Context, userID, token string) (*models.OrganizationMember, error)
+	CreateOrganization(ctx context.Context, req *protouser.CreateOrganizationRequest) (*models.Organization, error)
+	GetMember(organizationID, userID string) (*models.OrganizationMember, error)
+	GetOrganization(ctx context.Context, id string) (*models.Organization, error)
+	InviteMember(ctx context.Context, req *protouser.InviteMemberRequest) (*models.OrganizationInvitation, error)
+	ListMembers(ctx context.Context, id string) ([]*models.OrganizationMember, error)
+	ListUserOrganizations(userID string) ([]uint64, error)
+}
+
+type OrganizationImpl struct {
+	notificationService Notification
+	organizationModel   models.OrganizationInterface
+	userModel           models.UserInterface
+}
+
+func NewOrganizationImpl() *OrganizationImpl {
+	return &OrganizationImpl{
+		notificationService: NewNotificationImpl(),
+		organizationModel:   models.NewOrganization(),
+		userModel:           models.NewUser(),
+	}
+}
+
+// AcceptInvitation adds the user to the organization of the invitation, the invitation must be sent to the email of
+// the user.
+func (r *OrganizationImpl) AcceptInvitation(ctx context.Context, userID, token string) (*models.OrganizationMember, error) {
+	invitation, err := r.organizationModel.GetInvitationByToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if !invitation.IsPending() {
+		return nil, utilerrors.NewNotFound(facades.Lang(ctx).Get("not_exist.invitation"))
+	}
+
+	user, err := r.userModel.GetUserByID(userID, []string{"id", "name", "email", "avatar", "avatar_thumbnail"})
+	if err != nil {
+		return nil, err
+	}
+
+	// The token may be leaked, only the invited email can join.
+	if user.ID == 0 || !strings.EqualFold(user.Email, invitation.Email) {
+		return nil, utilerrors.NewUnauthorized(facades.Lang(ctx).Get("forbidden.accept_invitation"))
+	}
+
+	member, err := r.organizationModel.AcceptInvitation(invitation, user.ID)
+	if err != nil {
+		if errors.Is(err, models.ErrInvitationAccepted) {
+			return nil, utilerrors.NewNotFound(facades.Lang(ctx).Get("not_exist.invitation"))
+		}
+		if errors.Is(err, models.ErrDuplicateMember) {
+			return nil, utilerrors.NewConflict(facades.Lang(ctx).Get("exist.member"))
+		}
+
+		return nil, err
+	}
+	// The email isn't a part of the members.
+	user.Email = ""
+	member.User = user
+
+	return member, nil
+}
+
+// CreateOrganization creates the organization with the user as its owner.
+func (r *OrganizationImpl) CreateOrganization(ctx context.Context, req *protouser.CreateOrganizationRequest) (*models.Organization, error) {
+	organization := &models.Organization{
+		Name:        strings.ToLower(req.GetName()),
+		DisplayName: req.GetDisplayName(),
+		Avatar:      req.GetAvatar(),
+		Summary:     req.GetSummary(),
+	}
+	if organization.DisplayName == "" {
+		organization.DisplayName = req.GetName()
+	}
+
+	if err := r.organizationModel.CreateOrganization(organization, cast.ToUint64(req.GetUserId())); err != nil {
+		if errors.Is(err, models.ErrDuplicateOrganizationName) {
+			return nil, utilerrors.NewConflict(facades.Lang(ctx).Get("exist.organization"))
+		}
+
+		return nil, err
+	}
+
+	return organization, nil
+}
+
+// GetMember gets the user in the members of the organization, the ID of the returned member is 0 if the user isn't a
+// member of the organization.
+func (r *OrganizationImpl) GetMember(organizationID, userID string) (*models.OrganizationMember, error) {
+	return r.organizationModel.GetMember(cast.ToUint64(organizationID), cast.ToUint64(userID))
+}
+
+func (r *OrganizationImpl) GetOrganization(ctx context.Context, id string) (*models.Organization, error) {
+	organization, err := r.organizationModel.GetOrganizationByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if organization.ID == 0 {
+		return nil, utilerrors.NewNotFound(facades.Lang(ctx).Get("not_exist.organization"))
+	}
+
+	return organization, nil
+}
+
+// InviteMember invites the email to the organization and sends the invitation, only the owners and the admins can
+// invite. The invitation is deleted if the email fails to be sent.
+func (r *OrganizationImpl) InviteMember(ctx context.Context, req *protouser.InviteMemberRequest) (*models.OrganizationInvitation, error) {
+	organization, err := r.GetOrganization(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	inviter, err := r.organizationModel.GetMember(organization.ID, cast.ToUint64(req.GetUserId()))
+	if err != nil {
+		return nil, err
+	}
+
+	if inviter.Role != models.OrganizationRoleOwner && inviter.Role != models.OrganizationRoleAdmin {
+		return nil, utilerrors.NewUnauthorized(facades.Lang(ctx).Get("forbidden.invite_member"))
+	}
+
+	token, err := invitationToken()
+	if err != nil {
+		return nil, utilerrors.NewInternalServerError(err)
+	}
+
+	role := req.GetRole()
+	if role == "" {
+		role = models.OrganizationRoleMember
+	}
+
+	invitation := &models.OrganizationInvitation{
+		OrganizationID: organization.ID,
+		Email:          req.GetEmail(),
+		Role:           role,
+		TokenHash:      models.HashInvitationToken(token),
+		InviterID:      inviter.UserID,
+		ExpiresAt:      carbon.DateTime{Carbon: carbon.Now().AddHours(facades.Config().GetInt("user.organization.invitation_expire_hours", 168))},
+	}
+	if err := r.organizationModel.CreateInvitation(invitation); err != nil {
+		return nil, err
+	}
+
+	if err := r.notificationService.SendOrganizationInvitation(ctx, invitation.Email, organization.DisplayName, invitationURL(token)); err != nil {
+		// The token is only in the email, an invitation that isn't sent can never be accepted.
+		if err := r.organizationModel.DeleteInvitation(invitation); err != nil {
+			facades.Log().Errorf("delete unsent invitation %d error: %+v", invitation.ID, err)
+		}
+
+		return nil, utilerrors.NewInternalServerError(err)
+	}
+
+	return invitation, nil
+}
+
+// ListMembers lists the members of the organization with their users, the owners first.
+func (r *OrganizationImpl) ListMembers(ctx context.Context, id string) ([]*models.OrganizationMember, error) {
+	organization, err := r.GetOrganization(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := r.organizationModel.GetMembers(organization.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(members) == 0 {
+		return members, nil
+	}
+
+	userIDs := make([]string, len(members))
+	for i, member := range members {
+		userIDs[i] = cast.ToString(member.UserID)
+	}
+
+	users, err := r.userModel.GetUsers(userIDs, []string{"id", "name", "avatar", "avatar_thumbnail", "username"})
+	if err != nil {
+		return nil, err
+	}
+
+	userMap := make(map[uint64]*models.User, len(users))
+	for _, user := range users {
+		userMap[user.ID] = user
+	}
+	for _, member := range members {
+		member.User = userMap[member.UserID]
+	}
+
+	return members, nil
+}
+
+// invitationToken generates the random token sent in an invitation email.
+func invitationToken() (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(token), nil
+}
+
+// invitationURL is the link in the invitation email, the token is added to the configured URL as the "token" query.
+func invitationURL(token string) string {
+	url := facades.Config().GetString("user.organization.invitation_url", "https://market.goravel.dev/organizations/invitations")
+	separator := "?"
+	if strings.Contains(url, "?") {
+		separator = "&"
+	}
+
+	return url + separator + "token=" + neturl.QueryEscape(token)
+}
+
+// ListUserOrganizations lists the IDs of the organizations the user is a member of.
+func (r *OrganizationImpl) ListUserOrganizations(userID string) ([]uint64, error) {
+	return r.organizationModel.GetUserOrganizationIDs(cast.ToUint64(userID))
+}
@@ -0,0 +1,375 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	mocksconfig "github.com/goravel/framework/mocks/config"
+	mockstranslation "github.com/goravel/framework/mocks/translation"
+	"github.com/goravel/framework/support/carbon"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	protouser "market.goravel.dev/proto/user"
+	mocksmodels "market.goravel.dev/user/app/mocks/models"
+	mocksservices "market.goravel.dev/user/app/mocks/services"
+	"market.goravel.dev/user/app/models"
+	utilserrors "market.goravel.dev/utils/errors"
+)
+
+type OrganizationTestSuite struct {
+	suite.Suite
+	ctx                     context.Context
+	mockConfig              *mocksconfig.Config
+	mockLang                *mockstranslation.Translator
+	mockNotificationService *mocksservices.Notification
+	mockOrganization        *mocksmodels.OrganizationInterface
+	mockUser                *mocksmodels.UserInterface
+	organizationImpl        *OrganizationImpl
+}
+
+func TestOrganizationTestSuite(t *testing.T) {
+	suite.Run(t, new(OrganizationTestSuite))
+}
+
+func (s *OrganizationTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	mockFactory := testingmock.Factory()
+	mockFactory.Log()
+	s.mockConfig = mockFactory.Config()
+	s.mockLang = mockFactory.Lang(s.ctx)
+	s.mockNotificationService = new(mocksservices.Notification)
+	s.mockOrganization = new(mocksmodels.OrganizationInterface)
+	s.mockUser = new(mocksmodels.UserInterface)
+	s.organizationImpl = &OrganizationImpl{
+		notificationService: s.mockNotificationService,
+		organizationModel:   s.mockOrganization,
+		userModel:           s.mockUser,
+	}
+}
+
+func (s *OrganizationTestSuite) TestAcceptInvitation() {
+	var (
+		fields     = []string{"id", "name", "email", "avatar", "avatar_thumbnail"}
+		invitation = &models.OrganizationInvitation{
+			UUIDModel:      models.UUIDModel{ID: 1},
+			OrganizationID: 2,
+			Email:          "hello@goravel.dev",
+			Role:           models.OrganizationRoleMember,
+			ExpiresAt:      carbon.DateTime{Carbon: carbon.Now().AddHour()},
+		}
+		user = func() *models.User {
+			return &models.User{UUIDModel: models.UUIDModel{ID: 3}, Name: "goravel", Email: "Hello@goravel.dev"}
+		}
+	)
+
+	tests := []struct {
+		name         string
+		setup        func()
+		expectMember *models.OrganizationMember
+		expectedErr  error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrganization.On("GetInvitationByToken", "token").Return(invitation, nil).Once()
+				s.mockUser.On("GetUserByID", "3", fields).Return(user(), nil).Once()
+				s.mockOrganization.On("AcceptInvitation", invitation, uint64(3)).Return(&models.OrganizationMember{
+					OrganizationID: 2,
+					UserID:         3,
+					Role:           models.OrganizationRoleMember,
+				}, nil).Once()
+			},
+			expectMember: &models.OrganizationMember{
+				OrganizationID: 2,
+				UserID:         3,
+				Role:           models.OrganizationRoleMember,
+				User:           &models.User{UUIDModel: models.UUIDModel{ID: 3}, Name: "goravel"},
+			},
+		},
+		{
+			name: "Sad path - invitation doesn't exist",
+			setup: func() {
+				s.mockOrganization.On("GetInvitationByToken", "token").Return(&models.OrganizationInvitation{}, nil).Once()
+				s.mockLang.On("Get", "not_exist.invitation").Return("invitation not found").Once()
+			},
+			expectedErr: utilserrors.NewNotFound("invitation not found"),
+		},
+		{
+			name: "Sad path - invitation was sent to another email",
+			setup: func() {
+				s.mockOrganization.On("GetInvitationByToken", "token").Return(invitation, nil).Once()
+				s.mockUser.On("GetUserByID", "3", fields).Return(&models.User{UUIDModel: models.UUIDModel{ID: 3}, Email: "world@goravel.dev"}, nil).Once()
+				s.mockLang.On("Get", "forbidden.accept_invitation").Return("forbidden").Once()
+			},
+			expectedErr: utilserrors.NewUnauthorized("forbidden"),
+		},
+		{
+			name: "Sad path - invitation was accepted meanwhile",
+			setup: func() {
+				s.mockOrganization.On("GetInvitationByToken", "token").Return(invitation, nil).Once()
+				s.mockUser.On("GetUserByID", "3", fields).Return(user(), nil).Once()
+				s.mockOrganization.On("AcceptInvitation", invitation, uint64(3)).Return(nil, models.ErrInvitationAccepted).Once()
+				s.mockLang.On("Get", "not_exist.invitation").Return("invitation not found").Once()
+			},
+			expectedErr: utilserrors.NewNotFound("invitation not found"),
+		},
+		{
+			name: "Sad path - user is a member already",
+			setup: func() {
+				s.mockOrganization.On("GetInvitationByToken", "token").Return(invitation, nil).Once()
+				s.mockUser.On("GetUserByID", "3", fields).Return(user(), nil).Once()
+				s.mockOrganization.On("AcceptInvitation", invitation, uint64(3)).Return(nil, models.ErrDuplicateMember).Once()
+				s.mockLang.On("Get", "exist.member").Return("member exists").Once()
+			},
+			expectedErr: utilserrors.NewConflict("member exists"),
+		},
+		{
+			name: "Sad path - GetInvitationByToken returns error",
+			setup: func() {
+				s.mockOrganization.On("GetInvitationByToken", "token").Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+
+			member, err := s.organizationImpl.AcceptInvitation(s.ctx, "3", "token")
+			s.Equal(test.expectMember, member)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockOrganization.AssertExpectations(s.T())
+			s.mockUser.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *OrganizationTestSuite) TestCreateOrganization() {
+	req := &protouser.CreateOrganizationRequest{UserId: "1", Name: "Goravel"}
+	organization := &models.Organization{Name: "goravel", DisplayName: "Goravel"}
+
+	tests := []struct {
+		name               string
+		setup              func()
+		expectOrganization *models.Organization
+		expectedErr        error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrganization.On("CreateOrganization", organization, uint64(1)).Return(nil).Once()
+			},
+			expectOrganization: organization,
+		},
+		{
+			name: "Sad path - name is taken",
+			setup: func() {
+				s.mockOrganization.On("CreateOrganization", organization, uint64(1)).Return(models.ErrDuplicateOrganizationName).Once()
+				s.mockLang.On("Get", "exist.organization").Return("organization exists").Once()
+			},
+			expectedErr: utilserrors.NewConflict("organization exists"),
+		},
+		{
+			name: "Sad path - CreateOrganization returns error",
+			setup: func() {
+				s.mockOrganization.On("CreateOrganization", organization, uint64(1)).Return(errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+
+			organization, err := s.organizationImpl.CreateOrganization(s.ctx, req)
+			s.Equal(test.expectOrganization, organization)
+			s.Equal(test.expectedErr, err)
+
+			s.mockLang.AssertExpectations(s.T())
+			s.mockOrganization.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *OrganizationTestSuite) TestInviteMember() {
+	var (
+		req           = &protouser.InviteMemberRequest{UserId: "1", Id: "2", Email: "hello@goravel.dev"}
+		organization  = &models.Organization{UUIDModel: models.UUIDModel{ID: 2}, DisplayName: "Goravel"}
+		invitationURL = mock.MatchedBy(func(url string) bool {
+			return strings.HasPrefix(url, "https://market.goravel.dev/organizations/invitations?token=")
+		})
+		isInvitation = mock.MatchedBy(func(invitation *models.OrganizationInvitation) bool {
+			return invitation.OrganizationID == 2 && invitation.Email == "hello@goravel.dev" &&
+				invitation.Role == models.OrganizationRoleMember && invitation.InviterID == 1 &&
+				len(invitation.TokenHash) == 64 && invitation.ExpiresAt.Gt(carbon.Now())
+		})
+	)
+
+	tests := []struct {
+		name        string
+		setup       func()
+		expectedErr error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrganization.On("GetOrganizationByID", "2").Return(organization, nil).Once()
+				s.mockOrganization.On("GetMember", uint64(2), uint64(1)).Return(&models.OrganizationMember{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, Role: models.OrganizationRoleAdmin}, nil).Once()
+				s.mockConfig.On("GetInt", "user.organization.invitation_expire_hours", 168).Return(168).Once()
+				s.mockOrganization.On("CreateInvitation", isInvitation).Return(nil).Once()
+				s.mockConfig.On("GetString", "user.organization.invitation_url", "https://market.goravel.dev/organizations/invitations").
+					Return("https://market.goravel.dev/organizations/invitations").Once()
+				s.mockNotificationService.On("SendOrganizationInvitation", s.ctx, "hello@goravel.dev", "Goravel", invitationURL).Return(nil).Once()
+			},
+		},
+		{
+			name: "Sad path - organization doesn't exist",
+			setup: func() {
+				s.mockOrganization.On("GetOrganizationByID", "2").Return(&models.Organization{}, nil).Once()
+				s.mockLang.On("Get", "not_exist.organization").Return("organization not found").Once()
+			},
+			expectedErr: utilserrors.NewNotFound("organization not found"),
+		},
+		{
+			name: "Sad path - user isn't an admin",
+			setup: func() {
+				s.mockOrganization.On("GetOrganizationByID", "2").Return(organization, nil).Once()
+				s.mockOrganization.On("GetMember", uint64(2), uint64(1)).Return(&models.OrganizationMember{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, Role: models.OrganizationRoleMember}, nil).Once()
+				s.mockLang.On("Get", "forbidden.invite_member").Return("forbidden").Once()
+			},
+			expectedErr: utilserrors.NewUnauthorized("forbidden"),
+		},
+		{
+			name: "Sad path - user isn't a member",
+			setup: func() {
+				s.mockOrganization.On("GetOrganizationByID", "2").Return(organization, nil).Once()
+				s.mockOrganization.On("GetMember", uint64(2), uint64(1)).Return(&models.OrganizationMember{}, nil).Once()
+				s.mockLang.On("Get", "forbidden.invite_member").Return("forbidden").Once()
+			},
+			expectedErr: utilserrors.NewUnauthorized("forbidden"),
+		},
+		{
+			name: "Sad path - sending the invitation returns error",
+			setup: func() {
+				s.mockOrganization.On("GetOrganizationByID", "2").Return(organization, nil).Once()
+				s.mockOrganization.On("GetMember", uint64(2), uint64(1)).Return(&models.OrganizationMember{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, Role: models.OrganizationRoleOwner}, nil).Once()
+				s.mockConfig.On("GetInt", "user.organization.invitation_expire_hours", 168).Return(168).Once()
+				s.mockOrganization.On("CreateInvitation", isInvitation).Return(nil).Once()
+				s.mockConfig.On("GetString", "user.organization.invitation_url", "https://market.goravel.dev/organizations/invitations").
+					Return("https://market.goravel.dev/organizations/invitations").Once()
+				s.mockNotificationService.On("SendOrganizationInvitation", s.ctx, "hello@goravel.dev", "Goravel", invitationURL).Return(errors.New("error")).Once()
+				s.mockOrganization.On("DeleteInvitation", isInvitation).Return(nil).Once()
+			},
+			expectedErr: utilserrors.NewInternalServerError(errors.New("error")),
+		},
+		{
+			name: "Sad path - unsent invitation fails to be deleted",
+			setup: func() {
+				s.mockOrganization.On("GetOrganizationByID", "2").Return(organization, nil).Once()
+				s.mockOrganization.On("GetMember", uint64(2), uint64(1)).Return(&models.OrganizationMember{UUIDModel: models.UUIDModel{ID: 1}, UserID: 1, Role: models.OrganizationRoleOwner}, nil).Once()
+				s.mockConfig.On("GetInt", "user.organization.invitation_expire_hours", 168).Return(168).Once()
+				s.mockOrganization.On("CreateInvitation", isInvitation).Return(nil).Once()
+				s.mockConfig.On("GetString", "user.organization.invitation_url", "https://market.goravel.dev/organizations/invitations").
+					Return("https://market.goravel.dev/organizations/invitations").Once()
+				s.mockNotificationService.On("SendOrganizationInvitation", s.ctx, "hello@goravel.dev", "Goravel", invitationURL).Return(errors.New("error")).Once()
+				s.mockOrganization.On("DeleteInvitation", isInvitation).Return(errors.New("delete error")).Once()
+			},
+			expectedErr: utilserrors.NewInternalServerError(errors.New("error")),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+
+			invitation, err := s.organizationImpl.InviteMember(s.ctx, req)
+			s.Equal(test.expectedErr, err)
+			if test.expectedErr == nil {
+				s.Equal(uint64(2), invitation.OrganizationID)
+			}
+
+			s.mockConfig.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+			s.mockNotificationService.AssertExpectations(s.T())
+			s.mockOrganization.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *OrganizationTestSuite) TestListMembers() {
+	organization := &models.Organization{UUIDModel: models.UUIDModel{ID: 2}}
+	fields := []string{"id", "name", "avatar", "avatar_thumbnail", "username"}
+
+	tests := []struct {
+		name          string
+		setup         func()
+		expectMembers []*models.OrganizationMember
+		expectedErr   error
+	}{
+		{
+			name: "Happy path",
+			setup: func() {
+				s.mockOrganization.On("GetOrganizationByID", "2").Return(organization, nil).Once()
+				s.mockOrganization.On("GetMembers", uint64(2)).Return([]*models.OrganizationMember{
+					{UUIDModel: models.UUIDModel{ID: 1}, OrganizationID: 2, UserID: 1, Role: models.OrganizationRoleOwner},
+					{UUIDModel: models.UUIDModel{ID: 2}, OrganizationID: 2, UserID: 3, Role: models.OrganizationRoleMember},
+				}, nil).Once()
+				s.mockUser.On("GetUsers", []string{"1", "3"}, fields).Return([]*models.User{
+					{UUIDModel: models.UUIDModel{ID: 3}, Name: "world"},
+					{UUIDModel: models.UUIDModel{ID: 1}, Name: "hello"},
+				}, nil).Once()
+			},
+			expectMembers: []*models.OrganizationMember{
+				{UUIDModel: models.UUIDModel{ID: 1}, OrganizationID: 2, UserID: 1, Role: models.OrganizationRoleOwner, User: &models.User{UUIDModel: models.UUIDModel{ID: 1}, Name: "hello"}},
+				{UUIDModel: models.UUIDModel{ID: 2}, OrganizationID: 2, UserID: 3, Role: models.OrganizationRoleMember, User: &models.User{UUIDModel: models.UUIDModel{ID: 3}, Name: "world"}},
+			},
+		},
+		{
+			name: "Sad path - GetMembers returns error",
+			setup: func() {
+				s.mockOrganization.On("GetOrganizationByID", "2").Return(organization, nil).Once()
+				s.mockOrganization.On("GetMembers", uint64(2)).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			name: "Sad path - GetUsers returns error",
+			setup: func() {
+				s.mockOrganization.On("GetOrganizationByID", "2").Return(organization, nil).Once()
+				s.mockOrganization.On("GetMembers", uint64(2)).Return([]*models.OrganizationMember{
+					{UUIDModel: models.UUIDModel{ID: 1}, OrganizationID: 2, UserID: 1, Role: models.OrganizationRoleOwner},
+				}, nil).Once()
+				s.mockUser.On("GetUsers", []string{"1"}, fields).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+
+			members, err := s.organizationImpl.ListMembers(s.ctx, "2")
+			s.Equal(test.expectMembers, members)
+			s.Equal(test.expectedErr, err)
+
+			s.mockOrganization.AssertExpectations(s.T())
+			s.mockUser.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *OrganizationTestSuite) TestInvitationURL() {
+	s.mockConfig.On("GetString", "user.organization.invitation_url", "https://market.goravel.dev/organizations/invitations").
+		Return("https://market.goravel.dev/invitations?lang=en").Once()
+
+	s.Equal("https://market.goravel.dev/invitations?lang=en&token=token", invitationURL("token"))
+}
@@ -2,6 +2,9 @@ package services
 
 import (
 	"context"
+	"errors"
+	"strconv"
+	"strings"
 
 	"github.com/goravel/framework/facades"
 	"github.com/spf13/cast"
@@ -9,10 +12,12 @@ import (
 	protouser "market.goravel.dev/proto/user"
 	"market.goravel.dev/user/app/models"
 	utilerrors "market.goravel.dev/utils/errors"
+	utilsfieldmask "market.goravel.dev/utils/fieldmask"
 )
 
 type User interface {
 	GetUserByEmail(email string) (*models.User, error)
+	GetPublicProfile(ctx context.Context, id string) (*models.User, error)
 	GetUserByID(id string) (*models.User, error)
 	GetUsers(ids []string) ([]*models.User, error)
 	IsEmailExist(email string) (bool, error)
@@ -31,15 +36,40 @@ func NewUserImpl() *UserImpl {
 }
 
 func (r *UserImpl) GetUserByEmail(email string) (*models.User, error) {
-	return r.userModel.GetUserByEmail(email, []string{"id", "name", "email", "password", "avatar", "summary"})
+	return r.userModel.GetUserByEmail(email, []string{"id", "name", "email", "password", "avatar", "avatar_thumbnail", "summary", "username"})
+}
+
+// GetPublicProfile gets the user shown on the public profile by the ID or the username, only the public fields are
+// read. The usernames start with a letter, so they never look like an ID.
+func (r *UserImpl) GetPublicProfile(ctx context.Context, id string) (*models.User, error) {
+	fields := []string{"id", "username", "name", "avatar", "avatar_thumbnail", "summary", "created_at"}
+
+	var (
+		user *models.User
+		err  error
+	)
+	if _, parseErr := strconv.ParseUint(id, 10, 64); parseErr == nil {
+		user, err = r.userModel.GetUserByID(id, fields)
+	} else {
+		user, err = r.userModel.GetUserByUsername(strings.ToLower(id), fields)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if user.ID == 0 {
+		return nil, utilerrors.NewNotFound(facades.Lang(ctx).Get("not_exist.user"))
+	}
+
+	return user, nil
 }
 
 func (r *UserImpl) GetUserByID(id string) (*models.User, error) {
-	return r.userModel.GetUserByID(id, []string{"id", "name", "avatar", "summary"})
+	return r.userModel.GetUserByID(id, []string{"id", "name", "avatar", "avatar_thumbnail", "summary", "revision", "username"})
 }
 
 func (r *UserImpl) GetUsers(ids []string) ([]*models.User, error) {
-	return r.userModel.GetUsers(ids, []string{"id", "name", "avatar"})
+	return r.userModel.GetUsers(ids, []string{"id", "name", "avatar", "avatar_thumbnail", "username"})
 }
 
 func (r *UserImpl) IsEmailExist(email string) (bool, error) {
@@ -69,12 +99,33 @@ func (r *UserImpl) UpdateUser(ctx context.Context, req *protouser.UpdateUserRequ
 		return nil, utilerrors.NewUnauthorized(facades.Lang(ctx).Get("forbidden.update_user"))
 	}
 
-	user.Name = req.GetName()
-	user.Avatar = req.GetAvatar()
-	user.Summary = req.GetSummary()
+	// The user was read by the client before the update, the changes made since would be overwritten.
+	if req.GetRevision() != 0 && req.GetRevision() != user.Revision {
+		return nil, utilerrors.NewConflict(facades.Lang(ctx).Get("conflict.user"))
+	}
+
+	// Only the fields in the update mask are changed, all of them are changed without a mask.
+	fields := utilsfieldmask.New(req.GetUpdateMask())
+	if fields.Has("name") {
+		user.Name = req.GetName()
+	}
+	// The omitted avatar is kept without a mask, but the masked empty avatar removes it.
+	previousAvatar, previousAvatarThumbnail := user.Avatar, user.AvatarThumbnail
+	if avatar := req.GetAvatar(); (fields["avatar"] || len(fields) == 0 && avatar != "") && avatar != previousAvatar {
+		// The thumbnail belongs to the uploaded avatar, a linked avatar has none.
+		user.Avatar = avatar
+		user.AvatarThumbnail = ""
+	}
+	if fields.Has("summary") {
+		user.Summary = req.GetSummary()
+	}
+	// The omitted username is kept without a mask, but the masked empty username clears it.
+	if username := req.GetUsername(); fields["username"] || len(fields) == 0 && username != "" {
+		user.Username = strings.ToLower(username)
+	}
 
 	password := req.GetPassword()
-	if password != "" {
+	if fields.Has("password") && password != "" {
 		hashedPassword, err := facades.Hash().Make(req.GetPassword())
 		if err != nil {
 			return nil, utilerrors.NewInternalServerError(err)
@@ -83,8 +134,25 @@ func (r *UserImpl) UpdateUser(ctx context.Context, req *protouser.UpdateUserRequ
 	}
 
 	if err := r.userModel.UpdateUser(user); err != nil {
-		return nil, err
+		return nil, userConflictError(ctx, err)
+	}
+
+	if user.Avatar != previousAvatar {
+		deleteAvatar(user.ID, previousAvatar, previousAvatarThumbnail)
 	}
 
 	return user, nil
 }
+
+// userConflictError converts models.ErrUserChanged and models.ErrDuplicateUsername to conflict errors, the other
+// errors are kept.
+func userConflictError(ctx context.Context, err error) error {
+	if errors.Is(err, models.ErrUserChanged) {
+		return utilerrors.NewConflict(facades.Lang(ctx).Get("conflict.user"))
+	}
+	if errors.Is(err, models.ErrDuplicateUsername) {
+		return utilerrors.NewConflict(facades.Lang(ctx).Get("exist.username"))
+	}
+
+	return err
+}
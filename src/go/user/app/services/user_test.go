@@ -43,6 +43,65 @@ func (s *UserTestSuite) SetupTest() {
 	}
 }
 
+func (s *UserTestSuite) TestGetPublicProfile() {
+	fields := []string{"id", "username", "name", "avatar", "avatar_thumbnail", "summary", "created_at"}
+
+	tests := []struct {
+		name        string
+		id          string
+		setup       func()
+		expectUser  *models.User
+		expectedErr error
+	}{
+		{
+			name: "Happy path - get by ID",
+			id:   "1",
+			setup: func() {
+				s.mockUser.On("GetUserByID", "1", fields).Return(&models.User{UUIDModel: models.UUIDModel{ID: 1}, Username: "goravel"}, nil).Once()
+			},
+			expectUser: &models.User{UUIDModel: models.UUIDModel{ID: 1}, Username: "goravel"},
+		},
+		{
+			name: "Happy path - get by username",
+			id:   "Goravel",
+			setup: func() {
+				s.mockUser.On("GetUserByUsername", "goravel", fields).Return(&models.User{UUIDModel: models.UUIDModel{ID: 1}, Username: "goravel"}, nil).Once()
+			},
+			expectUser: &models.User{UUIDModel: models.UUIDModel{ID: 1}, Username: "goravel"},
+		},
+		{
+			name: "Sad path - user does not exist",
+			id:   "goravel",
+			setup: func() {
+				s.mockUser.On("GetUserByUsername", "goravel", fields).Return(&models.User{}, nil).Once()
+				s.mockLang.On("Get", "not_exist.user").Return("not_exist.user").Once()
+			},
+			expectedErr: utilserrors.NewNotFound("not_exist.user"),
+		},
+		{
+			name: "Sad path - GetUserByID returns error",
+			id:   "1",
+			setup: func() {
+				s.mockUser.On("GetUserByID", "1", fields).Return(nil, errors.New("error")).Once()
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.setup()
+
+			user, err := s.userImpl.GetPublicProfile(s.ctx, test.id)
+			s.Equal(test.expectUser, user)
+			s.Equal(test.expectedErr, err)
+
+			s.mockUser.AssertExpectations(s.T())
+			s.mockLang.AssertExpectations(s.T())
+		})
+	}
+}
+
 func (s *UserTestSuite) TestIsEmailExist() {
 	var (
 		email = "hello@goravel.dev"
@@ -120,6 +179,52 @@ func (s *UserTestSuite) TestUpdateUser() {
 			},
 			expectUser: &models.User{UUIDModel: models.UUIDModel{ID: 1}, Name: name, Password: password, Avatar: avatar, Summary: summary},
 		},
+		{
+			name: "Happy path - Username is stored in lowercase",
+			request: &protouser.UpdateUserRequest{
+				Id:       id,
+				UserId:   userID,
+				Name:     name,
+				Username: "Goravel",
+			},
+			setup: func() {
+				s.mockUser.On("GetUserByID", id, []string{}).Return(&models.User{UUIDModel: models.UUIDModel{ID: 1}}, nil).Once()
+				s.mockUser.On("UpdateUser", mock.MatchedBy(func(user *models.User) bool {
+					return user.Name == name && user.Username == "goravel"
+				})).Return(nil).Once()
+			},
+			expectUser: &models.User{UUIDModel: models.UUIDModel{ID: 1}, Name: name, Username: "goravel"},
+		},
+		{
+			name: "Happy path - Omitted username is kept",
+			request: &protouser.UpdateUserRequest{
+				Id:     id,
+				UserId: userID,
+				Name:   name,
+			},
+			setup: func() {
+				s.mockUser.On("GetUserByID", id, []string{}).Return(&models.User{UUIDModel: models.UUIDModel{ID: 1}, Username: "goravel"}, nil).Once()
+				s.mockUser.On("UpdateUser", mock.MatchedBy(func(user *models.User) bool {
+					return user.Name == name && user.Username == "goravel"
+				})).Return(nil).Once()
+			},
+			expectUser: &models.User{UUIDModel: models.UUIDModel{ID: 1}, Name: name, Username: "goravel"},
+		},
+		{
+			name: "Happy path - Omitted avatar is kept",
+			request: &protouser.UpdateUserRequest{
+				Id:     id,
+				UserId: userID,
+				Name:   name,
+			},
+			setup: func() {
+				s.mockUser.On("GetUserByID", id, []string{}).Return(&models.User{UUIDModel: models.UUIDModel{ID: 1}, Avatar: avatar, AvatarThumbnail: avatar}, nil).Once()
+				s.mockUser.On("UpdateUser", mock.MatchedBy(func(user *models.User) bool {
+					return user.Name == name && user.Avatar == avatar && user.AvatarThumbnail == avatar
+				})).Return(nil).Once()
+			},
+			expectUser: &models.User{UUIDModel: models.UUIDModel{ID: 1}, Name: name, Avatar: avatar, AvatarThumbnail: avatar},
+		},
 		{
 			name: "Happy path - GetUserByID returns error",
 			request: &protouser.UpdateUserRequest{
@@ -184,6 +289,50 @@ func (s *UserTestSuite) TestUpdateUser() {
 			},
 			expectedErr: errors.New("error"),
 		},
+		{
+			name: "Sad path - Revision is outdated",
+			request: &protouser.UpdateUserRequest{
+				Id:       id,
+				UserId:   userID,
+				Name:     name,
+				Revision: 1,
+			},
+			setup: func() {
+				s.mockUser.On("GetUserByID", id, []string{}).Return(&models.User{UUIDModel: models.UUIDModel{ID: 1}, Revision: 2}, nil).Once()
+				s.mockLang.On("Get", "conflict.user").Return("conflict.user").Once()
+			},
+			expectedErr: utilserrors.NewConflict("conflict.user"),
+		},
+		{
+			name: "Sad path - User is changed during the update",
+			request: &protouser.UpdateUserRequest{
+				Id:       id,
+				UserId:   userID,
+				Name:     name,
+				Revision: 2,
+			},
+			setup: func() {
+				s.mockUser.On("GetUserByID", id, []string{}).Return(&models.User{UUIDModel: models.UUIDModel{ID: 1}, Revision: 2}, nil).Once()
+				s.mockUser.On("UpdateUser", mock.AnythingOfType("*models.User")).Return(models.ErrUserChanged).Once()
+				s.mockLang.On("Get", "conflict.user").Return("conflict.user").Once()
+			},
+			expectedErr: utilserrors.NewConflict("conflict.user"),
+		},
+		{
+			name: "Sad path - Username is taken",
+			request: &protouser.UpdateUserRequest{
+				Id:       id,
+				UserId:   userID,
+				Name:     name,
+				Username: "goravel",
+			},
+			setup: func() {
+				s.mockUser.On("GetUserByID", id, []string{}).Return(&models.User{UUIDModel: models.UUIDModel{ID: 1}}, nil).Once()
+				s.mockUser.On("UpdateUser", mock.AnythingOfType("*models.User")).Return(models.ErrDuplicateUsername).Once()
+				s.mockLang.On("Get", "exist.username").Return("exist.username").Once()
+			},
+			expectedErr: utilserrors.NewConflict("exist.username"),
+		},
 		{
 			name: "Sad path - Password hashing error",
 			request: &protouser.UpdateUserRequest{
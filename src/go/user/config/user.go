@@ -0,0 +1,36 @@
+package config
+
+import (
+	"github.com/goravel/framework/facades"
+)
+
+func init() {
+	config := facades.Config()
+	config.Add("user", map[string]any{
+		// Avatar
+		//
+		// The uploaded avatars are stored on the disk, the images larger than
+		// max_size_kb or out of the dimensions are rejected. The thumbnails
+		// shown next to the packages and the comments are cropped to squares.
+		"avatar": map[string]any{
+			"disk":             config.Env("USER_AVATAR_DISK", "public"),
+			"max_size_kb":      config.Env("USER_AVATAR_MAX_SIZE_KB", 1024),
+			"min_width":        64,
+			"min_height":       64,
+			"max_width":        4096,
+			"max_height":       4096,
+			"thumbnail_width":  128,
+			"thumbnail_height": 128,
+		},
+
+		// Organization
+		//
+		// The invitations to the organizations are sent by email with a link
+		// to invitation_url, the token of the invitation is added to it as the
+		// "token" query. The invitations expire after invitation_expire_hours.
+		"organization": map[string]any{
+			"invitation_url":          config.Env("USER_ORGANIZATION_INVITATION_URL", "https://market.goravel.dev/organizations/invitations"),
+			"invitation_expire_hours": config.Env("USER_ORGANIZATION_INVITATION_EXPIRE_HOURS", 168),
+		},
+	})
+}
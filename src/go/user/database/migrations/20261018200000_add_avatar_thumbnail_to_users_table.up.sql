@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN avatar_thumbnail varchar(255) NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN revision bigint NOT NULL DEFAULT 1;
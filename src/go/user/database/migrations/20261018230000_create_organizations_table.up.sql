@@ -0,0 +1,41 @@
+CREATE TABLE organizations (
+  id bigint PRIMARY KEY,
+  name varchar(255) NOT NULL,
+  display_name varchar(255) NOT NULL,
+  avatar varchar(255) DEFAULT NULL,
+  summary varchar(255) DEFAULT NULL,
+  created_at timestamp NOT NULL,
+  updated_at timestamp NOT NULL,
+  deleted_at timestamp DEFAULT NULL
+);
+
+CREATE UNIQUE INDEX idx_unique_organization_name ON organizations(name);
+
+CREATE TABLE organization_members (
+  id bigint PRIMARY KEY,
+  organization_id bigint NOT NULL,
+  user_id bigint NOT NULL,
+  role varchar(16) NOT NULL,
+  created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE(organization_id, user_id)
+);
+
+CREATE INDEX organization_members_user_id_index ON organization_members (user_id);
+
+CREATE TABLE organization_invitations (
+  id bigint PRIMARY KEY,
+  organization_id bigint NOT NULL,
+  email varchar(255) NOT NULL,
+  role varchar(16) NOT NULL,
+  token_hash char(64) NOT NULL,
+  inviter_id bigint NOT NULL,
+  expires_at timestamp NOT NULL,
+  accepted_at timestamp DEFAULT NULL,
+  created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_unique_organization_invitation_token ON organization_invitations(token_hash);
+
+COMMENT ON COLUMN organization_invitations.token_hash IS 'the SHA-256 of the token sent in the invitation email, the token itself is not stored';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_unique_username;
+ALTER TABLE users DROP COLUMN IF EXISTS username;
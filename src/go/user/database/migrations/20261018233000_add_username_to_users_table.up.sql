@@ -0,0 +1,4 @@
+ALTER TABLE users ADD COLUMN username varchar(50) NOT NULL DEFAULT '';
+
+-- The users without a username don't conflict with each other.
+CREATE UNIQUE INDEX idx_unique_username ON users(username) WHERE username <> '';
@@ -45,10 +45,18 @@ func NewUnauthorized(message string) ErrorWithCode {
 	return New(http.StatusUnauthorized, message)
 }
 
+func NewForbidden(message string) ErrorWithCode {
+	return New(http.StatusForbidden, message)
+}
+
 func NewNotFound(message string) ErrorWithCode {
 	return New(http.StatusNotFound, message)
 }
 
+func NewConflict(message string) ErrorWithCode {
+	return New(http.StatusConflict, message)
+}
+
 func NewInternalServerError(err error) ErrorWithCode {
 	facades.Log().Errorf("internal server error: %+v", err)
 
@@ -0,0 +1,52 @@
+package etag
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// Format formats the revision of a resource as the value of the ETag header, e.g. "3" with the quotes.
+func Format(revision uint64) string {
+	return strconv.Quote(strconv.FormatUint(revision, 10))
+}
+
+// Parse parses the value of the If-Match header to the expected revision, it's 0 for an empty value and "*", which
+// match any revision. ok is false if the value isn't an ETag formatted by Format, including a weak ETag W/"3", since
+// If-Match uses the strong comparison.
+func Parse(value string) (revision uint64, ok bool) {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "*" {
+		return 0, true
+	}
+
+	unquoted, err := strconv.Unquote(value)
+	if err != nil {
+		return 0, false
+	}
+
+	revision, err = strconv.ParseUint(unquoted, 10, 64)
+	if err != nil || revision == 0 {
+		return 0, false
+	}
+
+	return revision, true
+}
+
+// IfMatch gets the expected revision of the request, the gateway forwards the If-Match header as the if-match
+// metadata.
+func IfMatch(ctx context.Context) (revision uint64, ok bool) {
+	md, exist := metadata.FromIncomingContext(ctx)
+	if !exist {
+		return 0, true
+	}
+
+	values := md.Get("if-match")
+	if len(values) == 0 {
+		return 0, true
+	}
+
+	return Parse(values[0])
+}
@@ -0,0 +1,85 @@
+package etag
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestFormat(t *testing.T) {
+	assert.Equal(t, `"3"`, Format(3))
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name           string
+		value          string
+		expectRevision uint64
+		expectOk       bool
+	}{
+		{
+			name:     "empty value matches any revision",
+			expectOk: true,
+		},
+		{
+			name:     "asterisk matches any revision",
+			value:    "*",
+			expectOk: true,
+		},
+		{
+			name:           "strong etag",
+			value:          `"3"`,
+			expectRevision: 3,
+			expectOk:       true,
+		},
+		{
+			name:           "strong etag with spaces",
+			value:          ` "3" `,
+			expectRevision: 3,
+			expectOk:       true,
+		},
+		{
+			name:  "weak etag",
+			value: `W/"3"`,
+		},
+		{
+			name:  "unquoted etag",
+			value: "3",
+		},
+		{
+			name:  "not a revision",
+			value: `"abc"`,
+		},
+		{
+			name:  "zero revision",
+			value: `"0"`,
+		},
+		{
+			name:  "several etags",
+			value: `"3", "4"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			revision, ok := Parse(test.value)
+			assert.Equal(t, test.expectRevision, revision)
+			assert.Equal(t, test.expectOk, ok)
+		})
+	}
+}
+
+func TestIfMatch(t *testing.T) {
+	revision, ok := IfMatch(context.Background())
+	assert.Equal(t, uint64(0), revision)
+	assert.True(t, ok)
+
+	revision, ok = IfMatch(metadata.NewIncomingContext(context.Background(), metadata.Pairs("if-match", `"5"`)))
+	assert.Equal(t, uint64(5), revision)
+	assert.True(t, ok)
+
+	_, ok = IfMatch(metadata.NewIncomingContext(context.Background(), metadata.Pairs("if-match", "5")))
+	assert.False(t, ok)
+}
@@ -0,0 +1,38 @@
+package fieldmask
+
+import "google.golang.org/protobuf/types/known/fieldmaskpb"
+
+// Mask is the set of the fields to update by their proto names. An empty mask means all the fields, so the clients
+// that don't send a mask keep replacing the whole resource.
+type Mask map[string]bool
+
+func New(mask *fieldmaskpb.FieldMask) Mask {
+	paths := mask.GetPaths()
+	fields := make(Mask, len(paths))
+	for _, path := range paths {
+		fields[path] = true
+	}
+
+	return fields
+}
+
+// Has reports whether the field should be updated.
+func (r Mask) Has(field string) bool {
+	return len(r) == 0 || r[field]
+}
+
+// Unknown returns the first path of the mask that isn't one of the fields, it's empty if all the paths are known.
+func Unknown(mask *fieldmaskpb.FieldMask, fields []string) string {
+	known := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		known[field] = true
+	}
+
+	for _, path := range mask.GetPaths() {
+		if !known[path] {
+			return path
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,54 @@
+package fieldmask
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestHas(t *testing.T) {
+	tests := []struct {
+		name   string
+		mask   *fieldmaskpb.FieldMask
+		field  string
+		expect bool
+	}{
+		{
+			name:   "nil mask has all the fields",
+			field:  "name",
+			expect: true,
+		},
+		{
+			name:   "empty mask has all the fields",
+			mask:   &fieldmaskpb.FieldMask{},
+			field:  "name",
+			expect: true,
+		},
+		{
+			name:   "masked field",
+			mask:   &fieldmaskpb.FieldMask{Paths: []string{"name", "summary"}},
+			field:  "summary",
+			expect: true,
+		},
+		{
+			name:  "unmasked field",
+			mask:  &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+			field: "summary",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, New(test.mask).Has(test.field))
+		})
+	}
+}
+
+func TestUnknown(t *testing.T) {
+	fields := []string{"name", "summary"}
+
+	assert.Equal(t, "", Unknown(nil, fields))
+	assert.Equal(t, "", Unknown(&fieldmaskpb.FieldMask{Paths: []string{"summary", "name"}}, fields))
+	assert.Equal(t, "user_id", Unknown(&fieldmaskpb.FieldMask{Paths: []string{"name", "user_id", "id"}}, fields))
+}
@@ -0,0 +1,233 @@
+package image
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/goravel/framework/contracts/filesystem"
+	"github.com/goravel/framework/contracts/translation"
+	"github.com/goravel/framework/facades"
+	"github.com/spf13/cast"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+
+	utilserrors "market.goravel.dev/utils/errors"
+)
+
+var (
+	ErrTooLarge          = errors.New("image is too large")
+	ErrUnsupportedType   = errors.New("image type is unsupported")
+	ErrInvalidDimensions = errors.New("image dimensions are out of range")
+
+	errInvalidThumbnailSize = errors.New("thumbnail size is invalid")
+)
+
+// supportedContentTypes are the sniffed content types of the images that can be uploaded, GIF isn't supported since
+// the animations would be lost.
+var supportedContentTypes = map[string]bool{"image/jpeg": true, "image/png": true, "image/webp": true}
+
+type Options struct {
+	// MaxSize is the maximum size of the uploaded file in bytes.
+	MaxSize   int
+	MinWidth  int
+	MinHeight int
+	MaxWidth  int
+	MaxHeight int
+	// The image is scaled to cover the thumbnail and the overflow is cropped, the smaller images aren't scaled up.
+	ThumbnailWidth  int
+	ThumbnailHeight int
+}
+
+type Image struct {
+	Content   []byte
+	Thumbnail []byte
+	// Extension is "jpg" for JPEG images and "png" for the others, WebP images are stored as PNG since there is no
+	// WebP encoder.
+	Extension string
+}
+
+// Process validates the uploaded image and encodes it again along with its thumbnail. Encoding the decoded pixels
+// drops the metadata of the file, so the EXIF data like the location of a photo isn't published.
+func Process(data []byte, options Options) (*Image, error) {
+	if len(data) > options.MaxSize {
+		return nil, ErrTooLarge
+	}
+	if !supportedContentTypes[http.DetectContentType(data)] {
+		return nil, ErrUnsupportedType
+	}
+
+	// The dimensions are checked before decoding, so a small file claiming huge dimensions isn't decoded.
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedType
+	}
+	if config.Width < options.MinWidth || config.Height < options.MinHeight ||
+		config.Width > options.MaxWidth || config.Height > options.MaxHeight {
+		return nil, ErrInvalidDimensions
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedType
+	}
+
+	extension := "png"
+	if format == "jpeg" {
+		extension = "jpg"
+	}
+
+	content, err := encode(img, extension)
+	if err != nil {
+		return nil, err
+	}
+
+	thumbnailImg, err := thumbnail(img, options.ThumbnailWidth, options.ThumbnailHeight)
+	if err != nil {
+		return nil, err
+	}
+	thumbnailContent, err := encode(thumbnailImg, extension)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Image{
+		Content:   content,
+		Thumbnail: thumbnailContent,
+		Extension: extension,
+	}, nil
+}
+
+// TranslateError converts the validation errors of Process to bad requests, the messages are the max.image,
+// invalid.image_type and invalid.image_dimensions translations of the service.
+func TranslateError(ctx context.Context, err error, options Options) error {
+	translate := facades.Lang(ctx)
+	switch {
+	case errors.Is(err, ErrTooLarge):
+		return utilserrors.NewBadRequest(translate.Get("max.image", translation.Option{
+			Replace: map[string]string{
+				"max": cast.ToString(options.MaxSize / 1024),
+			},
+		}))
+	case errors.Is(err, ErrUnsupportedType):
+		return utilserrors.NewBadRequest(translate.Get("invalid.image_type"))
+	case errors.Is(err, ErrInvalidDimensions):
+		return utilserrors.NewBadRequest(translate.Get("invalid.image_dimensions", translation.Option{
+			Replace: map[string]string{
+				"min_width":  cast.ToString(options.MinWidth),
+				"min_height": cast.ToString(options.MinHeight),
+				"max_width":  cast.ToString(options.MaxWidth),
+				"max_height": cast.ToString(options.MaxHeight),
+			},
+		}))
+	default:
+		return utilserrors.NewInternalServerError(err)
+	}
+}
+
+// Store stores the image and its thumbnail in the directory of the disk and returns their URLs. The names are random,
+// so a replaced image cached by the browsers isn't served under the same URL.
+func Store(disk filesystem.Driver, dir string, img *Image) (url, thumbnailURL string, err error) {
+	name, err := randomName()
+	if err != nil {
+		return "", "", err
+	}
+
+	file := path.Join(dir, name+"."+img.Extension)
+	thumbnailFile := path.Join(dir, name+"_thumb."+img.Extension)
+	if err := disk.Put(file, string(img.Content)); err != nil {
+		return "", "", err
+	}
+	if err := disk.Put(thumbnailFile, string(img.Thumbnail)); err != nil {
+		_ = disk.Delete(file)
+
+		return "", "", err
+	}
+
+	return disk.Url(file), disk.Url(thumbnailFile), nil
+}
+
+// Delete deletes the stored images of the URLs in the directory of the disk. The other URLs are skipped, e.g. the
+// images hosted somewhere else, or the URLs pointing outside the directory since the URLs may be set by the users.
+func Delete(disk filesystem.Driver, dir string, urls ...string) error {
+	prefix := disk.Url(dir)
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	var files []string
+	for _, url := range urls {
+		name, ok := strings.CutPrefix(url, prefix)
+		if !ok || name == "" || strings.ContainsAny(name, `/\`) || name == "." || name == ".." {
+			continue
+		}
+
+		files = append(files, path.Join(dir, name))
+	}
+
+	if len(files) == 0 {
+		return nil
+	}
+
+	return disk.Delete(files...)
+}
+
+func encode(img image.Image, extension string) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	if extension == "jpg" {
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 90})
+	} else {
+		err = png.Encode(&buf, img)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func randomName() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// thumbnail crops the centered region of the image with the aspect ratio of the thumbnail and scales it down to the
+// thumbnail size.
+func thumbnail(img image.Image, width, height int) (image.Image, error) {
+	bounds := img.Bounds()
+	if width <= 0 || height <= 0 {
+		return nil, errInvalidThumbnailSize
+	}
+	if bounds.Dx() == 0 || bounds.Dy() == 0 {
+		return nil, ErrInvalidDimensions
+	}
+
+	cropWidth, cropHeight := bounds.Dx(), bounds.Dx()*height/width
+	if cropHeight > bounds.Dy() {
+		cropWidth, cropHeight = bounds.Dy()*width/height, bounds.Dy()
+	}
+	x := bounds.Min.X + (bounds.Dx()-cropWidth)/2
+	y := bounds.Min.Y + (bounds.Dy()-cropHeight)/2
+
+	if cropWidth < width {
+		width, height = max(cropWidth, 1), max(cropHeight, 1)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, image.Rect(x, y, x+cropWidth, y+cropHeight), draw.Src, nil)
+
+	return dst, nil
+}
@@ -0,0 +1,228 @@
+package image
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	mocksfilesystem "github.com/goravel/framework/mocks/filesystem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var options = Options{
+	MaxSize:         1 << 20,
+	MinWidth:        10,
+	MinHeight:       10,
+	MaxWidth:        1000,
+	MaxHeight:       1000,
+	ThumbnailWidth:  40,
+	ThumbnailHeight: 20,
+}
+
+func newImage(width, height int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 100, A: 255})
+		}
+	}
+
+	return img
+}
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, newImage(width, height)))
+
+	return buf.Bytes()
+}
+
+// encodeJPEG encodes a JPEG carrying an EXIF segment right after the start of image marker.
+func encodeJPEG(t *testing.T, width, height int) []byte {
+	var buf bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&buf, newImage(width, height), nil))
+
+	exif := append([]byte{0xFF, 0xE1, 0x00, 0x10}, []byte("Exif\x00\x00GPS-DATA")...)
+	data := buf.Bytes()
+
+	return append(append(append([]byte{}, data[:2]...), exif...), data[2:]...)
+}
+
+func TestProcess(t *testing.T) {
+	var gifData bytes.Buffer
+	assert.NoError(t, gif.Encode(&gifData, newImage(100, 100), nil))
+
+	tests := []struct {
+		name                  string
+		data                  []byte
+		expectExtension       string
+		expectWidth           int
+		expectHeight          int
+		expectThumbnailWidth  int
+		expectThumbnailHeight int
+		expectErr             error
+	}{
+		{
+			name:                  "PNG",
+			data:                  encodePNG(t, 200, 200),
+			expectExtension:       "png",
+			expectWidth:           200,
+			expectHeight:          200,
+			expectThumbnailWidth:  40,
+			expectThumbnailHeight: 20,
+		},
+		{
+			name:                  "JPEG",
+			data:                  encodeJPEG(t, 100, 300),
+			expectExtension:       "jpg",
+			expectWidth:           100,
+			expectHeight:          300,
+			expectThumbnailWidth:  40,
+			expectThumbnailHeight: 20,
+		},
+		{
+			name:                  "small image isn't scaled up",
+			data:                  encodePNG(t, 30, 30),
+			expectExtension:       "png",
+			expectWidth:           30,
+			expectHeight:          30,
+			expectThumbnailWidth:  30,
+			expectThumbnailHeight: 15,
+		},
+		{
+			name:      "too large",
+			data:      make([]byte, options.MaxSize+1),
+			expectErr: ErrTooLarge,
+		},
+		{
+			name:      "empty",
+			expectErr: ErrUnsupportedType,
+		},
+		{
+			name:      "not an image",
+			data:      []byte("<svg></svg>"),
+			expectErr: ErrUnsupportedType,
+		},
+		{
+			name:      "GIF",
+			data:      gifData.Bytes(),
+			expectErr: ErrUnsupportedType,
+		},
+		{
+			name:      "too small",
+			data:      encodePNG(t, 5, 100),
+			expectErr: ErrInvalidDimensions,
+		},
+		{
+			name:      "too big",
+			data:      encodePNG(t, 1001, 100),
+			expectErr: ErrInvalidDimensions,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			img, err := Process(test.data, options)
+			assert.Equal(t, test.expectErr, err)
+			if test.expectErr != nil {
+				assert.Nil(t, img)
+
+				return
+			}
+
+			assert.Equal(t, test.expectExtension, img.Extension)
+			assert.NotContains(t, string(img.Content), "Exif")
+
+			config, _, err := image.DecodeConfig(bytes.NewReader(img.Content))
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectWidth, config.Width)
+			assert.Equal(t, test.expectHeight, config.Height)
+
+			config, _, err = image.DecodeConfig(bytes.NewReader(img.Thumbnail))
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectThumbnailWidth, config.Width)
+			assert.Equal(t, test.expectThumbnailHeight, config.Height)
+		})
+	}
+}
+
+func TestStore(t *testing.T) {
+	img := &Image{Content: []byte("content"), Thumbnail: []byte("thumbnail"), Extension: "png"}
+	isFile := func(suffix string) any {
+		return mock.MatchedBy(func(file string) bool {
+			return len(file) == len("covers/1/")+32+len(suffix) && file[:9] == "covers/1/" && file[len(file)-len(suffix):] == suffix
+		})
+	}
+
+	t.Run("Happy path", func(t *testing.T) {
+		disk := &mocksfilesystem.Driver{}
+		disk.On("Put", isFile(".png"), "content").Return(nil).Once()
+		disk.On("Put", isFile("_thumb.png"), "thumbnail").Return(nil).Once()
+		disk.On("Url", mock.Anything).Return(func(file string) string {
+			return "https://goravel.dev/storage/" + file
+		}).Twice()
+
+		url, thumbnailURL, err := Store(disk, "covers/1", img)
+		assert.NoError(t, err)
+		assert.Regexp(t, `^https://goravel.dev/storage/covers/1/[0-9a-f]{32}\.png$`, url)
+		assert.Equal(t, url[:len(url)-len(".png")]+"_thumb.png", thumbnailURL)
+		disk.AssertExpectations(t)
+	})
+
+	t.Run("Sad path - storing the thumbnail fails", func(t *testing.T) {
+		disk := &mocksfilesystem.Driver{}
+		disk.On("Put", isFile(".png"), "content").Return(nil).Once()
+		disk.On("Put", isFile("_thumb.png"), "thumbnail").Return(errors.New("error")).Once()
+		disk.On("Delete", isFile(".png")).Return(nil).Once()
+
+		url, thumbnailURL, err := Store(disk, "covers/1", img)
+		assert.Equal(t, errors.New("error"), err)
+		assert.Empty(t, url)
+		assert.Empty(t, thumbnailURL)
+		disk.AssertExpectations(t)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	tests := []struct {
+		name        string
+		urls        []string
+		expectFiles []string
+	}{
+		{
+			name:        "stored images",
+			urls:        []string{"https://goravel.dev/storage/covers/1/a.png", "https://goravel.dev/storage/covers/1/a_thumb.png"},
+			expectFiles: []string{"covers/1/a.png", "covers/1/a_thumb.png"},
+		},
+		{
+			name: "other URLs are skipped",
+			urls: []string{
+				"",
+				"https://example.com/cover.png",
+				"https://goravel.dev/storage/covers/2/a.png",
+				"https://goravel.dev/storage/covers/1/../2/a.png",
+				"https://goravel.dev/storage/covers/1/",
+				"https://goravel.dev/storage/covers/10/a.png",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			disk := &mocksfilesystem.Driver{}
+			disk.On("Url", "covers/1").Return("https://goravel.dev/storage/covers/1").Once()
+			if len(test.expectFiles) > 0 {
+				disk.On("Delete", test.expectFiles[0], test.expectFiles[1]).Return(nil).Once()
+			}
+
+			assert.NoError(t, Delete(disk, "covers/1", test.urls...))
+			disk.AssertExpectations(t)
+		})
+	}
+}
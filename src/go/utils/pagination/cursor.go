@@ -0,0 +1,75 @@
+package pagination
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor is the position of the last item of a page, it's encoded into an opaque string, so the clients can't rely on
+// its content. The order of the page is saved as well, the cursor is invalid for the other orders.
+type Cursor struct {
+	// ID is the ID of the last item.
+	ID uint64 `json:"i,omitempty"`
+	// Value is the value of the ordered column of the last item.
+	Value any `json:"v,omitempty"`
+	// Offset is the offset of the next page, it's only used by the orders that can't be paginated by keyset.
+	Offset int `json:"o,omitempty"`
+	// Sort is the ordered column of the page.
+	Sort string `json:"s,omitempty"`
+	// Desc is the direction of the page.
+	Desc bool `json:"d,omitempty"`
+}
+
+// matches reports whether the cursor was made for the order, an offset cursor is only valid for the orders that are
+// paginated by offset and a keyset cursor for the others.
+func (r *Cursor) matches(order *Order) bool {
+	if order == nil {
+		return r.ID == 0 && r.Sort == "" && !r.Desc
+	}
+
+	return (r.ID == 0) == order.ByOffset && r.Sort == order.Column && r.Desc == order.Desc
+}
+
+func EncodeCursor(cursor Cursor) string {
+	data, _ := json.Marshal(cursor)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor decodes a cursor encoded by EncodeCursor, it returns ErrInvalidCursor if the cursor is malformed.
+func DecodeCursor(cursor string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var decoded Cursor
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&decoded); err != nil || decoded.Offset < 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	// Numbers are kept as integers where possible, float64 would lose the precision of large values.
+	if number, ok := decoded.Value.(json.Number); ok {
+		if value, err := number.Int64(); err == nil {
+			decoded.Value = value
+		} else if value, err := number.Float64(); err == nil {
+			decoded.Value = value
+		} else {
+			return nil, ErrInvalidCursor
+		}
+	}
+
+	switch decoded.Value.(type) {
+	case nil, string, int64, float64, bool:
+	default:
+		return nil, ErrInvalidCursor
+	}
+
+	return &decoded, nil
+}
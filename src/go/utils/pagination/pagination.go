@@ -2,9 +2,36 @@ package pagination
 
 import protobase "market.goravel.dev/proto/base"
 
+const (
+	DefaultLimit = 10
+	// MaxLimit is the max number of items of a page, larger limits are reduced to it.
+	MaxLimit = 100
+)
+
 func Default() *protobase.Pagination {
 	return &protobase.Pagination{
 		Page:  1,
-		Limit: 10,
+		Limit: DefaultLimit,
+	}
+}
+
+// Normalize fills the default page and limit of the pagination and caps the limit at MaxLimit.
+func Normalize(pagination *protobase.Pagination) *protobase.Pagination {
+	if pagination == nil {
+		return Default()
+	}
+
+	if pagination.GetPage() <= 0 {
+		pagination.Page = 1
 	}
+
+	if pagination.GetLimit() <= 0 {
+		pagination.Limit = DefaultLimit
+	}
+
+	if pagination.GetLimit() > MaxLimit {
+		pagination.Limit = MaxLimit
+	}
+
+	return pagination
 }
@@ -0,0 +1,306 @@
+package pagination
+
+import (
+	"errors"
+	"testing"
+
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	protobase "market.goravel.dev/proto/base"
+)
+
+type item struct {
+	ID    uint64
+	Name  string
+	Views int64
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name       string
+		pagination *protobase.Pagination
+		expect     *protobase.Pagination
+	}{
+		{
+			name:   "nil pagination",
+			expect: &protobase.Pagination{Page: 1, Limit: 10},
+		},
+		{
+			name:       "empty pagination",
+			pagination: &protobase.Pagination{},
+			expect:     &protobase.Pagination{Page: 1, Limit: 10},
+		},
+		{
+			name:       "limit is capped",
+			pagination: &protobase.Pagination{Page: 2, Limit: 1000, Cursor: "cursor"},
+			expect:     &protobase.Pagination{Page: 2, Limit: 100, Cursor: "cursor"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, Normalize(test.pagination))
+		})
+	}
+}
+
+func TestCursor(t *testing.T) {
+	tests := []struct {
+		name      string
+		cursor    string
+		expect    *Cursor
+		expectErr error
+	}{
+		{
+			name:   "ID only",
+			cursor: EncodeCursor(Cursor{ID: 1844674407370955161}),
+			expect: &Cursor{ID: 1844674407370955161},
+		},
+		{
+			name:   "integer value",
+			cursor: EncodeCursor(Cursor{ID: 1, Value: 9007199254740993}),
+			expect: &Cursor{ID: 1, Value: int64(9007199254740993)},
+		},
+		{
+			name:   "string value",
+			cursor: EncodeCursor(Cursor{ID: 1, Value: "goravel"}),
+			expect: &Cursor{ID: 1, Value: "goravel"},
+		},
+		{
+			name:   "offset",
+			cursor: EncodeCursor(Cursor{Offset: 20}),
+			expect: &Cursor{Offset: 20},
+		},
+		{
+			name:   "sort and direction",
+			cursor: EncodeCursor(Cursor{ID: 1, Value: "goravel", Sort: "name", Desc: true}),
+			expect: &Cursor{ID: 1, Value: "goravel", Sort: "name", Desc: true},
+		},
+		{
+			name:      "not base64",
+			cursor:    "!",
+			expectErr: ErrInvalidCursor,
+		},
+		{
+			name:      "not json",
+			cursor:    "Z29yYXZlbA",
+			expectErr: ErrInvalidCursor,
+		},
+		{
+			name:      "value isn't scalar",
+			cursor:    EncodeCursor(Cursor{ID: 1, Value: []string{"goravel"}}),
+			expectErr: ErrInvalidCursor,
+		},
+		{
+			name:      "negative offset",
+			cursor:    EncodeCursor(Cursor{Offset: -1}),
+			expectErr: ErrInvalidCursor,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cursor, err := DecodeCursor(test.cursor)
+			assert.Equal(t, test.expect, cursor)
+			assert.Equal(t, test.expectErr, err)
+		})
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	var (
+		mockOrmQuery *mocksorm.Query
+		key          = func(item *item) (any, uint64) {
+			return item.Views, item.ID
+		}
+	)
+
+	tests := []struct {
+		name         string
+		pagination   *protobase.Pagination
+		order        *Order
+		setup        func()
+		expectItems  []*item
+		expectTotal  int64
+		expectCursor string
+		expectErr    error
+	}{
+		{
+			name:       "offset pagination with the next page",
+			pagination: &protobase.Pagination{Page: 1, Limit: 2},
+			order:      &Order{Column: "views", Desc: true},
+			setup: func() {
+				mockOrmQuery.On("Order", "views DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "id DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Paginate", 1, 2, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					*args.Get(2).(*[]*item) = []*item{{ID: 3, Views: 30}, {ID: 2, Views: 20}}
+					*args.Get(3).(*int64) = 3
+				}).Return(nil).Once()
+			},
+			expectItems:  []*item{{ID: 3, Views: 30}, {ID: 2, Views: 20}},
+			expectTotal:  3,
+			expectCursor: EncodeCursor(Cursor{ID: 2, Value: 20, Sort: "views", Desc: true}),
+		},
+		{
+			name:       "offset pagination on the last page",
+			pagination: &protobase.Pagination{Page: 2, Limit: 2},
+			order:      &Order{},
+			setup: func() {
+				mockOrmQuery.On("Order", "id ASC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Paginate", 2, 2, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					*args.Get(2).(*[]*item) = []*item{{ID: 3}}
+					*args.Get(3).(*int64) = 3
+				}).Return(nil).Once()
+			},
+			expectItems: []*item{{ID: 3}},
+			expectTotal: 3,
+		},
+		{
+			name:       "offset pagination ordered by the query",
+			pagination: &protobase.Pagination{Page: 1, Limit: 1},
+			setup: func() {
+				mockOrmQuery.On("Paginate", 1, 1, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					*args.Get(2).(*[]*item) = []*item{{ID: 3}}
+					*args.Get(3).(*int64) = 3
+				}).Return(nil).Once()
+			},
+			expectItems:  []*item{{ID: 3}},
+			expectTotal:  3,
+			expectCursor: EncodeCursor(Cursor{Offset: 1}),
+		},
+		{
+			name:       "keyset pagination by ID",
+			pagination: &protobase.Pagination{Limit: 2, Cursor: EncodeCursor(Cursor{ID: 5, Desc: true})},
+			order:      &Order{Desc: true},
+			setup: func() {
+				mockOrmQuery.On("Where", "id < ?", uint64(5)).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "id DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Limit", 3).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Find", mock.Anything).Run(func(args mock.Arguments) {
+					*args.Get(0).(*[]*item) = []*item{{ID: 4, Views: 40}, {ID: 3, Views: 30}, {ID: 2, Views: 20}}
+				}).Return(nil).Once()
+			},
+			expectItems:  []*item{{ID: 4, Views: 40}, {ID: 3, Views: 30}},
+			expectCursor: EncodeCursor(Cursor{ID: 3, Desc: true}),
+		},
+		{
+			name:       "keyset pagination by column on the last page",
+			pagination: &protobase.Pagination{Limit: 2, Cursor: EncodeCursor(Cursor{ID: 5, Value: 50, Sort: "views"})},
+			order:      &Order{Column: "views"},
+			setup: func() {
+				mockOrmQuery.On("Where", "(views > ? OR (views = ? AND id > ?))", int64(50), int64(50), uint64(5)).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "views ASC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "id ASC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Limit", 3).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Find", mock.Anything).Run(func(args mock.Arguments) {
+					*args.Get(0).(*[]*item) = []*item{{ID: 6, Views: 60}}
+				}).Return(nil).Once()
+			},
+			expectItems: []*item{{ID: 6, Views: 60}},
+		},
+		{
+			name:       "keyset pagination by column with a qualified ID column",
+			pagination: &protobase.Pagination{Limit: 2, Cursor: EncodeCursor(Cursor{ID: 5, Value: 50, Sort: "items.views", Desc: true})},
+			order:      &Order{Column: "items.views", IDColumn: "items.id", Desc: true},
+			setup: func() {
+				mockOrmQuery.On("Where", "(items.views < ? OR (items.views = ? AND items.id < ?))", int64(50), int64(50), uint64(5)).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "items.views DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "items.id DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Limit", 3).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Find", mock.Anything).Run(func(args mock.Arguments) {
+					*args.Get(0).(*[]*item) = []*item{{ID: 4, Views: 40}}
+				}).Return(nil).Once()
+			},
+			expectItems: []*item{{ID: 4, Views: 40}},
+		},
+		{
+			name:       "offset saved in the cursor",
+			pagination: &protobase.Pagination{Limit: 1, Cursor: EncodeCursor(Cursor{Offset: 1})},
+			setup: func() {
+				mockOrmQuery.On("Offset", 1).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Limit", 2).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Find", mock.Anything).Run(func(args mock.Arguments) {
+					*args.Get(0).(*[]*item) = []*item{{ID: 2}, {ID: 1}}
+				}).Return(nil).Once()
+			},
+			expectItems:  []*item{{ID: 2}},
+			expectCursor: EncodeCursor(Cursor{Offset: 2}),
+		},
+		{
+			name:       "offset saved in the cursor of an order by offset",
+			pagination: &protobase.Pagination{Limit: 1, Cursor: EncodeCursor(Cursor{Offset: 1, Sort: "views", Desc: true})},
+			order:      &Order{Column: "views", Desc: true, ByOffset: true},
+			setup: func() {
+				mockOrmQuery.On("Order", "views DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "id DESC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Offset", 1).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Limit", 2).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Find", mock.Anything).Run(func(args mock.Arguments) {
+					*args.Get(0).(*[]*item) = []*item{{ID: 2, Views: 20}, {ID: 1, Views: 10}}
+				}).Return(nil).Once()
+			},
+			expectItems:  []*item{{ID: 2, Views: 20}},
+			expectCursor: EncodeCursor(Cursor{Offset: 2, Sort: "views", Desc: true}),
+		},
+		{
+			name:       "cursor of another column",
+			pagination: &protobase.Pagination{Limit: 1, Cursor: EncodeCursor(Cursor{ID: 5, Value: 50, Sort: "views", Desc: true})},
+			order:      &Order{Column: "favorites", Desc: true},
+			setup:      func() {},
+			expectErr:  ErrInvalidCursor,
+		},
+		{
+			name:       "cursor of another direction",
+			pagination: &protobase.Pagination{Limit: 1, Cursor: EncodeCursor(Cursor{ID: 5, Value: 50, Sort: "views", Desc: true})},
+			order:      &Order{Column: "views"},
+			setup:      func() {},
+			expectErr:  ErrInvalidCursor,
+		},
+		{
+			name:       "offset cursor of a keyset order",
+			pagination: &protobase.Pagination{Limit: 1, Cursor: EncodeCursor(Cursor{Offset: 1, Sort: "views", Desc: true})},
+			order:      &Order{Column: "views", Desc: true},
+			setup:      func() {},
+			expectErr:  ErrInvalidCursor,
+		},
+		{
+			name:       "invalid cursor",
+			pagination: &protobase.Pagination{Limit: 1, Cursor: "invalid"},
+			setup:      func() {},
+			expectErr:  ErrInvalidCursor,
+		},
+		{
+			name:       "Find returns error",
+			pagination: &protobase.Pagination{Limit: 1, Cursor: EncodeCursor(Cursor{ID: 1})},
+			order:      &Order{},
+			setup: func() {
+				mockOrmQuery.On("Where", "id > ?", uint64(1)).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Order", "id ASC").Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Limit", 2).Return(mockOrmQuery).Once()
+				mockOrmQuery.On("Find", mock.Anything).Return(errors.New("error")).Once()
+			},
+			expectErr: errors.New("error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockOrmQuery = &mocksorm.Query{}
+			test.setup()
+
+			var (
+				items []*item
+				total int64
+			)
+			cursor, err := Paginate(mockOrmQuery, test.pagination, test.order, key, &items, &total)
+			assert.Equal(t, test.expectItems, items)
+			assert.Equal(t, test.expectTotal, total)
+			assert.Equal(t, test.expectCursor, cursor)
+			assert.Equal(t, test.expectErr, err)
+
+			mockOrmQuery.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package pagination
+
+import (
+	"fmt"
+
+	"github.com/goravel/framework/contracts/database/orm"
+
+	protobase "market.goravel.dev/proto/base"
+)
+
+// Order is the order of a keyset pagination. The items are ordered by the column and then by ID in the same
+// direction, the ID keeps the order stable for the items with the same value.
+type Order struct {
+	// Column is the column or the expression to order by, the items are only ordered by ID if it's empty. It must not
+	// be NULL, wrap nullable columns with COALESCE.
+	Column string
+	// IDColumn is the column of the IDs, it defaults to id, e.g. it's qualified in the joined queries.
+	IDColumn string
+	Desc     bool
+	// ByOffset paginates the cursors by offset instead of keyset, it's for the queries that are ordered by something
+	// else first, e.g. by relevance, the order is applied after it.
+	ByOffset bool
+}
+
+// OrderBy orders the query by the order.
+func OrderBy(query orm.Query, order Order) orm.Query {
+	direction := "ASC"
+	if order.Desc {
+		direction = "DESC"
+	}
+
+	if order.Column != "" {
+		query = query.Order(order.Column + " " + direction)
+	}
+
+	return query.Order(order.idColumn() + " " + direction)
+}
+
+func (r Order) idColumn() string {
+	if r.IDColumn == "" {
+		return "id"
+	}
+
+	return r.IDColumn
+}
+
+// Paginate gets a page of the items of the query into dest and returns the cursor of the next page, the cursor is
+// empty on the last page. key returns the ordered value and the ID of an item, they make up the cursor.
+//
+// The pagination without a cursor is paginated by offset and counts the total, it's kept for the clients that jump
+// to a page. The pagination with a cursor doesn't count the total, it's paginated by keyset if the order is given, or
+// by the offset saved in the cursor for the queries that order by themselves and the orders by offset. It returns
+// ErrInvalidCursor if the cursor is malformed or was made for another order.
+func Paginate[T any](query orm.Query, pagination *protobase.Pagination, order *Order, key func(T) (any, uint64), dest *[]T, total *int64) (string, error) {
+	limit := int(pagination.GetLimit())
+
+	if pagination.GetCursor() == "" {
+		page := int(pagination.GetPage())
+		if order != nil {
+			query = OrderBy(query, *order)
+		}
+
+		if err := query.Paginate(page, limit, dest, total); err != nil {
+			return "", err
+		}
+
+		if len(*dest) == 0 || int64(page*limit) >= *total {
+			return "", nil
+		}
+
+		return nextCursor(*dest, order, key, page*limit), nil
+	}
+
+	cursor, err := DecodeCursor(pagination.GetCursor())
+	if err != nil {
+		return "", err
+	}
+	if !cursor.matches(order) {
+		return "", ErrInvalidCursor
+	}
+
+	switch {
+	case order == nil:
+		query = query.Offset(cursor.Offset)
+	case order.ByOffset:
+		query = OrderBy(query, *order).Offset(cursor.Offset)
+	default:
+		query = OrderBy(whereAfter(query, *order, cursor), *order)
+	}
+
+	// One more item is fetched to know whether there is a next page.
+	if err := query.Limit(limit + 1).Find(dest); err != nil {
+		return "", err
+	}
+
+	if len(*dest) <= limit {
+		return "", nil
+	}
+
+	*dest = (*dest)[:limit]
+
+	return nextCursor(*dest, order, key, cursor.Offset+limit), nil
+}
+
+func nextCursor[T any](items []T, order *Order, key func(T) (any, uint64), offset int) string {
+	if order == nil {
+		return EncodeCursor(Cursor{Offset: offset})
+	}
+
+	if order.ByOffset {
+		return EncodeCursor(Cursor{Offset: offset, Sort: order.Column, Desc: order.Desc})
+	}
+
+	value, id := key(items[len(items)-1])
+	if order.Column == "" {
+		value = nil
+	}
+
+	return EncodeCursor(Cursor{ID: id, Value: value, Sort: order.Column, Desc: order.Desc})
+}
+
+// whereAfter filters the items after the cursor in the order.
+func whereAfter(query orm.Query, order Order, cursor *Cursor) orm.Query {
+	operator := ">"
+	if order.Desc {
+		operator = "<"
+	}
+
+	idColumn := order.idColumn()
+	if order.Column == "" {
+		return query.Where(fmt.Sprintf("%s %s ?", idColumn, operator), cursor.ID)
+	}
+
+	return query.Where(fmt.Sprintf("(%s %s ? OR (%s = ? AND %s %s ?))", order.Column, operator, order.Column, idColumn, operator), cursor.Value, cursor.Value, cursor.ID)
+}